@@ -3,6 +3,7 @@ package app
 import (
 	"context"
 
+	"github.com/cube2222/octosql/config"
 	"github.com/cube2222/octosql/execution"
 	"github.com/cube2222/octosql/logical"
 	"github.com/cube2222/octosql/output"
@@ -12,12 +13,14 @@ import (
 )
 
 type App struct {
+	cfg                  *config.Config
 	dataSourceRepository *physical.DataSourceRepository
 	out                  output.Output
 }
 
-func NewApp(dataSourceRepository *physical.DataSourceRepository, out output.Output) *App {
+func NewApp(cfg *config.Config, dataSourceRepository *physical.DataSourceRepository, out output.Output) *App {
 	return &App{
+		cfg:                  cfg,
 		dataSourceRepository: dataSourceRepository,
 		out:                  out,
 	}
@@ -30,26 +33,58 @@ func (app *App) RunPlan(ctx context.Context, plan logical.Node) error {
 	}
 
 	phys = optimizer.Optimize(ctx, optimizer.DefaultScenarios, phys)
+	phys = optimizer.PruneColumns(ctx, phys)
+
+	if app.cfg.Execution.LookupPrefetch > 0 {
+		ctx = physical.WithLookupPrefetch(ctx, app.cfg.Execution.LookupPrefetch)
+	}
+	if app.cfg.Execution.SortMemoryLimit > 0 {
+		ctx = physical.WithSortMemoryLimit(ctx, app.cfg.Execution.SortMemoryLimit*1024*1024)
+	}
+	if app.cfg.Execution.GroupMemoryLimit > 0 {
+		ctx = physical.WithGroupMemoryLimit(ctx, app.cfg.Execution.GroupMemoryLimit*1024*1024)
+	}
+	if app.cfg.Execution.Parallelism > 0 {
+		ctx = physical.WithParallelism(ctx, app.cfg.Execution.Parallelism)
+	}
+
+	if app.cfg.Execution.MaxMemory > 0 {
+		budget := execution.NewMemoryBudget(app.cfg.Execution.MaxMemory * 1024 * 1024)
+		ctx = execution.WithMemoryBudget(ctx, budget)
+		if out, ok := app.out.(output.BufferingOutput); ok {
+			out.SetMemoryBudget(budget)
+		}
+	}
 
 	exec, err := phys.Materialize(ctx)
 	if err != nil {
 		return errors.Wrap(err, "couldn't materialize the physical plan into an execution plan")
 	}
 
-	stream, err := exec.Get(variables)
+	stream, err := exec.Get(ctx, variables)
 	if err != nil {
 		return errors.Wrap(err, "couldn't get record stream from execution plan")
 	}
+	defer stream.Close()
+
+	batches := execution.NewBatchReader(stream)
+	rows := 0
+	var batch *execution.RecordBatch
+	for batch, err = batches.NextBatch(ctx, execution.DefaultBatchSize); err == nil; batch, err = batches.NextBatch(ctx, execution.DefaultBatchSize) {
+		for i := 0; i < batch.Len(); i++ {
+			rows++
+			if app.cfg.Execution.MaxRows > 0 && rows > app.cfg.Execution.MaxRows {
+				return errors.Errorf("query returned more than the maximum of %d rows", app.cfg.Execution.MaxRows)
+			}
 
-	var rec *execution.Record
-	for rec, err = stream.Next(); err == nil; rec, err = stream.Next() {
-		err := app.out.WriteRecord(rec)
-		if err != nil {
-			return errors.Wrap(err, "couldn't write record")
+			err := app.out.WriteRecord(batch.Record(i))
+			if err != nil {
+				return errors.Wrap(err, "couldn't write record")
+			}
 		}
 	}
 	if err != execution.ErrEndOfStream {
-		return errors.Wrap(err, "couldn't get next record")
+		return errors.Wrap(err, "couldn't get next record batch")
 	}
 
 	err = app.out.Close()
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"os/signal"
 	"reflect"
+	"time"
 
 	"github.com/cube2222/octosql/app"
 	"github.com/cube2222/octosql/config"
@@ -14,17 +16,23 @@ import (
 	jsonoutput "github.com/cube2222/octosql/output/json"
 	"github.com/cube2222/octosql/output/table"
 	"github.com/cube2222/octosql/parser"
+	"github.com/cube2222/octosql/parser/sqlparser"
 	"github.com/cube2222/octosql/storage/csv"
 	"github.com/cube2222/octosql/storage/json"
 	"github.com/cube2222/octosql/storage/mysql"
 	"github.com/cube2222/octosql/storage/postgres"
 	"github.com/cube2222/octosql/storage/redis"
 	"github.com/spf13/cobra"
-	"github.com/xwb1989/sqlparser"
 )
 
 var configPath string
 var outputFormat string
+var sortMemoryLimit int
+var groupMemoryLimit int
+var parallelism int
+var maxMemory int
+var maxRows int
+var timeout time.Duration
 
 var rootCmd = &cobra.Command{
 	Use:   "octosql <query>",
@@ -36,7 +44,22 @@ OctoSQL does that by creating an internal representation of your query and later
 With OctoSQL you don't need O(n) client tools or a large data analysis system deployment. Everything's contained in a single binary.`,
 	Args: cobra.ExactValidArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		ctx := context.Background()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		if timeout > 0 {
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		// The first interrupt cancels the running query, a second one kills the process.
+		interrupts := make(chan os.Signal, 1)
+		signal.Notify(interrupts, os.Interrupt)
+		go func() {
+			<-interrupts
+			signal.Stop(interrupts)
+			cancel()
+		}()
+
 		query := args[0]
 
 		// Configuration
@@ -44,6 +67,21 @@ With OctoSQL you don't need O(n) client tools or a large data analysis system de
 		if err != nil {
 			log.Fatal(err)
 		}
+		if sortMemoryLimit > 0 {
+			cfg.Execution.SortMemoryLimit = sortMemoryLimit
+		}
+		if groupMemoryLimit > 0 {
+			cfg.Execution.GroupMemoryLimit = groupMemoryLimit
+		}
+		if parallelism > 0 {
+			cfg.Execution.Parallelism = parallelism
+		}
+		if maxMemory > 0 {
+			cfg.Execution.MaxMemory = maxMemory
+		}
+		if maxRows > 0 {
+			cfg.Execution.MaxRows = maxRows
+		}
 		dataSourceRespository, err := config.CreateDataSourceRepositoryFromConfig(
 			map[string]config.Factory{
 				"csv":      csv.NewDataSourceBuilderFactoryFromConfig,
@@ -74,7 +112,7 @@ With OctoSQL you don't need O(n) client tools or a large data analysis system de
 			log.Fatal("invalid output type")
 		}
 
-		app := app.NewApp(dataSourceRespository, out)
+		app := app.NewApp(cfg, dataSourceRespository, out)
 
 		// Parse query
 		stmt, err := sqlparser.Parse(query)
@@ -101,6 +139,12 @@ With OctoSQL you don't need O(n) client tools or a large data analysis system de
 func main() {
 	rootCmd.Flags().StringVarP(&configPath, "config", "c", os.Getenv("OCTOSQL_CONFIG"), "data source configuration path, defaults to $OCTOSQL_CONFIG")
 	rootCmd.Flags().StringVarP(&outputFormat, "output", "o", "table", "output format, one of [table json csv tabbed table_row_separated]")
+	rootCmd.Flags().IntVar(&sortMemoryLimit, "sort-memory-limit", 0, "megabytes of records an ORDER BY keeps in memory before spilling sorted runs to disk, overrides the configuration, defaults to 256")
+	rootCmd.Flags().IntVar(&groupMemoryLimit, "group-memory-limit", 0, "megabytes of groups a GROUP BY, PIVOT or DISTINCT keeps in memory before spilling partitions to disk, overrides the configuration, defaults to 256")
+	rootCmd.Flags().IntVar(&parallelism, "parallelism", 0, "number of workers evaluating the expressions of maps and filters, greater than 1 also reads both inputs of UNION ALL concurrently, overrides the configuration, defaults to 1")
+	rootCmd.Flags().IntVar(&maxMemory, "max-memory", 0, "megabytes of records all the operators of a query may keep in memory together, operators which can spill to disk do so when it's exceeded, the others fail the query, overrides the configuration, no limit by default")
+	rootCmd.Flags().IntVar(&maxRows, "max-rows", 0, "maximum number of records the query may output, after which it fails, overrides the configuration, no limit by default")
+	rootCmd.Flags().DurationVar(&timeout, "timeout", 0, "maximum duration of the query, e.g. 30s or 5m, after which it gets cancelled, no limit by default")
 
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Println(err)
@@ -14,8 +14,26 @@ type DataSourceConfig struct {
 	Config map[string]interface{} `yaml:"config"`
 }
 
+// ExecutionConfig contains settings for the execution of all queries.
+type ExecutionConfig struct {
+	// LookupPrefetch is the number of source records for which lookup joins fetch the joined records concurrently.
+	LookupPrefetch int `yaml:"lookupPrefetch"`
+	// SortMemoryLimit is the number of megabytes of records an order by keeps in memory, before spilling them to disk.
+	SortMemoryLimit int `yaml:"sortMemoryLimit"`
+	// GroupMemoryLimit is the number of megabytes of groups a group by, pivot or distinct keeps in memory, before spilling records to disk.
+	GroupMemoryLimit int `yaml:"groupMemoryLimit"`
+	// Parallelism is the number of workers maps and filters evaluate records in, it also enables reading both inputs of union alls concurrently.
+	Parallelism int `yaml:"parallelism"`
+	// MaxMemory is the number of megabytes of records all the operators of a query may keep in memory together, zero means no limit.
+	// Operators which spill do so once they reach either their own memory limit or this one.
+	MaxMemory int `yaml:"maxMemory"`
+	// MaxRows is the maximum number of records a query may output, zero means no limit.
+	MaxRows int `yaml:"maxRows"`
+}
+
 type Config struct {
 	DataSources []DataSourceConfig `yaml:"dataSources"`
+	Execution   ExecutionConfig    `yaml:"execution"`
 }
 
 func ReadConfig(path string) (*Config, error) {
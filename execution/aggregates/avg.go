@@ -13,6 +13,7 @@ type Average struct {
 	averages   *execution.HashMap
 	counts     *execution.HashMap
 	typedValue octosql.Value
+	nulls      nullGroups
 }
 
 func NewAverage() *Average {
@@ -26,12 +27,16 @@ func (agg *Average) Document() docs.Documentation {
 	return docs.Section(
 		agg.String(),
 		docs.Body(
-			docs.Section("Description", docs.Text("Averages Floats, Ints or Durations in the group. You may not mix types.")),
+			docs.Section("Description", docs.Text("Averages Floats, Ints or Durations in the group. You may not mix types. Null values are skipped.")),
 		),
 	)
 }
 
 func (agg *Average) AddRecord(key octosql.Tuple, value octosql.Value) error {
+	if value == nil {
+		return agg.nulls.Add(key)
+	}
+
 	if agg.typedValue == nil {
 		agg.typedValue = value
 	}
@@ -106,6 +111,13 @@ func (agg *Average) GetAggregated(key octosql.Tuple) (octosql.Value, error) {
 	}
 
 	if !ok {
+		onlyNulls, err := agg.nulls.Has(key)
+		if err != nil {
+			return nil, err
+		}
+		if onlyNulls {
+			return nil, nil
+		}
 		return nil, errors.Errorf("average for key not found")
 	}
 
@@ -10,6 +10,7 @@ import (
 type Max struct {
 	maxes      *execution.HashMap
 	typedValue octosql.Value
+	nulls      nullGroups
 }
 
 func NewMax() *Max {
@@ -22,12 +23,16 @@ func (agg *Max) Document() docs.Documentation {
 	return docs.Section(
 		agg.String(),
 		docs.Body(
-			docs.Section("Description", docs.Text("Takes the maximum element in the group. Works with Ints, Floats, Strings, Booleans, Times, Durations.")),
+			docs.Section("Description", docs.Text("Takes the maximum element in the group. Works with Ints, Floats, Strings, Booleans, Times, Durations. Null values are skipped.")),
 		),
 	)
 }
 
 func (agg *Max) AddRecord(key octosql.Tuple, value octosql.Value) error {
+	if value == nil {
+		return agg.nulls.Add(key)
+	}
+
 	max, previousValueExists, err := agg.maxes.Get(key)
 	if err != nil {
 		return errors.Wrap(err, "couldn't get current max out of hashmap")
@@ -127,6 +132,13 @@ func (agg *Max) GetAggregated(key octosql.Tuple) (octosql.Value, error) {
 	}
 
 	if !ok {
+		onlyNulls, err := agg.nulls.Has(key)
+		if err != nil {
+			return nil, err
+		}
+		if onlyNulls {
+			return nil, nil
+		}
 		return nil, errors.Errorf("max for key not found")
 	}
 
@@ -10,6 +10,7 @@ import (
 type Min struct {
 	mins       *execution.HashMap
 	typedValue octosql.Value
+	nulls      nullGroups
 }
 
 func NewMin() *Min {
@@ -22,12 +23,16 @@ func (agg *Min) Document() docs.Documentation {
 	return docs.Section(
 		agg.String(),
 		docs.Body(
-			docs.Section("Description", docs.Text("Takes the minimum element in the group. Works with Ints, Floats, Strings, Booleans, Times, Durations.")),
+			docs.Section("Description", docs.Text("Takes the minimum element in the group. Works with Ints, Floats, Strings, Booleans, Times, Durations. Null values are skipped.")),
 		),
 	)
 }
 
 func (agg *Min) AddRecord(key octosql.Tuple, value octosql.Value) error {
+	if value == nil {
+		return agg.nulls.Add(key)
+	}
+
 	min, previousValueExists, err := agg.mins.Get(key)
 	if err != nil {
 		return errors.Wrap(err, "couldn't get current min out of hashmap")
@@ -128,6 +133,13 @@ func (agg *Min) GetAggregated(key octosql.Tuple) (octosql.Value, error) {
 	}
 
 	if !ok {
+		onlyNulls, err := agg.nulls.Has(key)
+		if err != nil {
+			return nil, err
+		}
+		if onlyNulls {
+			return nil, nil
+		}
 		return nil, errors.Errorf("min for key not found")
 	}
 
@@ -0,0 +1,40 @@
+package aggregates
+
+import (
+	"github.com/cube2222/octosql"
+	"github.com/cube2222/octosql/execution"
+	"github.com/pkg/errors"
+)
+
+// nullGroups contains the keys of the groups which received null values.
+// Like in SQL, aggregates skip nulls, so groups of only nulls get aggregated to null.
+// The zero value is empty and ready to use.
+type nullGroups struct {
+	keys *execution.HashMap
+}
+
+func (groups *nullGroups) Add(key octosql.Tuple) error {
+	if groups.keys == nil {
+		groups.keys = execution.NewHashMap()
+	}
+
+	err := groups.keys.Set(key, struct{}{})
+	if err != nil {
+		return errors.Wrap(err, "couldn't put key into null groups hashmap")
+	}
+
+	return nil
+}
+
+func (groups *nullGroups) Has(key octosql.Tuple) (bool, error) {
+	if groups.keys == nil {
+		return false, nil
+	}
+
+	_, ok, err := groups.keys.Get(key)
+	if err != nil {
+		return false, errors.Wrap(err, "couldn't get key out of null groups hashmap")
+	}
+
+	return ok, nil
+}
@@ -10,6 +10,7 @@ import (
 type Sum struct {
 	sums       *execution.HashMap
 	typedValue octosql.Value
+	nulls      nullGroups
 }
 
 func NewSum() *Sum {
@@ -22,12 +23,16 @@ func (agg *Sum) Document() docs.Documentation {
 	return docs.Section(
 		agg.String(),
 		docs.Body(
-			docs.Section("Description", docs.Text("Sums Floats, Ints or Durations in the group. You may not mix types.")),
+			docs.Section("Description", docs.Text("Sums Floats, Ints or Durations in the group. You may not mix types. Null values are skipped.")),
 		),
 	)
 }
 
 func (agg *Sum) AddRecord(key octosql.Tuple, value octosql.Value) error {
+	if value == nil {
+		return agg.nulls.Add(key)
+	}
+
 	sum, previousValueExists, err := agg.sums.Get(key)
 	if err != nil {
 		return errors.Wrap(err, "couldn't get current sum out of hashmap")
@@ -98,6 +103,13 @@ func (agg *Sum) GetAggregated(key octosql.Tuple) (octosql.Value, error) {
 	}
 
 	if !ok {
+		onlyNulls, err := agg.nulls.Has(key)
+		if err != nil {
+			return nil, err
+		}
+		if onlyNulls {
+			return nil, nil
+		}
 		return nil, errors.Errorf("sum for key not found")
 	}
 
@@ -329,6 +329,40 @@ func TestSum(t *testing.T) {
 			key:     octosql.MakeTuple([]octosql.Value{octosql.MakeString("key"), octosql.MakeInt(1), octosql.MakeTuple([]octosql.Value{octosql.MakeString("key"), octosql.MakeInt(1)}), octosql.MakeObject(map[string]octosql.Value{"key": octosql.MakeInt(1)})}),
 			wantErr: true,
 		},
+		{
+			name: "nulls are skipped, like in SQL",
+			args: []kv{
+				{
+					key:   octosql.MakeTuple([]octosql.Value{octosql.MakeString("key")}),
+					value: octosql.MakeInt(3),
+				},
+				{
+					key:   octosql.MakeTuple([]octosql.Value{octosql.MakeString("key")}),
+					value: nil,
+				},
+				{
+					key:   octosql.MakeTuple([]octosql.Value{octosql.MakeString("key")}),
+					value: octosql.MakeInt(5),
+				},
+			},
+			key:  octosql.MakeTuple([]octosql.Value{octosql.MakeString("key")}),
+			want: octosql.MakeInt(8),
+		},
+		{
+			name: "sum of only nulls is null, like in SQL",
+			args: []kv{
+				{
+					key:   octosql.MakeTuple([]octosql.Value{octosql.MakeString("key")}),
+					value: nil,
+				},
+				{
+					key:   octosql.MakeTuple([]octosql.Value{octosql.MakeString("key")}),
+					value: nil,
+				},
+			},
+			key:  octosql.MakeTuple([]octosql.Value{octosql.MakeString("key")}),
+			want: nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,133 @@
+package execution
+
+import (
+	"context"
+
+	"github.com/cube2222/octosql"
+)
+
+// DefaultBatchSize is the default maximum number of records in a batch.
+const DefaultBatchSize = 1024
+
+// RecordBatch is a column-oriented batch of records with the same fields.
+// Batches mustn't be modified, as their columns may be shared with other batches.
+type RecordBatch struct {
+	schema  *Schema
+	columns [][]octosql.Value
+	length  int
+}
+
+// NewRecordBatch creates a batch out of columns of equal length, one for each field of the schema.
+func NewRecordBatch(schema *Schema, columns [][]octosql.Value, length int) *RecordBatch {
+	return &RecordBatch{
+		schema:  schema,
+		columns: columns,
+		length:  length,
+	}
+}
+
+// NewRecordBatchFromRecords creates a batch out of records, which all have to have the given schema.
+func NewRecordBatchFromRecords(schema *Schema, records []*Record) *RecordBatch {
+	columns := make([][]octosql.Value, schema.Len())
+	for i := range columns {
+		columns[i] = make([]octosql.Value, len(records))
+		for j := range records {
+			columns[i][j] = records[j].data[i]
+		}
+	}
+
+	return NewRecordBatch(schema, columns, len(records))
+}
+
+func (batch *RecordBatch) Len() int {
+	return batch.length
+}
+
+func (batch *RecordBatch) Schema() *Schema {
+	return batch.schema
+}
+
+func (batch *RecordBatch) Fields() []octosql.VariableName {
+	return batch.schema.fields
+}
+
+// Column returns the values of the field with the given index.
+func (batch *RecordBatch) Column(i int) []octosql.Value {
+	return batch.columns[i]
+}
+
+// Record returns the row with the given index as a record.
+func (batch *RecordBatch) Record(row int) *Record {
+	data := make([]octosql.Value, len(batch.columns))
+	for i := range batch.columns {
+		data[i] = batch.columns[i][row]
+	}
+
+	return NewRecordFromSchema(batch.schema, data)
+}
+
+// Filter returns a batch with only the given rows, in the given order.
+func (batch *RecordBatch) Filter(rows []int) *RecordBatch {
+	columns := make([][]octosql.Value, len(batch.columns))
+	for i := range batch.columns {
+		columns[i] = make([]octosql.Value, len(rows))
+		for j, row := range rows {
+			columns[i][j] = batch.columns[i][row]
+		}
+	}
+
+	return NewRecordBatch(batch.schema, columns, len(rows))
+}
+
+// BatchRecordStream is a record stream which can also natively return its records in batches.
+// A consumer should use either Next or NextBatch on a stream, not both.
+type BatchRecordStream interface {
+	RecordStream
+	// NextBatch returns a non-empty batch of at most maxSize records, or ErrEndOfStream at the end of the stream.
+	NextBatch(ctx context.Context, maxSize int) (*RecordBatch, error)
+}
+
+// BatchReader reads batches out of a stream, natively if it implements BatchRecordStream,
+// otherwise record by record. The batch ends early if the fields of the records change.
+type BatchReader struct {
+	stream RecordStream
+	// pending is a record read in the previous batch, which had different fields.
+	pending *Record
+}
+
+func NewBatchReader(stream RecordStream) *BatchReader {
+	return &BatchReader{stream: stream}
+}
+
+func (reader *BatchReader) NextBatch(ctx context.Context, maxSize int) (*RecordBatch, error) {
+	if batchStream, ok := reader.stream.(BatchRecordStream); ok {
+		return batchStream.NextBatch(ctx, maxSize)
+	}
+
+	var records []*Record
+	if reader.pending != nil {
+		records = append(records, reader.pending)
+		reader.pending = nil
+	}
+
+	for len(records) < maxSize {
+		record, err := reader.stream.Next(ctx)
+		if err != nil {
+			if err == ErrEndOfStream {
+				break
+			}
+			return nil, err
+		}
+		if len(records) > 0 && !records[0].schema.Equal(record.schema) {
+			reader.pending = record
+			break
+		}
+		records = append(records, record)
+	}
+
+	if len(records) == 0 {
+		return nil, ErrEndOfStream
+	}
+
+	return NewRecordBatchFromRecords(records[0].schema, records), nil
+}
@@ -0,0 +1,157 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/cube2222/octosql"
+)
+
+func TestBatchReader(t *testing.T) {
+	ctx := context.Background()
+	fieldsA := []octosql.VariableName{"a", "b"}
+	fieldsB := []octosql.VariableName{"c"}
+
+	var records []*Record
+	for i := 0; i < 5; i++ {
+		records = append(records, NewRecordFromSlice(fieldsA, []octosql.Value{octosql.MakeInt(i), octosql.MakeInt(i * 2)}))
+	}
+	for i := 0; i < 2; i++ {
+		records = append(records, NewRecordFromSlice(fieldsB, []octosql.Value{octosql.MakeInt(i)}))
+	}
+	records = append(records, NewRecordFromSlice(fieldsA, []octosql.Value{octosql.MakeInt(7), octosql.MakeInt(14)}))
+
+	type batchShape struct {
+		fields []octosql.VariableName
+		length int
+	}
+	want := []batchShape{
+		{fieldsA, 3},
+		{fieldsA, 2},
+		{fieldsB, 2},
+		{fieldsA, 1},
+	}
+
+	reader := NewBatchReader(NewInMemoryStream(records))
+	var got []batchShape
+	var gotRecords []*Record
+	for {
+		batch, err := reader.NextBatch(ctx, 3)
+		if err == ErrEndOfStream {
+			break
+		}
+		if err != nil {
+			t.Fatalf("NextBatch() error: %v", err)
+		}
+		got = append(got, batchShape{batch.Fields(), batch.Len()})
+		for i := 0; i < batch.Len(); i++ {
+			gotRecords = append(gotRecords, batch.Record(i))
+		}
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NextBatch() batches = %v, want %v", got, want)
+	}
+
+	equal, err := AreStreamsEqual(ctx, NewInMemoryStream(records), NewInMemoryStream(gotRecords))
+	if err != nil {
+		t.Errorf("Error in AreStreamsEqual(): %v", err)
+	}
+	if !equal {
+		t.Errorf("Batched records don't match source records")
+	}
+}
+
+func TestBatchedFilterAndMap(t *testing.T) {
+	ctx := context.Background()
+	fields := []octosql.VariableName{"id", "name"}
+	var records []*Record
+	for i := 0; i < 1000; i++ {
+		records = append(records, NewRecordFromSlice(fields, []octosql.Value{
+			octosql.MakeInt(i),
+			octosql.MakeString(fmt.Sprintf("name%d", i)),
+		}))
+	}
+
+	tests := []struct {
+		name string
+		node Node
+	}{
+		{
+			name: "filter",
+			node: NewFilter(
+				NewPredicate(NewVariable("id"), &MoreThan{}, NewDummyValue(octosql.MakeInt(100))),
+				NewDummyNode(records),
+				1,
+			),
+		},
+		{
+			name: "filter matching nothing",
+			node: NewFilter(
+				NewPredicate(NewVariable("id"), &LessThan{}, NewDummyValue(octosql.MakeInt(0))),
+				NewDummyNode(records),
+				1,
+			),
+		},
+		{
+			name: "map over filter",
+			node: NewMap(
+				[]NamedExpression{
+					NewAliasedExpression("renamed", NewVariable("name")),
+					NewVariable("id"),
+				},
+				NewFilter(
+					NewPredicate(NewVariable("id"), &LessThan{}, NewDummyValue(octosql.MakeInt(500))),
+					NewDummyNode(records),
+					1,
+				),
+				true,
+				1,
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, err := tt.node.Get(ctx, octosql.NoVariables())
+			if err != nil {
+				t.Fatalf("couldn't get stream: %v", err)
+			}
+
+			stream, err := tt.node.Get(ctx, octosql.NoVariables())
+			if err != nil {
+				t.Fatalf("couldn't get stream: %v", err)
+			}
+			batchStream, ok := stream.(BatchRecordStream)
+			if !ok {
+				t.Fatalf("stream %T doesn't support batches", stream)
+			}
+
+			var got []*Record
+			for {
+				batch, err := batchStream.NextBatch(ctx, 64)
+				if err == ErrEndOfStream {
+					break
+				}
+				if err != nil {
+					t.Fatalf("NextBatch() error: %v", err)
+				}
+				if batch.Len() == 0 || batch.Len() > 64 {
+					t.Errorf("NextBatch() returned batch of length %v", batch.Len())
+				}
+				for i := 0; i < batch.Len(); i++ {
+					got = append(got, batch.Record(i))
+				}
+			}
+
+			equal, err := AreStreamsEqual(ctx, want, NewInMemoryStream(got))
+			if err != nil {
+				t.Errorf("Error in AreStreamsEqual(): %v", err)
+			}
+			if !equal {
+				t.Errorf("Batched stream doesn't match record stream")
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package execution
+
+import (
+	"context"
+
+	"github.com/cube2222/octosql"
+	"github.com/pkg/errors"
+)
+
+// BatchedLookupJoinedStream is a lookup join which collects batches of source records
+// and looks up the joined records for the whole batch at once.
+// If keepUnmatched is set, source records without any joined record are returned too, as in a left join.
+type BatchedLookupJoinedStream struct {
+	variables     octosql.Variables
+	source        RecordStream
+	joined        BatchLookupNode
+	keepUnmatched bool
+
+	sourceDone      bool
+	batch           []*Record
+	batchStreams    []RecordStream
+	batchIndex      int
+	joinedAnyRecord bool
+	schemas         concatSchemas
+}
+
+func newBatchedLookupJoinedStream(variables octosql.Variables, source RecordStream, joined BatchLookupNode, keepUnmatched bool) *BatchedLookupJoinedStream {
+	return &BatchedLookupJoinedStream{
+		variables:     variables,
+		source:        source,
+		joined:        joined,
+		keepUnmatched: keepUnmatched,
+	}
+}
+
+func (stream *BatchedLookupJoinedStream) Close() error {
+	err := stream.closeBatchStreams()
+	if err != nil {
+		return err
+	}
+
+	err = stream.source.Close()
+	if err != nil {
+		return errors.Wrap(err, "Couldn't close source stream")
+	}
+
+	return nil
+}
+
+func (stream *BatchedLookupJoinedStream) Next(ctx context.Context) (*Record, error) {
+	for {
+		if stream.batchIndex == len(stream.batch) {
+			err := stream.loadBatch(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if len(stream.batch) == 0 {
+				return nil, ErrEndOfStream
+			}
+		}
+
+		curRecord := stream.batch[stream.batchIndex]
+
+		joinedRecord, err := stream.batchStreams[stream.batchIndex].Next(ctx)
+		if err != nil {
+			if err == ErrEndOfStream {
+				err := stream.batchStreams[stream.batchIndex].Close()
+				if err != nil {
+					return nil, errors.Wrap(err, "couldn't close joined stream")
+				}
+
+				joinedAnyRecord := stream.joinedAnyRecord
+				stream.batchIndex++
+				stream.joinedAnyRecord = false
+				if stream.keepUnmatched && !joinedAnyRecord {
+					return curRecord, nil
+				}
+				continue
+			}
+			return nil, errors.Wrap(err, "couldn't get joined record")
+		}
+		stream.joinedAnyRecord = true
+
+		record, err := concatRecords(&stream.schemas, curRecord, joinedRecord)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't merge current record variables with joined record variables")
+		}
+
+		return record, nil
+	}
+}
+
+func (stream *BatchedLookupJoinedStream) loadBatch(ctx context.Context) error {
+	err := stream.closeBatchStreams()
+	if err != nil {
+		return err
+	}
+
+	stream.batch = stream.batch[:0]
+	stream.batchIndex = 0
+	if stream.sourceDone {
+		return nil
+	}
+
+	batchVariables := make([]octosql.Variables, 0, stream.joined.BatchSize())
+	for len(stream.batch) < stream.joined.BatchSize() {
+		srcRecord, err := stream.source.Next(ctx)
+		if err != nil {
+			if err == ErrEndOfStream {
+				stream.sourceDone = true
+				break
+			}
+			return errors.Wrap(err, "couldn't get source record")
+		}
+
+		variables, err := stream.variables.MergeWith(srcRecord.AsVariables())
+		if err != nil {
+			return errors.Wrap(err, "couldn't merge given variables with source record variables")
+		}
+
+		stream.batch = append(stream.batch, srcRecord)
+		batchVariables = append(batchVariables, variables)
+	}
+	if len(stream.batch) == 0 {
+		return nil
+	}
+
+	streams, err := stream.joined.GetBatch(ctx, batchVariables)
+	if err != nil {
+		return errors.Wrap(err, "couldn't get joined streams for batch")
+	}
+	stream.batchStreams = streams
+
+	return nil
+}
+
+// closeBatchStreams closes the joined streams of the current batch, which haven't been read until their end.
+func (stream *BatchedLookupJoinedStream) closeBatchStreams() error {
+	if stream.batchIndex < len(stream.batchStreams) {
+		for _, joinedStream := range stream.batchStreams[stream.batchIndex:] {
+			err := joinedStream.Close()
+			if err != nil {
+				return errors.Wrap(err, "couldn't close joined stream")
+			}
+		}
+	}
+	stream.batchStreams = nil
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package execution
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cube2222/octosql"
+)
+
+// batchLookupTable looks up the records whose keyField equals the lookupVariable.
+type batchLookupTable struct {
+	records        []*Record
+	keyField       octosql.VariableName
+	lookupVariable octosql.VariableName
+	batchSize      int
+
+	batches int
+	// open counts the streams returned by GetBatch, which weren't closed.
+	open int
+}
+
+func (node *batchLookupTable) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	value, err := variables.Get(node.lookupVariable)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []*Record
+	for _, record := range node.records {
+		if octosql.AreEqual(record.Value(node.keyField), value) {
+			records = append(records, record)
+		}
+	}
+	return NewInMemoryStream(records), nil
+}
+
+func (node *batchLookupTable) GetBatch(ctx context.Context, batch []octosql.Variables) ([]RecordStream, error) {
+	node.batches++
+
+	streams := make([]RecordStream, len(batch))
+	for i := range batch {
+		stream, err := node.Get(ctx, batch[i])
+		if err != nil {
+			return nil, err
+		}
+		node.open++
+		streams[i] = &batchLookupStream{RecordStream: stream, node: node}
+	}
+	return streams, nil
+}
+
+type batchLookupStream struct {
+	RecordStream
+	node *batchLookupTable
+}
+
+func (stream *batchLookupStream) Close() error {
+	stream.node.open--
+	return stream.RecordStream.Close()
+}
+
+func (node *batchLookupTable) BatchSize() int {
+	return node.batchSize
+}
+
+func TestBatchedLookupJoin(t *testing.T) {
+	ctx := context.Background()
+	sourceFields := []octosql.VariableName{"e.user_id", "e.action"}
+	joinedFields := []octosql.VariableName{"u.id", "u.name"}
+	allFields := []octosql.VariableName{"e.user_id", "e.action", "u.id", "u.name"}
+
+	source := []*Record{
+		NewRecordFromSliceWithNormalize(sourceFields, []interface{}{1, "a"}),
+		NewRecordFromSliceWithNormalize(sourceFields, []interface{}{2, "b"}),
+		NewRecordFromSliceWithNormalize(sourceFields, []interface{}{3, "c"}),
+		NewRecordFromSliceWithNormalize(sourceFields, []interface{}{1, "d"}),
+		NewRecordFromSliceWithNormalize(sourceFields, []interface{}{4, "e"}),
+	}
+	joined := []*Record{
+		NewRecordFromSliceWithNormalize(joinedFields, []interface{}{1, "alice"}),
+		NewRecordFromSliceWithNormalize(joinedFields, []interface{}{3, "carol"}),
+		NewRecordFromSliceWithNormalize(joinedFields, []interface{}{3, "charlie"}),
+	}
+
+	tests := []struct {
+		name        string
+		left        bool
+		batchSize   int
+		want        []*Record
+		wantBatches int
+	}{
+		{
+			name:      "inner join",
+			batchSize: 2,
+			want: []*Record{
+				NewRecordFromSliceWithNormalize(allFields, []interface{}{1, "a", 1, "alice"}),
+				NewRecordFromSliceWithNormalize(allFields, []interface{}{3, "c", 3, "carol"}),
+				NewRecordFromSliceWithNormalize(allFields, []interface{}{3, "c", 3, "charlie"}),
+				NewRecordFromSliceWithNormalize(allFields, []interface{}{1, "d", 1, "alice"}),
+			},
+			wantBatches: 3,
+		},
+		{
+			name:      "left join",
+			left:      true,
+			batchSize: 10,
+			want: []*Record{
+				NewRecordFromSliceWithNormalize(allFields, []interface{}{1, "a", 1, "alice"}),
+				NewRecordFromSliceWithNormalize(sourceFields, []interface{}{2, "b"}),
+				NewRecordFromSliceWithNormalize(allFields, []interface{}{3, "c", 3, "carol"}),
+				NewRecordFromSliceWithNormalize(allFields, []interface{}{3, "c", 3, "charlie"}),
+				NewRecordFromSliceWithNormalize(allFields, []interface{}{1, "d", 1, "alice"}),
+				NewRecordFromSliceWithNormalize(sourceFields, []interface{}{4, "e"}),
+			},
+			wantBatches: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := &batchLookupTable{
+				records:        joined,
+				keyField:       "u.id",
+				lookupVariable: "e.user_id",
+				batchSize:      tt.batchSize,
+			}
+
+			var node Node = NewInnerJoin(NewDummyNode(source), table, 1)
+			if tt.left {
+				node = NewLeftJoin(NewDummyNode(source), table, 1)
+			}
+
+			stream, err := node.Get(ctx, octosql.NoVariables())
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+
+			equal, err := AreStreamsEqual(ctx, stream, NewInMemoryStream(tt.want))
+			if err != nil {
+				t.Errorf("AreStreamsEqual() error = %v", err)
+			}
+			if !equal {
+				t.Errorf("streams not equal")
+			}
+			if table.batches != tt.wantBatches {
+				t.Errorf("batch count = %v, want %v", table.batches, tt.wantBatches)
+			}
+			if table.open != 0 {
+				t.Errorf("joined streams left open = %v, want 0", table.open)
+			}
+		})
+	}
+}
+
+func TestBatchedLookupJoin_Close(t *testing.T) {
+	ctx := context.Background()
+	sourceFields := []octosql.VariableName{"e.user_id"}
+	joinedFields := []octosql.VariableName{"u.id"}
+
+	source := []*Record{
+		NewRecordFromSliceWithNormalize(sourceFields, []interface{}{1}),
+		NewRecordFromSliceWithNormalize(sourceFields, []interface{}{2}),
+		NewRecordFromSliceWithNormalize(sourceFields, []interface{}{3}),
+	}
+	table := &batchLookupTable{
+		records:        []*Record{NewRecordFromSliceWithNormalize(joinedFields, []interface{}{1})},
+		keyField:       "u.id",
+		lookupVariable: "e.user_id",
+		batchSize:      3,
+	}
+
+	stream, err := NewLeftJoin(NewDummyNode(source), table, 1).Get(ctx, octosql.NoVariables())
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	if _, err := stream.Next(ctx); err != nil {
+		t.Fatalf("Next() error = %v", err)
+	}
+	if err := stream.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	if table.open != 0 {
+		t.Errorf("joined streams left open = %v, want 0", table.open)
+	}
+}
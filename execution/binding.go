@@ -0,0 +1,243 @@
+package execution
+
+import (
+	"context"
+
+	"github.com/cube2222/octosql"
+	"github.com/pkg/errors"
+)
+
+// recordBinding evaluates expressions and a formula for records, with their variables resolved once for each schema of the records.
+// Variables of record fields become lookups of the record value with the field's index,
+// while the other variables are replaced by their values, as the variables of a stream don't change.
+// Expressions which can't be resolved this way, like subqueries, which need all the variables,
+// are evaluated with the variables merged with the record's fields.
+// A record binding mustn't be used concurrently.
+type recordBinding struct {
+	variables   octosql.Variables
+	expressions []Expression
+	formula     Formula
+
+	schema           *Schema
+	row              boundRow
+	boundExpressions []Expression
+	boundFormula     Formula
+
+	// merged are only set for each record, if some expression couldn't be resolved.
+	merged          *recordVariables
+	mergedVariables octosql.Variables
+}
+
+// newRecordBinding creates a binding of the expressions and the formula, which may be nil if there's no formula.
+func newRecordBinding(variables octosql.Variables, expressions []Expression, formula Formula) *recordBinding {
+	return &recordBinding{
+		variables:   variables,
+		expressions: expressions,
+		formula:     formula,
+	}
+}
+
+func (binding *recordBinding) setSchema(schema *Schema) error {
+	if binding.schema != nil && binding.schema.Equal(schema) {
+		return nil
+	}
+
+	for _, field := range schema.fields {
+		if vOld, ok := binding.variables[field]; ok {
+			return errors.Errorf("%v already defined as %+v", field, vOld)
+		}
+	}
+
+	resolved := true
+	binding.boundExpressions = make([]Expression, len(binding.expressions))
+	for i, expr := range binding.expressions {
+		bound, ok := bindExpression(expr, schema, &binding.row, binding.variables)
+		if !ok {
+			bound, resolved = expr, false
+		}
+		binding.boundExpressions[i] = bound
+	}
+	if binding.formula != nil {
+		bound, ok := bindFormula(binding.formula, schema, &binding.row, binding.variables)
+		if !ok {
+			bound, resolved = binding.formula, false
+		}
+		binding.boundFormula = bound
+	}
+
+	binding.merged = nil
+	if !resolved {
+		binding.merged = newRecordVariables(binding.variables)
+	}
+	binding.mergedVariables = nil
+	binding.schema = schema
+	return nil
+}
+
+// Set makes the expressions get evaluated for the record.
+func (binding *recordBinding) Set(record *Record) error {
+	if err := binding.setSchema(record.schema); err != nil {
+		return err
+	}
+	binding.row = boundRow{data: record.data}
+
+	if binding.merged != nil {
+		variables, err := binding.merged.Set(record)
+		if err != nil {
+			return err
+		}
+		binding.mergedVariables = variables
+	}
+	return nil
+}
+
+// SetBatchRow makes the expressions get evaluated for the row of the batch.
+func (binding *recordBinding) SetBatchRow(batch *RecordBatch, row int) error {
+	if err := binding.setSchema(batch.schema); err != nil {
+		return err
+	}
+	binding.row = boundRow{columns: batch.columns, row: row}
+
+	if binding.merged != nil {
+		variables, err := binding.merged.SetBatchRow(batch, row)
+		if err != nil {
+			return err
+		}
+		binding.mergedVariables = variables
+	}
+	return nil
+}
+
+// Value evaluates the expression with the given index for the current record.
+func (binding *recordBinding) Value(ctx context.Context, index int) (octosql.Value, error) {
+	return binding.boundExpressions[index].ExpressionValue(ctx, binding.mergedVariables)
+}
+
+// Holds evaluates the formula for the current record.
+func (binding *recordBinding) Holds(ctx context.Context) (bool, error) {
+	return binding.boundFormula.Evaluate(ctx, binding.mergedVariables)
+}
+
+// boundRow is the record, or the row of a batch, whose values the resolved variables of a record binding read.
+type boundRow struct {
+	data    []octosql.Value
+	columns [][]octosql.Value
+	row     int
+}
+
+// fieldVariable is a variable resolved to the index of a record field.
+type fieldVariable struct {
+	row   *boundRow
+	index int
+}
+
+func (v *fieldVariable) ExpressionValue(ctx context.Context, variables octosql.Variables) (octosql.Value, error) {
+	if v.row.columns != nil {
+		return v.row.columns[v.index][v.row.row], nil
+	}
+	return v.row.data[v.index], nil
+}
+
+// bindExpression returns a copy of the expression with its variables resolved, false if it can't be resolved.
+func bindExpression(expr Expression, schema *Schema, row *boundRow, variables octosql.Variables) (Expression, bool) {
+	switch expr := expr.(type) {
+	case *Variable:
+		if index := schema.Index(expr.name); index != -1 {
+			return &fieldVariable{row: row, index: index}, true
+		}
+		return NewDummyValue(variables[expr.name]), true
+
+	case *DummyValue:
+		return expr, true
+
+	case *TupleExpression:
+		expressions := make([]Expression, len(expr.expressions))
+		for i := range expr.expressions {
+			bound, ok := bindExpression(expr.expressions[i], schema, row, variables)
+			if !ok {
+				return nil, false
+			}
+			expressions[i] = bound
+		}
+		return NewTuple(expressions), true
+
+	case *FunctionExpression:
+		arguments := make([]Expression, len(expr.arguments))
+		for i := range expr.arguments {
+			bound, ok := bindExpression(expr.arguments[i], schema, row, variables)
+			if !ok {
+				return nil, false
+			}
+			arguments[i] = bound
+		}
+		return NewFunctionExpression(expr.function, arguments), true
+
+	case *LogicExpression:
+		formula, ok := bindFormula(expr.formula, schema, row, variables)
+		if !ok {
+			return nil, false
+		}
+		return NewLogicExpression(formula), true
+
+	case *AliasedExpression:
+		bound, ok := bindExpression(expr.expr, schema, row, variables)
+		if !ok {
+			return nil, false
+		}
+		return NewAliasedExpression(expr.name, bound), true
+
+	default:
+		return nil, false
+	}
+}
+
+// bindFormula returns a copy of the formula with the variables of its expressions resolved, false if it can't be resolved.
+func bindFormula(formula Formula, schema *Schema, row *boundRow, variables octosql.Variables) (Formula, bool) {
+	switch formula := formula.(type) {
+	case *Constant, Constant:
+		return formula, true
+
+	case *And:
+		left, ok := bindFormula(formula.Left, schema, row, variables)
+		if !ok {
+			return nil, false
+		}
+		right, ok := bindFormula(formula.Right, schema, row, variables)
+		if !ok {
+			return nil, false
+		}
+		return NewAnd(left, right), true
+
+	case *Or:
+		left, ok := bindFormula(formula.Left, schema, row, variables)
+		if !ok {
+			return nil, false
+		}
+		right, ok := bindFormula(formula.Right, schema, row, variables)
+		if !ok {
+			return nil, false
+		}
+		return NewOr(left, right), true
+
+	case *Not:
+		child, ok := bindFormula(formula.Child, schema, row, variables)
+		if !ok {
+			return nil, false
+		}
+		return NewNot(child), true
+
+	case *Predicate:
+		left, ok := bindExpression(formula.Left, schema, row, variables)
+		if !ok {
+			return nil, false
+		}
+		right, ok := bindExpression(formula.Right, schema, row, variables)
+		if !ok {
+			return nil, false
+		}
+		return NewPredicate(left, formula.Relation, right), true
+
+	default:
+		return nil, false
+	}
+}
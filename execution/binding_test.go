@@ -0,0 +1,113 @@
+package execution
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cube2222/octosql"
+)
+
+func TestRecordBinding(t *testing.T) {
+	ctx := context.Background()
+	variables := octosql.NewVariables(map[octosql.VariableName]octosql.Value{
+		"const": octosql.MakeInt(3),
+	})
+	// The subquery returns the value of the field a of the outer record, so it needs all the variables.
+	subquery := NewNodeExpression(NewMap(
+		[]NamedExpression{NewAliasedExpression("x", NewVariable("a"))},
+		NewDummyNode([]*Record{NewRecordFromSlice([]octosql.VariableName{"y"}, []octosql.Value{octosql.MakeInt(0)})}),
+		false,
+		1,
+	))
+
+	tests := []struct {
+		name        string
+		expressions []Expression
+		formula     Formula
+		records     []*Record
+		want        [][]octosql.Value
+		wantHolds   []bool
+		wantMerged  bool
+	}{
+		{
+			name:        "fields and variables",
+			expressions: []Expression{NewVariable("b"), NewVariable("const"), NewTuple([]Expression{NewVariable("a"), NewVariable("missing")})},
+			formula:     NewPredicate(NewVariable("a"), NewLessThan(), NewVariable("const")),
+			records: []*Record{
+				NewRecordFromSlice([]octosql.VariableName{"a", "b"}, []octosql.Value{octosql.MakeInt(1), octosql.MakeString("x")}),
+				NewRecordFromSlice([]octosql.VariableName{"a", "b"}, []octosql.Value{octosql.MakeInt(5), octosql.MakeString("y")}),
+				// Records with another schema get the variables resolved again.
+				NewRecordFromSlice([]octosql.VariableName{"b", "a"}, []octosql.Value{octosql.MakeString("z"), octosql.MakeInt(2)}),
+			},
+			want: [][]octosql.Value{
+				{octosql.MakeString("x"), octosql.MakeInt(3), octosql.Tuple{octosql.MakeInt(1), nil}},
+				{octosql.MakeString("y"), octosql.MakeInt(3), octosql.Tuple{octosql.MakeInt(5), nil}},
+				{octosql.MakeString("z"), octosql.MakeInt(3), octosql.Tuple{octosql.MakeInt(2), nil}},
+			},
+			wantHolds: []bool{true, false, true},
+		},
+		{
+			name:        "subquery",
+			expressions: []Expression{subquery, NewVariable("a")},
+			formula:     NewConstant(true),
+			records: []*Record{
+				NewRecordFromSlice([]octosql.VariableName{"a"}, []octosql.Value{octosql.MakeInt(1)}),
+				NewRecordFromSlice([]octosql.VariableName{"a"}, []octosql.Value{octosql.MakeInt(2)}),
+			},
+			want: [][]octosql.Value{
+				{octosql.MakeInt(1), octosql.MakeInt(1)},
+				{octosql.MakeInt(2), octosql.MakeInt(2)},
+			},
+			wantHolds:  []bool{true, true},
+			wantMerged: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := func(binding *recordBinding, i int) {
+				for j := range tt.expressions {
+					got, err := binding.Value(ctx, j)
+					if err != nil {
+						t.Fatalf("recordBinding.Value() error: %v", err)
+					}
+					if !octosql.AreEqual(got, tt.want[i][j]) {
+						t.Errorf("recordBinding.Value() of expression %v for record %v = %v, want %v", j, i, got, tt.want[i][j])
+					}
+				}
+				holds, err := binding.Holds(ctx)
+				if err != nil {
+					t.Fatalf("recordBinding.Holds() error: %v", err)
+				}
+				if holds != tt.wantHolds[i] {
+					t.Errorf("recordBinding.Holds() for record %v = %v, want %v", i, holds, tt.wantHolds[i])
+				}
+				if merged := binding.merged != nil; merged != tt.wantMerged {
+					t.Errorf("recordBinding merges variables = %v, want %v", merged, tt.wantMerged)
+				}
+			}
+
+			binding := newRecordBinding(variables, tt.expressions, tt.formula)
+			for i, record := range tt.records {
+				if err := binding.Set(record); err != nil {
+					t.Fatalf("recordBinding.Set() error: %v", err)
+				}
+				check(binding, i)
+			}
+
+			binding = newRecordBinding(variables, tt.expressions, tt.formula)
+			for i, record := range tt.records {
+				batch := NewRecordBatchFromRecords(record.schema, []*Record{record})
+				if err := binding.SetBatchRow(batch, 0); err != nil {
+					t.Fatalf("recordBinding.SetBatchRow() error: %v", err)
+				}
+				check(binding, i)
+			}
+		})
+	}
+
+	binding := newRecordBinding(variables, []Expression{NewVariable("const")}, nil)
+	conflicting := NewRecordFromSchema(NewSchema([]octosql.VariableName{"const"}), []octosql.Value{octosql.MakeInt(1)})
+	if err := binding.Set(conflicting); err == nil {
+		t.Errorf("recordBinding.Set() with a field already defined as a variable didn't return an error")
+	}
+}
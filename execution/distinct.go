@@ -1,29 +1,42 @@
 package execution
 
 import (
+	"context"
+
 	"github.com/cube2222/octosql"
 	"github.com/mitchellh/hashstructure"
 	"github.com/pkg/errors"
 )
 
+// distinctSeqField tags spilled records with their position in the source.
+const distinctSeqField = octosql.VariableName("*distinct_seq*")
+
 type Distinct struct {
-	child Node
+	child       Node
+	memoryLimit int
 }
 
-func NewDistinct(child Node) *Distinct {
-	return &Distinct{child: child}
+// NewDistinct creates a distinct, which keeps records using up to approximately memoryLimit bytes in memory.
+// Unseen records which don't fit get hash partitioned and spilled to disk,
+// the partitions are then deduplicated one at a time after the source ends.
+// Records are returned in the order of their first occurrence, so the records deduplicated out of the partitions
+// are spilled tagged with their position in the source, and merged by it afterwards.
+func NewDistinct(child Node, memoryLimit int) *Distinct {
+	return &Distinct{child: child, memoryLimit: memoryLimit}
 }
 
-func (node *Distinct) Get(variables octosql.Variables) (RecordStream, error) {
-	stream, err := node.child.Get(variables)
+func (node *Distinct) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	stream, err := node.child.Get(ctx, variables)
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't get stream for child node in distinct")
 	}
 
 	return &DistinctStream{
-		stream:    stream,
-		variables: variables,
-		records:   newRecordSet(),
+		stream:      stream,
+		variables:   variables,
+		records:     newRecordSet(),
+		memoryLimit: node.memoryLimit,
+		budget:      MemoryBudgetFromContext(ctx),
 	}, nil
 }
 
@@ -31,9 +44,54 @@ type DistinctStream struct {
 	stream    RecordStream
 	variables octosql.Variables
 	records   *recordSet
+
+	memoryLimit int
+	// memoryUsed is the approximate size of the records in the record set, it's reserved in the budget.
+	memoryUsed int
+	budget     *MemoryBudget
+	level      int
+
+	// partition is the spilled partition currently being read instead of the underlying stream.
+	partition *spillFile
+	// partitions contains the records spilled on the current level.
+	partitions *spillPartitions
+	// pending contains the spilled partitions which haven't been deduplicated yet.
+	pending []spilledPartition
+	// spilling is set once a record got spilled on the current level, from then on all unseen records get spilled,
+	// so that a record is never both returned from memory and spilled.
+	// That also means all the records returned from memory precede the spilled ones in the source.
+	spilling bool
+	// seq is the position of the next source record.
+	seq int
+
+	// run contains the records deduplicated out of the current partition, keyed by their position in the source.
+	run []sortedRecord
+	// runs contain the records deduplicated out of the finished partitions, sorted by their position in the source.
+	runs []*sortRunFile
+	// merged merges the runs, once all the partitions have been deduplicated.
+	merged *MergedSortRunsStream
 }
 
 func (ds *DistinctStream) Close() error {
+	ds.budget.Release(ds.memoryUsed)
+	ds.memoryUsed = 0
+	closeSpilledPartitions(ds.pending)
+	ds.pending = nil
+	if ds.partitions != nil {
+		ds.partitions.Close()
+		ds.partitions = nil
+	}
+	if ds.partition != nil {
+		ds.partition.Close()
+		ds.partition = nil
+	}
+	closeSortRunFiles(ds.runs)
+	ds.runs = nil
+	if ds.merged != nil {
+		ds.merged.Close()
+		ds.merged = nil
+	}
+
 	err := ds.stream.Close()
 	if err != nil {
 		return errors.Wrap(err, "Couldn't close underlying stream")
@@ -42,12 +100,42 @@ func (ds *DistinctStream) Close() error {
 	return nil
 }
 
-func (ds *DistinctStream) Next() (*Record, error) {
+func (ds *DistinctStream) Next(ctx context.Context) (*Record, error) {
 	for {
-		record, err := ds.stream.Next()
+		if ds.merged != nil {
+			record, err := ds.merged.Next(ctx)
+			if err != nil {
+				if err == ErrEndOfStream {
+					return nil, ErrEndOfStream
+				}
+				return nil, errors.Wrap(err, "couldn't get deduplicated spilled record")
+			}
+			return record, nil
+		}
+
+		var record *Record
+		var seq octosql.Value
+		var err error
+		if ds.partition != nil {
+			record, err = ds.partition.Next(ctx)
+			if err == nil {
+				record, seq = untagSpilledRecord(record)
+			}
+		} else {
+			record, err = ds.stream.Next(ctx)
+			seq = octosql.MakeInt(ds.seq)
+			ds.seq++
+		}
 		if err != nil {
 			if err == ErrEndOfStream {
-				return nil, ErrEndOfStream
+				ok, err := ds.nextPartition()
+				if err != nil {
+					return nil, err
+				}
+				if !ok {
+					return nil, ErrEndOfStream
+				}
+				continue
 			}
 			return nil, errors.Wrap(err, "couldn't get record from stream in DistinctStream")
 		}
@@ -59,17 +147,116 @@ func (ds *DistinctStream) Next() (*Record, error) {
 		}
 
 		if !already {
+			size := approximateRecordSize(record)
+			if !ds.spilling && (ds.memoryUsed > ds.memoryLimit || !ds.budget.TryReserve(size)) {
+				if ds.memoryUsed == 0 {
+					// Spilling wouldn't help, as there's no record in memory to make room for this one.
+					return nil, ds.budget.Reserve(size, "distinct")
+				}
+				ds.spilling = true
+			}
+			if ds.spilling {
+				if ds.partitions == nil {
+					ds.partitions = newSpillPartitions(ds.level)
+				}
+				err := ds.partitions.Write(octosql.MakeTuple(record.data), tagSpilledRecord(record, seq))
+				if err != nil {
+					return nil, errors.Wrap(err, "couldn't spill record")
+				}
+				continue
+			}
+
+			ds.memoryUsed += size
 			_, err := ds.records.Insert(record)
 
 			if err != nil {
 				return nil, errors.Wrap(err, "couldn't access the record set")
 			}
 
+			if ds.partition != nil {
+				ds.run = append(ds.run, sortedRecord{key: octosql.Tuple{seq}, record: record})
+				continue
+			}
 			return record, nil
 		}
 	}
 }
 
+func tagSpilledRecord(record *Record, seq octosql.Value) *Record {
+	fields := make([]octosql.VariableName, len(record.schema.fields)+1)
+	copy(fields, record.schema.fields)
+	fields[len(fields)-1] = distinctSeqField
+	data := make([]octosql.Value, len(record.data)+1)
+	copy(data, record.data)
+	data[len(data)-1] = seq
+
+	return NewRecordFromSlice(fields, data)
+}
+
+func untagSpilledRecord(record *Record) (*Record, octosql.Value) {
+	last := len(record.data) - 1
+	return NewRecordFromSlice(record.schema.fields[:last], record.data[:last]), record.data[last]
+}
+
+// nextPartition switches over to reading the next pending spilled partition, with a fresh record set.
+// Once all the partitions have been deduplicated, it switches over to merging their records.
+// It returns false if there's nothing more to read.
+func (ds *DistinctStream) nextPartition() (bool, error) {
+	if ds.partitions != nil {
+		spilled, err := ds.partitions.Finish()
+		ds.partitions = nil
+		if err != nil {
+			return false, errors.Wrap(err, "couldn't finish spilled partitions")
+		}
+		ds.pending = append(ds.pending, spilled...)
+	}
+
+	if ds.partition != nil {
+		err := ds.partition.Close()
+		ds.partition = nil
+		if err != nil {
+			return false, errors.Wrap(err, "couldn't close spilled partition")
+		}
+	}
+
+	if len(ds.run) > 0 {
+		run, err := spillSortRun(ds.run, []OrderDirection{Ascending})
+		ds.run = nil
+		if err != nil {
+			return false, errors.Wrap(err, "couldn't spill deduplicated records of partition")
+		}
+		ds.runs = append(ds.runs, run)
+	}
+
+	ds.records = newRecordSet()
+	ds.spilling = false
+	ds.budget.Release(ds.memoryUsed)
+	ds.memoryUsed = 0
+
+	if len(ds.pending) == 0 {
+		if len(ds.runs) == 0 {
+			return false, nil
+		}
+
+		merged, err := newMergedSortRunsStream(ds.runs, []OrderDirection{Ascending})
+		if err != nil {
+			return false, errors.Wrap(err, "couldn't merge deduplicated records of partitions")
+		}
+		ds.runs = nil
+		ds.merged = merged
+
+		return true, nil
+	}
+
+	partition := ds.pending[0]
+	ds.pending = ds.pending[1:]
+
+	ds.partition = partition.file
+	ds.level = partition.level
+
+	return true, nil
+}
+
 type recordSet struct {
 	set map[uint64][]*Record
 }
@@ -0,0 +1,86 @@
+package execution
+
+import (
+	"context"
+
+	"github.com/cube2222/octosql"
+	"github.com/pkg/errors"
+)
+
+// DistinctOn keeps only the first record for each distinct value of the key expressions.
+// Which record is first is decided by the ordering of the source stream.
+type DistinctOn struct {
+	key    []Expression
+	source Node
+}
+
+func NewDistinctOn(key []Expression, source Node) *DistinctOn {
+	return &DistinctOn{key: key, source: source}
+}
+
+func (node *DistinctOn) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	stream, err := node.source.Get(ctx, variables)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get stream for source node in distinct on")
+	}
+
+	return &DistinctOnStream{
+		stream:  stream,
+		binding: newRecordBinding(variables, node.key, nil),
+		seen:    NewHashMap(),
+	}, nil
+}
+
+type DistinctOnStream struct {
+	stream  RecordStream
+	binding *recordBinding
+	seen    *HashMap
+}
+
+func (ds *DistinctOnStream) Close() error {
+	err := ds.stream.Close()
+	if err != nil {
+		return errors.Wrap(err, "Couldn't close underlying stream")
+	}
+
+	return nil
+}
+
+func (ds *DistinctOnStream) Next(ctx context.Context) (*Record, error) {
+	for {
+		record, err := ds.stream.Next(ctx)
+		if err != nil {
+			if err == ErrEndOfStream {
+				return nil, ErrEndOfStream
+			}
+			return nil, errors.Wrap(err, "couldn't get record from stream in DistinctOnStream")
+		}
+
+		err = ds.binding.Set(record)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't merge stream variables with record")
+		}
+
+		key := make(octosql.Tuple, len(ds.binding.expressions))
+		for i := range key {
+			key[i], err = ds.binding.Value(ctx, i)
+			if err != nil {
+				return nil, errors.Wrapf(err, "couldn't evaluate distinct on key expression with index %v", i)
+			}
+		}
+
+		_, already, err := ds.seen.Get(key)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't access the seen keys hashmap")
+		}
+
+		if !already {
+			err := ds.seen.Set(key, octosql.Phantom{})
+			if err != nil {
+				return nil, errors.Wrap(err, "couldn't put distinct on key into hashmap")
+			}
+
+			return record, nil
+		}
+	}
+}
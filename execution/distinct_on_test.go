@@ -0,0 +1,113 @@
+package execution
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cube2222/octosql"
+)
+
+func TestDistinctOn_Get(t *testing.T) {
+	ctx := context.Background()
+	type args struct {
+		key    []Expression
+		source Node
+	}
+	tests := []struct {
+		name string
+		args args
+		want RecordStream
+	}{
+		{
+			name: "first record per key",
+			args: args{
+				key: []Expression{NewVariable("user_id")},
+				source: NewDummyNode([]*Record{
+					NewRecordFromSliceWithNormalize(
+						[]octosql.VariableName{"user_id", "ts"},
+						[]interface{}{1, 10}),
+					NewRecordFromSliceWithNormalize(
+						[]octosql.VariableName{"user_id", "ts"},
+						[]interface{}{1, 7}),
+					NewRecordFromSliceWithNormalize(
+						[]octosql.VariableName{"user_id", "ts"},
+						[]interface{}{2, 5}),
+					NewRecordFromSliceWithNormalize(
+						[]octosql.VariableName{"user_id", "ts"},
+						[]interface{}{1, 3}),
+					NewRecordFromSliceWithNormalize(
+						[]octosql.VariableName{"user_id", "ts"},
+						[]interface{}{2, 4}),
+				}),
+			},
+			want: NewInMemoryStream([]*Record{
+				NewRecordFromSliceWithNormalize(
+					[]octosql.VariableName{"user_id", "ts"},
+					[]interface{}{1, 10}),
+				NewRecordFromSliceWithNormalize(
+					[]octosql.VariableName{"user_id", "ts"},
+					[]interface{}{2, 5}),
+			}),
+		},
+		{
+			name: "multiple key expressions",
+			args: args{
+				key: []Expression{NewVariable("city"), NewVariable("age")},
+				source: NewDummyNode([]*Record{
+					NewRecordFromSliceWithNormalize(
+						[]octosql.VariableName{"name", "city", "age"},
+						[]interface{}{"Janek", "Warsaw", 3}),
+					NewRecordFromSliceWithNormalize(
+						[]octosql.VariableName{"name", "city", "age"},
+						[]interface{}{"Wojtek", "Warsaw", 4}),
+					NewRecordFromSliceWithNormalize(
+						[]octosql.VariableName{"name", "city", "age"},
+						[]interface{}{"Kuba", "Warsaw", 3}),
+					NewRecordFromSliceWithNormalize(
+						[]octosql.VariableName{"name", "city", "age"},
+						[]interface{}{"Adam", "Cracow", 3}),
+				}),
+			},
+			want: NewInMemoryStream([]*Record{
+				NewRecordFromSliceWithNormalize(
+					[]octosql.VariableName{"name", "city", "age"},
+					[]interface{}{"Janek", "Warsaw", 3}),
+				NewRecordFromSliceWithNormalize(
+					[]octosql.VariableName{"name", "city", "age"},
+					[]interface{}{"Wojtek", "Warsaw", 4}),
+				NewRecordFromSliceWithNormalize(
+					[]octosql.VariableName{"name", "city", "age"},
+					[]interface{}{"Adam", "Cracow", 3}),
+			}),
+		},
+		{
+			name: "empty stream",
+			args: args{
+				key:    []Expression{NewVariable("user_id")},
+				source: NewDummyNode(nil),
+			},
+			want: NewInMemoryStream([]*Record{}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream, err := NewDistinctOn(tt.args.key, tt.args.source).Get(ctx, octosql.NoVariables())
+			if err != nil {
+				t.Errorf("Error in Get(): %v", err)
+				return
+			}
+
+			equal, err := AreStreamsEqual(ctx, tt.want, stream)
+			if err != nil {
+				t.Errorf("Error in AreStreamsEqual(): %v", err)
+				return
+			}
+
+			if !equal {
+				t.Errorf("Streams don't match")
+				return
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package execution
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -8,6 +9,7 @@ import (
 )
 
 func TestDistinct_Get(t *testing.T) {
+	ctx := context.Background()
 	type args struct {
 		stream RecordStream
 	}
@@ -487,7 +489,7 @@ func TestDistinct_Get(t *testing.T) {
 				records:   newRecordSet(),
 			}
 
-			equal, err := AreStreamsEqualNoOrdering(tt.want, &distinct)
+			equal, err := AreStreamsEqualNoOrdering(ctx, tt.want, &distinct)
 			if err != nil {
 				t.Errorf("Error in AreStreamsEqual()")
 				return
@@ -500,3 +502,35 @@ func TestDistinct_Get(t *testing.T) {
 		})
 	}
 }
+
+func TestDistinct_Spill(t *testing.T) {
+	ctx := context.Background()
+	fields := []octosql.VariableName{"id", "name"}
+	var records []*Record
+	var expected []*Record
+	for i := 0; i < 200; i++ {
+		record := NewRecordFromSlice(fields, []octosql.Value{
+			octosql.MakeInt(i % 53),
+			octosql.MakeString(string(rune('a' + i%53%26))),
+		})
+		records = append(records, record)
+		if i < 53 {
+			expected = append(expected, record)
+		}
+	}
+
+	for _, memoryLimit := range []int{0, 500, 5000, DefaultGroupMemoryLimit} {
+		stream, err := NewDistinct(NewDummyNode(records), memoryLimit).Get(ctx, octosql.NoVariables())
+		if err != nil {
+			t.Fatalf("couldn't get distinct stream with memory limit %v: %v", memoryLimit, err)
+		}
+
+		equal, err := AreStreamsEqual(ctx, NewInMemoryStream(expected), stream)
+		if err != nil {
+			t.Errorf("Error in AreStreamsEqual(): %v", err)
+		}
+		if !equal {
+			t.Errorf("Streams don't match with memory limit %v", memoryLimit)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package execution
+
+import (
+	"context"
+
+	"github.com/cube2222/octosql"
+	"github.com/pkg/errors"
+)
+
+// DefaultExchangeBufferSize is the default number of records an exchange buffers between its producers and its consumer.
+const DefaultExchangeBufferSize = 256
+
+// Exchange runs its source subtree in a separate goroutine,
+// which reads ahead up to bufferSize records, while the consumer processes the previous ones.
+type Exchange struct {
+	source     Node
+	bufferSize int
+}
+
+func NewExchange(source Node, bufferSize int) *Exchange {
+	return &Exchange{source: source, bufferSize: bufferSize}
+}
+
+func (node *Exchange) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	source, err := node.source.Get(ctx, variables)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get source record stream")
+	}
+
+	return newExchangeStream(ctx, []RecordStream{source}, node.bufferSize), nil
+}
+
+// exchangeItem is a record or an error sent from a producer to the consumer.
+type exchangeItem struct {
+	record *Record
+	err    error
+}
+
+// ExchangeStream reads its sources concurrently, each in its own producer goroutine,
+// which sends the records through a bounded channel. Records of different sources get interleaved,
+// the records of a single source stay in order.
+type ExchangeStream struct {
+	sources []RecordStream
+	items   chan exchangeItem
+	cancel  context.CancelFunc
+}
+
+func newExchangeStream(ctx context.Context, sources []RecordStream, bufferSize int) *ExchangeStream {
+	ctx, cancel := context.WithCancel(ctx)
+
+	stream := &ExchangeStream{
+		sources: sources,
+		items:   make(chan exchangeItem, bufferSize),
+		cancel:  cancel,
+	}
+
+	producers := make(chan struct{}, len(sources))
+	for i := range sources {
+		go stream.produce(ctx, i, producers)
+	}
+	go func() {
+		for range sources {
+			<-producers
+		}
+		close(stream.items)
+	}()
+
+	return stream
+}
+
+// produce sends the records of the source with the given index, until the end of the source, an error or cancellation.
+func (stream *ExchangeStream) produce(ctx context.Context, index int, producers chan<- struct{}) {
+	defer func() {
+		producers <- struct{}{}
+	}()
+
+	for {
+		record, err := stream.sources[index].Next(ctx)
+		if err == ErrEndOfStream {
+			return
+		}
+		if err != nil {
+			err = errors.Wrapf(err, "couldn't get record of source with index %v", index)
+		}
+
+		select {
+		case stream.items <- exchangeItem{record: record, err: err}:
+		case <-ctx.Done():
+			return
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (stream *ExchangeStream) Next(ctx context.Context) (*Record, error) {
+	select {
+	case item, ok := <-stream.items:
+		if !ok {
+			return nil, ErrEndOfStream
+		}
+		if item.err != nil {
+			return nil, item.err
+		}
+		return item.record, nil
+	case <-ctx.Done():
+		return nil, errors.Wrap(ctx.Err(), "couldn't get next record")
+	}
+}
+
+// Close stops the producers and closes the sources after they've finished.
+func (stream *ExchangeStream) Close() error {
+	stream.cancel()
+	for range stream.items {
+	}
+
+	for i := range stream.sources {
+		err := stream.sources[i].Close()
+		if err != nil {
+			return errors.Wrapf(err, "Couldn't close source stream with index %v", i)
+		}
+	}
+
+	return nil
+}
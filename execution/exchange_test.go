@@ -0,0 +1,108 @@
+package execution
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cube2222/octosql"
+	"github.com/pkg/errors"
+)
+
+// failingStream returns its records and then an error.
+type failingStream struct {
+	records []*Record
+	err     error
+}
+
+func (stream *failingStream) Next(ctx context.Context) (*Record, error) {
+	if len(stream.records) == 0 {
+		return nil, stream.err
+	}
+	record := stream.records[0]
+	stream.records = stream.records[1:]
+	return record, nil
+}
+
+func (stream *failingStream) Close() error {
+	return nil
+}
+
+func TestExchangeStream(t *testing.T) {
+	ctx := context.Background()
+	fields := []octosql.VariableName{"id"}
+	newRecords := func(from, to int) []*Record {
+		var records []*Record
+		for i := from; i < to; i++ {
+			records = append(records, NewRecordFromSlice(fields, []octosql.Value{octosql.MakeInt(i)}))
+		}
+		return records
+	}
+
+	t.Run("parallel union all", func(t *testing.T) {
+		node := NewUnionAll(NewDummyNode(newRecords(0, 500)), NewDummyNode(newRecords(500, 1000)), true)
+		got, err := node.Get(ctx, octosql.NoVariables())
+		if err != nil {
+			t.Fatalf("couldn't get stream: %v", err)
+		}
+
+		equal, err := AreStreamsEqualNoOrdering(ctx, NewInMemoryStream(newRecords(0, 1000)), got)
+		if err != nil {
+			t.Errorf("Error in AreStreamsEqualNoOrdering(): %v", err)
+		}
+		if !equal {
+			t.Errorf("Streams don't match")
+		}
+	})
+
+	t.Run("source error", func(t *testing.T) {
+		sourceErr := errors.New("source error")
+		stream := newExchangeStream(ctx, []RecordStream{
+			NewInMemoryStream(newRecords(0, 10)),
+			&failingStream{records: newRecords(10, 20), err: sourceErr},
+		}, 4)
+		defer stream.Close()
+
+		for {
+			_, err := stream.Next(ctx)
+			if err == ErrEndOfStream {
+				t.Fatalf("expected source error")
+			}
+			if err != nil {
+				if errors.Cause(err) != sourceErr {
+					t.Errorf("got error %v, wanted %v", err, sourceErr)
+				}
+				break
+			}
+		}
+	})
+
+	t.Run("close before end of stream", func(t *testing.T) {
+		stream := newExchangeStream(ctx, []RecordStream{NewInMemoryStream(newRecords(0, 1000))}, 4)
+		if _, err := stream.Next(ctx); err != nil {
+			t.Fatalf("couldn't get first record: %v", err)
+		}
+		if err := stream.Close(); err != nil {
+			t.Errorf("couldn't close stream: %v", err)
+		}
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		stream := newExchangeStream(ctx, []RecordStream{NewInMemoryStream(newRecords(0, 1000))}, 4)
+		defer stream.Close()
+
+		cancel()
+		for {
+			_, err := stream.Next(ctx)
+			if err == ErrEndOfStream {
+				t.Fatalf("expected cancellation error")
+			}
+			if err != nil {
+				if errors.Cause(err) != context.Canceled {
+					t.Errorf("got error %v, wanted %v", err, context.Canceled)
+				}
+				break
+			}
+		}
+	})
+}
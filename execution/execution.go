@@ -1,16 +1,44 @@
 package execution
 
 import (
+	"context"
+	"sync"
+
 	"github.com/cube2222/octosql"
 	"github.com/pkg/errors"
 )
 
 type Node interface {
-	Get(variables octosql.Variables) (RecordStream, error)
+	Get(ctx context.Context, variables octosql.Variables) (RecordStream, error)
+}
+
+// BatchLookupNode is a node which can handle lookups for many sets of variables at once.
+// Lookup joins use it to issue a single query for a batch of source records.
+type BatchLookupNode interface {
+	Node
+	// GetBatch returns a record stream for each of the given sets of variables.
+	GetBatch(ctx context.Context, batch []octosql.Variables) ([]RecordStream, error)
+	// BatchSize is the maximum number of sets of variables in a single batch.
+	BatchSize() int
+}
+
+// PrefetchNode is a node which sets its own number of lookups, which lookup joins run concurrently.
+type PrefetchNode interface {
+	Node
+	// Prefetch is the number of source records to look up concurrently, zero means the global setting is used.
+	Prefetch() int
+}
+
+// LookupKeyNode is a node whose records only depend on a key computed out of the variables,
+// like the values of a data source's query placeholders.
+type LookupKeyNode interface {
+	Node
+	// LookupKey returns the key of the records returned for the variables, equal keys mean equal records.
+	LookupKey(ctx context.Context, variables octosql.Variables) (octosql.Value, error)
 }
 
 type Expression interface {
-	ExpressionValue(variables octosql.Variables) (octosql.Value, error)
+	ExpressionValue(ctx context.Context, variables octosql.Variables) (octosql.Value, error)
 }
 
 type NamedExpression interface {
@@ -18,6 +46,9 @@ type NamedExpression interface {
 	Name() octosql.VariableName
 }
 
+// Variable gets the value of the variable with its name.
+// Operators evaluating expressions for records resolve variables of record fields to their indices with a recordBinding instead,
+// so the variables only get looked up by name outside of records, like for limits or in subqueries.
 type Variable struct {
 	name octosql.VariableName
 }
@@ -26,7 +57,7 @@ func NewVariable(name octosql.VariableName) *Variable {
 	return &Variable{name: name}
 }
 
-func (v *Variable) ExpressionValue(variables octosql.Variables) (octosql.Value, error) {
+func (v *Variable) ExpressionValue(ctx context.Context, variables octosql.Variables) (octosql.Value, error) {
 	val, err := variables.Get(v.name)
 	if err != nil {
 		return nil, errors.Wrapf(err, "couldn't get variable %+v, available variables %+v", v.name, variables)
@@ -46,10 +77,10 @@ func NewTuple(expressions []Expression) *TupleExpression {
 	return &TupleExpression{expressions: expressions}
 }
 
-func (tup *TupleExpression) ExpressionValue(variables octosql.Variables) (octosql.Value, error) {
+func (tup *TupleExpression) ExpressionValue(ctx context.Context, variables octosql.Variables) (octosql.Value, error) {
 	outValues := make(octosql.Tuple, len(tup.expressions))
 	for i, expr := range tup.expressions {
-		value, err := expr.ExpressionValue(variables)
+		value, err := expr.ExpressionValue(ctx, variables)
 		if err != nil {
 			return nil, errors.Wrapf(err, "couldn't get tuple subexpression with index %v", i)
 		}
@@ -67,8 +98,72 @@ func NewNodeExpression(node Node) *NodeExpression {
 	return &NodeExpression{node: node}
 }
 
-func (ne *NodeExpression) ExpressionValue(variables octosql.Variables) (octosql.Value, error) {
-	records, err := ne.node.Get(variables)
+// maxCachedSubqueryResults is the maximum number of results kept by a CachedNodeExpression.
+// The cache is cleared once it's full.
+const maxCachedSubqueryResults = 4096
+
+// CachedNodeExpression is a NodeExpression which caches its results by the values of the outer variables its node references.
+// The referenced variables are all the variables used in the node, the ones not given on evaluation are defined inside of it.
+// A subquery referencing no outer variables is this way evaluated only once per query.
+type CachedNodeExpression struct {
+	*NodeExpression
+	referenced []octosql.VariableName
+
+	mutex   sync.Mutex
+	results *HashMap
+	count   int
+}
+
+func NewCachedNodeExpression(node Node, referenced []octosql.VariableName) *CachedNodeExpression {
+	return &CachedNodeExpression{
+		NodeExpression: NewNodeExpression(node),
+		referenced:     referenced,
+		results:        NewHashMap(),
+	}
+}
+
+func (ne *CachedNodeExpression) ExpressionValue(ctx context.Context, variables octosql.Variables) (octosql.Value, error) {
+	key := make([]octosql.Value, 0, len(ne.referenced)*2)
+	for _, name := range ne.referenced {
+		if value, ok := variables[name]; ok {
+			key = append(key, octosql.MakeString(name.String()), value)
+		}
+	}
+	cacheKey := octosql.MakeTuple(key)
+
+	ne.mutex.Lock()
+	cached, ok, err := ne.results.Get(cacheKey)
+	ne.mutex.Unlock()
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get cached subquery result")
+	}
+	if ok {
+		// Subqueries returning no records have a nil value.
+		value, _ := cached.(octosql.Value)
+		return value, nil
+	}
+
+	value, err := ne.NodeExpression.ExpressionValue(ctx, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	ne.mutex.Lock()
+	defer ne.mutex.Unlock()
+	if ne.count == maxCachedSubqueryResults {
+		ne.results = NewHashMap()
+		ne.count = 0
+	}
+	if err := ne.results.Set(cacheKey, value); err != nil {
+		return nil, errors.Wrap(err, "couldn't cache subquery result")
+	}
+	ne.count++
+
+	return value, nil
+}
+
+func (ne *NodeExpression) ExpressionValue(ctx context.Context, variables octosql.Variables) (octosql.Value, error) {
+	records, err := ne.node.Get(ctx, variables)
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't get record stream")
 	}
@@ -76,8 +171,21 @@ func (ne *NodeExpression) ExpressionValue(variables octosql.Variables) (octosql.
 	var firstRecord octosql.Tuple
 	outRecords := make(octosql.Tuple, 0)
 
+	// The records are reserved in the query memory budget while they're being materialized.
+	budget := MemoryBudgetFromContext(ctx)
+	reserved := 0
+	defer func() {
+		budget.Release(reserved)
+	}()
+
 	var curRecord *Record
-	for curRecord, err = records.Next(); err == nil; curRecord, err = records.Next() {
+	for curRecord, err = records.Next(ctx); err == nil; curRecord, err = records.Next(ctx) {
+		size, err := budget.ReserveRecord(curRecord, "subquery")
+		if err != nil {
+			return nil, err
+		}
+		reserved += size
+
 		if firstRecord == nil {
 			firstRecord = curRecord.AsTuple()
 		}
@@ -116,8 +224,8 @@ func NewLogicExpression(formula Formula) *LogicExpression {
 	}
 }
 
-func (le *LogicExpression) ExpressionValue(variables octosql.Variables) (octosql.Value, error) {
-	out, err := le.formula.Evaluate(variables)
+func (le *LogicExpression) ExpressionValue(ctx context.Context, variables octosql.Variables) (octosql.Value, error) {
+	out, err := le.formula.Evaluate(ctx, variables)
 	return octosql.MakeBool(out), err
 }
 
@@ -130,8 +238,8 @@ func NewAliasedExpression(name octosql.VariableName, expr Expression) *AliasedEx
 	return &AliasedExpression{name: name, expr: expr}
 }
 
-func (alExpr *AliasedExpression) ExpressionValue(variables octosql.Variables) (octosql.Value, error) {
-	return alExpr.expr.ExpressionValue(variables)
+func (alExpr *AliasedExpression) ExpressionValue(ctx context.Context, variables octosql.Variables) (octosql.Value, error) {
+	return alExpr.expr.ExpressionValue(ctx, variables)
 }
 
 func (alExpr *AliasedExpression) Name() octosql.VariableName {
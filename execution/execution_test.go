@@ -0,0 +1,78 @@
+package execution
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cube2222/octosql"
+)
+
+// countingNode returns a single record with the value of the variable x, and counts how many times it's been run.
+type countingNode struct {
+	gets int
+}
+
+func (node *countingNode) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	node.gets++
+	return NewInMemoryStream([]*Record{
+		NewRecordFromSlice([]octosql.VariableName{"out"}, []octosql.Value{variables["x"]}),
+	}), nil
+}
+
+func TestCachedNodeExpression(t *testing.T) {
+	tests := []struct {
+		name       string
+		referenced []octosql.VariableName
+		xs         []int
+		wantGets   int
+	}{
+		{
+			name:       "uncorrelated",
+			referenced: []octosql.VariableName{},
+			xs:         []int{1, 1, 1},
+			wantGets:   1,
+		},
+		{
+			name:       "correlated",
+			referenced: []octosql.VariableName{"x"},
+			xs:         []int{1, 2, 1, 3, 2},
+			wantGets:   3,
+		},
+		{
+			name:       "correlated with inner variable",
+			referenced: []octosql.VariableName{"inner", "x"},
+			xs:         []int{4, 4, 5},
+			wantGets:   2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			node := &countingNode{}
+			expr := NewCachedNodeExpression(node, tt.referenced)
+
+			for _, x := range tt.xs {
+				variables := octosql.NewVariables(map[octosql.VariableName]octosql.Value{
+					"x": octosql.MakeInt(x),
+					"y": octosql.MakeInt(len(tt.xs)),
+				})
+				got, err := expr.ExpressionValue(ctx, variables)
+				if err != nil {
+					t.Fatalf("ExpressionValue() error: %v", err)
+				}
+
+				want := octosql.MakeInt(x)
+				if len(tt.referenced) == 0 {
+					want = octosql.MakeInt(tt.xs[0])
+				}
+				if !octosql.AreEqual(got, want) {
+					t.Errorf("ExpressionValue() = %v, want %v", got, want)
+				}
+			}
+
+			if node.gets != tt.wantGets {
+				t.Errorf("node was run %v times, want %v", node.gets, tt.wantGets)
+			}
+		})
+	}
+}
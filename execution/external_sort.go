@@ -0,0 +1,216 @@
+package execution
+
+import (
+	"container/heap"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/cube2222/octosql"
+	"github.com/pkg/errors"
+)
+
+// sortRunFile is a sorted run of records spilled to a temporary file.
+type sortRunFile struct {
+	file    *os.File
+	decoder *recordDecoder
+	index   int
+	current sortedRecord
+}
+
+// spillSortRun sorts the run and writes it to a temporary file, from which it can then be read back.
+func spillSortRun(run []sortedRecord, directions []OrderDirection) (*sortRunFile, error) {
+	err := sortRun(run, directions)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't sort records")
+	}
+
+	file, err := ioutil.TempFile("", "octosql-sort-")
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't create temporary file")
+	}
+	runFile := &sortRunFile{
+		file: file,
+	}
+
+	encoder := newRecordEncoder(file)
+	for i := range run {
+		err := encoder.EncodeValue(run[i].key)
+		if err != nil {
+			runFile.Close()
+			return nil, errors.Wrap(err, "couldn't encode sort key")
+		}
+		err = encoder.EncodeRecord(run[i].record)
+		if err != nil {
+			runFile.Close()
+			return nil, errors.Wrap(err, "couldn't encode record")
+		}
+	}
+	err = encoder.Flush()
+	if err != nil {
+		runFile.Close()
+		return nil, errors.Wrap(err, "couldn't write to temporary file")
+	}
+
+	_, err = file.Seek(0, io.SeekStart)
+	if err != nil {
+		runFile.Close()
+		return nil, errors.Wrap(err, "couldn't rewind temporary file")
+	}
+	runFile.decoder = newRecordDecoder(file)
+
+	return runFile, nil
+}
+
+// next reads the next record of the run into current. It returns false at the end of the run.
+func (run *sortRunFile) next() (bool, error) {
+	key, err := run.decoder.DecodeValue()
+	if err != nil {
+		if errors.Cause(err) == io.EOF {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "couldn't decode sort key")
+	}
+	record, err := run.decoder.DecodeRecord()
+	if err != nil {
+		return false, errors.Wrap(err, "couldn't decode record")
+	}
+
+	run.current = sortedRecord{
+		key:    key.(octosql.Tuple),
+		record: record,
+	}
+	return true, nil
+}
+
+// Close closes and removes the temporary file.
+func (run *sortRunFile) Close() error {
+	err := run.file.Close()
+	if err != nil {
+		return errors.Wrap(err, "couldn't close temporary file")
+	}
+	err = os.Remove(run.file.Name())
+	if err != nil {
+		return errors.Wrap(err, "couldn't remove temporary file")
+	}
+
+	return nil
+}
+
+func closeSortRunFiles(runs []*sortRunFile) {
+	for i := range runs {
+		runs[i].Close()
+	}
+}
+
+// MergedSortRunsStream merges sorted runs, keeping only the current record of each run in memory.
+// Records with equal keys are returned in the order of their runs, which keeps the sort stable.
+type MergedSortRunsStream struct {
+	runs *sortRunHeap
+}
+
+func newMergedSortRunsStream(runs []*sortRunFile, directions []OrderDirection) (*MergedSortRunsStream, error) {
+	runHeap := &sortRunHeap{
+		directions: directions,
+	}
+	for i := range runs {
+		runs[i].index = i
+		ok, err := runs[i].next()
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't read first record of run %v", i)
+		}
+		if !ok {
+			runs[i].Close()
+			continue
+		}
+		runHeap.runs = append(runHeap.runs, runs[i])
+	}
+	heap.Init(runHeap)
+	if runHeap.err != nil {
+		return nil, runHeap.err
+	}
+
+	return &MergedSortRunsStream{
+		runs: runHeap,
+	}, nil
+}
+
+func (stream *MergedSortRunsStream) Close() error {
+	for _, run := range stream.runs.runs {
+		err := run.Close()
+		if err != nil {
+			return errors.Wrap(err, "couldn't close sorted run")
+		}
+	}
+	stream.runs.runs = nil
+
+	return nil
+}
+
+func (stream *MergedSortRunsStream) Next(ctx context.Context) (*Record, error) {
+	if len(stream.runs.runs) == 0 {
+		return nil, ErrEndOfStream
+	}
+
+	top := stream.runs.runs[0]
+	record := top.current.record
+
+	ok, err := top.next()
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't read next record of sorted run")
+	}
+	if ok {
+		heap.Fix(stream.runs, 0)
+	} else {
+		heap.Pop(stream.runs)
+		err := top.Close()
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't close sorted run")
+		}
+	}
+	if stream.runs.err != nil {
+		return nil, stream.runs.err
+	}
+
+	return record, nil
+}
+
+// sortRunHeap is a heap of sorted runs ordered by their current records.
+type sortRunHeap struct {
+	runs       []*sortRunFile
+	directions []OrderDirection
+	err        error
+}
+
+func (h *sortRunHeap) Len() int {
+	return len(h.runs)
+}
+
+func (h *sortRunHeap) Less(i, j int) bool {
+	cmp, err := compareSortKeys(h.runs[i].current.key, h.runs[j].current.key, h.directions)
+	if err != nil {
+		if h.err == nil {
+			h.err = errors.Wrap(err, "couldn't compare records of sorted runs")
+		}
+		return false
+	}
+	if cmp == 0 {
+		return h.runs[i].index < h.runs[j].index
+	}
+	return cmp < 0
+}
+
+func (h *sortRunHeap) Swap(i, j int) {
+	h.runs[i], h.runs[j] = h.runs[j], h.runs[i]
+}
+
+func (h *sortRunHeap) Push(x interface{}) {
+	h.runs = append(h.runs, x.(*sortRunFile))
+}
+
+func (h *sortRunHeap) Pop() interface{} {
+	last := h.runs[len(h.runs)-1]
+	h.runs = h.runs[:len(h.runs)-1]
+	return last
+}
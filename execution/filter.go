@@ -1,36 +1,63 @@
 package execution
 
 import (
+	"context"
+	"sync"
+
 	"github.com/cube2222/octosql"
 	"github.com/pkg/errors"
 )
 
+// Filter returns the source records which satisfy its formula.
+// With parallelism greater than 1, records are evaluated concurrently in batches by that many workers.
 type Filter struct {
-	formula Formula
-	source  Node
+	formula     Formula
+	source      Node
+	parallelism int
 }
 
-func NewFilter(formula Formula, child Node) *Filter {
-	return &Filter{formula: formula, source: child}
+func NewFilter(formula Formula, child Node, parallelism int) *Filter {
+	return &Filter{formula: formula, source: child, parallelism: parallelism}
 }
 
-func (node *Filter) Get(variables octosql.Variables) (RecordStream, error) {
-	recordStream, err := node.source.Get(variables)
+func (node *Filter) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	recordStream, err := node.source.Get(ctx, variables)
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't get record stream")
 	}
 
-	return &FilteredStream{
+	stream := &FilteredStream{
 		formula:   node.formula,
 		variables: variables,
 		source:    recordStream,
-	}, nil
+	}
+	if node.parallelism > 1 {
+		// Workers evaluate records concurrently, so each of them needs its own binding.
+		bindings := sync.Pool{
+			New: func() interface{} {
+				return newRecordBinding(variables, nil, node.formula)
+			},
+		}
+		filterRecord := func(ctx context.Context, record *Record) (*Record, error) {
+			binding := bindings.Get().(*recordBinding)
+			defer bindings.Put(binding)
+			return stream.filterRecord(ctx, binding, record)
+		}
+		return newParallelStream(ctx, recordStream, filterRecord, node.parallelism), nil
+	}
+
+	return stream, nil
 }
 
 type FilteredStream struct {
 	formula   Formula
 	variables octosql.Variables
 	source    RecordStream
+
+	// binding is reused for all the records, unless they're filtered by parallel workers.
+	binding *recordBinding
+
+	batches *BatchReader
 }
 
 func (stream *FilteredStream) Close() error {
@@ -42,9 +69,9 @@ func (stream *FilteredStream) Close() error {
 	return nil
 }
 
-func (stream *FilteredStream) Next() (*Record, error) {
+func (stream *FilteredStream) Next(ctx context.Context) (*Record, error) {
 	for {
-		record, err := stream.source.Next()
+		record, err := stream.source.Next(ctx)
 		if err != nil {
 			if err == ErrEndOfStream {
 				return nil, ErrEndOfStream
@@ -52,18 +79,80 @@ func (stream *FilteredStream) Next() (*Record, error) {
 			return nil, errors.Wrap(err, "couldn't get source record")
 		}
 
-		variables, err := stream.variables.MergeWith(record.AsVariables())
+		if stream.binding == nil {
+			stream.binding = newRecordBinding(stream.variables, nil, stream.formula)
+		}
+
+		filtered, err := stream.filterRecord(ctx, stream.binding, record)
 		if err != nil {
-			return nil, errors.Wrap(err, "couldn't merge given variables with record variables")
+			return nil, err
+		}
+
+		if filtered != nil {
+			return filtered, nil
 		}
+	}
+}
+
+// filterRecord returns the record if it satisfies the formula, nil otherwise.
+func (stream *FilteredStream) filterRecord(ctx context.Context, binding *recordBinding, record *Record) (*Record, error) {
+	err := binding.Set(record)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't merge given variables with record variables")
+	}
+
+	predicate, err := binding.Holds(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't evaluate formula")
+	}
+
+	if !predicate {
+		return nil, nil
+	}
+	return record, nil
+}
 
-		predicate, err := stream.formula.Evaluate(variables)
+// NextBatch evaluates the formula for a whole batch of source records at a time.
+func (stream *FilteredStream) NextBatch(ctx context.Context, maxSize int) (*RecordBatch, error) {
+	if stream.batches == nil {
+		stream.batches = NewBatchReader(stream.source)
+	}
+	if stream.binding == nil {
+		stream.binding = newRecordBinding(stream.variables, nil, stream.formula)
+	}
+
+	for {
+		batch, err := stream.batches.NextBatch(ctx, maxSize)
 		if err != nil {
-			return nil, errors.Wrap(err, "couldn't evaluate formula")
+			if err == ErrEndOfStream {
+				return nil, ErrEndOfStream
+			}
+			return nil, errors.Wrap(err, "couldn't get source record batch")
+		}
+
+		rows := make([]int, 0, batch.Len())
+		for row := 0; row < batch.Len(); row++ {
+			err := stream.binding.SetBatchRow(batch, row)
+			if err != nil {
+				return nil, errors.Wrap(err, "couldn't merge given variables with record variables")
+			}
+
+			predicate, err := stream.binding.Holds(ctx)
+			if err != nil {
+				return nil, errors.Wrap(err, "couldn't evaluate formula")
+			}
+			if predicate {
+				rows = append(rows, row)
+			}
 		}
 
-		if predicate {
-			return record, nil
+		switch len(rows) {
+		case 0:
+			continue
+		case batch.Len():
+			return batch, nil
+		default:
+			return batch.Filter(rows), nil
 		}
 	}
 }
@@ -1,12 +1,14 @@
 package execution
 
 import (
+	"context"
 	"testing"
 
 	"github.com/cube2222/octosql"
 )
 
 func TestFilteredStream_Next(t *testing.T) {
+	ctx := context.Background()
 	fieldNames := []octosql.VariableName{
 		octosql.NewVariableName("age"),
 		octosql.NewVariableName("something"),
@@ -171,7 +173,7 @@ func TestFilteredStream_Next(t *testing.T) {
 				variables: tt.fields.variables,
 				source:    tt.fields.source,
 			}
-			equal, err := AreStreamsEqual(stream, tt.want)
+			equal, err := AreStreamsEqual(ctx, stream, tt.want)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("FilteredStream.Next() error = %v, wantErr %v", err, tt.wantErr)
 				return
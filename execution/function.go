@@ -1,6 +1,7 @@
 package execution
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -49,10 +50,10 @@ func NewFunctionExpression(fun *Function, args []Expression) *FunctionExpression
 	}
 }
 
-func (fe *FunctionExpression) ExpressionValue(variables octosql.Variables) (octosql.Value, error) {
+func (fe *FunctionExpression) ExpressionValue(ctx context.Context, variables octosql.Variables) (octosql.Value, error) {
 	values := make([]octosql.Value, 0)
 	for i := range fe.arguments {
-		value, err := fe.arguments[i].ExpressionValue(variables)
+		value, err := fe.arguments[i].ExpressionValue(ctx, variables)
 		if err != nil {
 			return nil, errors.Wrap(err, "couldn't get argument's expression value")
 		}
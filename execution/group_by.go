@@ -1,6 +1,7 @@
 package execution
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/cube2222/octosql"
@@ -25,34 +26,39 @@ type GroupBy struct {
 	aggregatePrototypes []AggregatePrototype
 
 	as []octosql.VariableName
+
+	memoryLimit int
+	// memoryUsed is the approximate size of the current groups, it's reserved in the budget.
+	memoryUsed int
+	budget     *MemoryBudget
 }
 
-func NewGroupBy(source Node, key []Expression, fields []octosql.VariableName, aggregatePrototypes []AggregatePrototype, as []octosql.VariableName) *GroupBy {
-	return &GroupBy{source: source, key: key, fields: fields, aggregatePrototypes: aggregatePrototypes, as: as}
+// NewGroupBy creates a group by, which keeps groups using up to approximately memoryLimit bytes in memory.
+// Records of groups which don't fit get hash partitioned and spilled to disk,
+// the partitions are then aggregated one at a time after the in-memory groups are returned.
+func NewGroupBy(source Node, key []Expression, fields []octosql.VariableName, aggregatePrototypes []AggregatePrototype, as []octosql.VariableName, memoryLimit int) *GroupBy {
+	return &GroupBy{source: source, key: key, fields: fields, aggregatePrototypes: aggregatePrototypes, as: as, memoryLimit: memoryLimit}
 }
 
-func (node *GroupBy) Get(variables octosql.Variables) (RecordStream, error) {
-	source, err := node.source.Get(variables)
+func (node *GroupBy) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	source, err := node.source.Get(ctx, variables)
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't get stream for source in group by")
 	}
 
-	aggregates := make([]Aggregate, len(node.aggregatePrototypes))
-	for i := range node.aggregatePrototypes {
-		aggregates[i] = node.aggregatePrototypes[i]()
-	}
-
 	return &GroupByStream{
 		source:    source,
 		variables: variables,
 
-		key:    node.key,
-		groups: NewHashMap(),
+		key: node.key,
 
-		fields:     node.fields,
-		aggregates: aggregates,
+		fields:              node.fields,
+		aggregatePrototypes: node.aggregatePrototypes,
 
 		as: node.as,
+
+		memoryLimit: node.memoryLimit,
+		budget:      MemoryBudgetFromContext(ctx),
 	}, nil
 }
 
@@ -60,109 +66,223 @@ type GroupByStream struct {
 	source    RecordStream
 	variables octosql.Variables
 
-	key    []Expression
-	groups *HashMap
+	key []Expression
 
-	fields     []octosql.VariableName
-	aggregates []Aggregate
+	fields              []octosql.VariableName
+	aggregatePrototypes []AggregatePrototype
 
 	as []octosql.VariableName
 
-	fieldNames []octosql.VariableName
+	memoryLimit int
+	// memoryUsed is the approximate size of the current groups, it's reserved in the budget.
+	memoryUsed int
+	budget     *MemoryBudget
+
+	groups     *HashMap
+	aggregates []Aggregate
+	schema     *Schema
 	iterator   *Iterator
+
+	// pending contains the spilled partitions which haven't been aggregated yet.
+	pending []spilledPartition
+	// spilling is set once a group got spilled on the current level, from then on all new groups get spilled,
+	// so that a group is never both kept in memory and spilled.
+	spilling bool
 }
 
-func (stream *GroupByStream) Next() (*Record, error) {
+func (stream *GroupByStream) Next(ctx context.Context) (*Record, error) {
 	if stream.iterator == nil {
-		for {
-			record, err := stream.source.Next()
-			if err != nil {
-				if err == ErrEndOfStream {
-					stream.fieldNames = make([]octosql.VariableName, len(stream.fields))
-					for i := range stream.fields {
-						if len(stream.as[i]) > 0 {
-							stream.fieldNames[i] = stream.as[i]
-						} else {
-							stream.fieldNames[i] = octosql.NewVariableName(
-								fmt.Sprintf(
-									"%s_%s",
-									stream.fields[i].String(),
-									stream.aggregates[i].String(),
-								),
-							)
-						}
-					}
-					stream.iterator = stream.groups.GetIterator()
-					break
-				}
-				return nil, errors.Wrap(err, "couldn't get next source record")
-			}
+		err := stream.aggregate(ctx, stream.source, 0)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-			variables, err := stream.variables.MergeWith(record.AsVariables())
-			if err != nil {
-				return nil, errors.Wrap(err, "couldn't merge stream variables with record")
-			}
+	for {
+		key, _, ok := stream.iterator.Next()
+		if ok {
+			typedKey := key.(octosql.Tuple)
 
-			key := make(octosql.Tuple, len(stream.key))
-			for i := range stream.key {
-				key[i], err = stream.key[i].ExpressionValue(variables)
+			values := make([]octosql.Value, len(stream.aggregates))
+			for i := range stream.aggregates {
+				var err error
+				values[i], err = stream.aggregates[i].GetAggregated(typedKey)
 				if err != nil {
-					return nil, errors.Wrapf(err, "couldn't evaluate group key expression with index %v", i)
+					return nil, errors.Wrap(err, "couldn't get aggregate value")
 				}
 			}
 
-			if len(key) == 0 {
-				key = append(key, octosql.Phantom{})
+			return NewRecordFromSchema(stream.schema, values), nil
+		}
+
+		if len(stream.pending) == 0 {
+			return nil, ErrEndOfStream
+		}
+
+		partition := stream.pending[0]
+		stream.pending = stream.pending[1:]
+
+		err := stream.aggregate(ctx, partition.file, partition.level)
+		partition.file.Close()
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't aggregate spilled partition")
+		}
+	}
+}
+
+// aggregate aggregates the records of the source into fresh groups.
+// When the groups exceed the memory limit, records of new groups are spilled to partitions,
+// which get added to the pending ones.
+func (stream *GroupByStream) aggregate(ctx context.Context, source RecordStream, level int) error {
+	stream.groups = NewHashMap()
+	stream.budget.Release(stream.memoryUsed)
+	stream.memoryUsed = 0
+	stream.aggregates = make([]Aggregate, len(stream.aggregatePrototypes))
+	for i := range stream.aggregatePrototypes {
+		stream.aggregates[i] = stream.aggregatePrototypes[i]()
+	}
+	stream.iterator = nil
+	stream.spilling = false
+
+	if stream.schema == nil {
+		fieldNames := make([]octosql.VariableName, len(stream.fields))
+		for i := range stream.fields {
+			if len(stream.as[i]) > 0 {
+				fieldNames[i] = stream.as[i]
+			} else {
+				fieldNames[i] = octosql.NewVariableName(
+					fmt.Sprintf(
+						"%s_%s",
+						stream.fields[i].String(),
+						stream.aggregates[i].String(),
+					),
+				)
 			}
+		}
+		stream.schema = NewSchema(fieldNames)
+	}
 
-			err = stream.groups.Set(key, octosql.Phantom{})
+	partitions := newSpillPartitions(level)
+
+	batches := NewBatchReader(source)
+	for {
+		batch, err := batches.NextBatch(ctx, DefaultBatchSize)
+		if err != nil {
+			if err == ErrEndOfStream {
+				break
+			}
+			partitions.Close()
+			return errors.Wrap(err, "couldn't get next source record batch")
+		}
+
+		err = stream.aggregateBatch(ctx, batch, partitions)
+		if err != nil {
+			partitions.Close()
+			return err
+		}
+	}
+
+	spilled, err := partitions.Finish()
+	if err != nil {
+		return errors.Wrap(err, "couldn't finish spilled partitions")
+	}
+	stream.pending = append(stream.pending, spilled...)
+	stream.iterator = stream.groups.GetIterator()
+
+	return nil
+}
+
+// aggregateBatch adds the records of the batch to their groups.
+// Records of new groups get spilled to the partitions instead, once the groups exceed the memory limit,
+// or don't fit in the query memory budget.
+func (stream *GroupByStream) aggregateBatch(ctx context.Context, batch *RecordBatch, partitions *spillPartitions) error {
+	binding := newRecordBinding(stream.variables, stream.key, nil)
+
+	// Indices of the aggregated fields in the batch, -1 for missing fields.
+	fieldIndices := make([]int, len(stream.fields))
+	for i := range stream.fields {
+		fieldIndices[i] = batch.schema.Index(stream.fields[i])
+	}
+
+	for row := 0; row < batch.Len(); row++ {
+		err := binding.SetBatchRow(batch, row)
+		if err != nil {
+			return errors.Wrap(err, "couldn't merge stream variables with record")
+		}
+
+		key := make(octosql.Tuple, len(stream.key))
+		for i := range stream.key {
+			key[i], err = binding.Value(ctx, i)
 			if err != nil {
-				return nil, errors.Wrap(err, "couldn't put group key into hashmap")
+				return errors.Wrapf(err, "couldn't evaluate group key expression with index %v", i)
 			}
+		}
 
-			for i := range stream.aggregates {
-				var value octosql.Value
-				if stream.fields[i] == "*star*" {
-					mapping := make(octosql.Object, len(record.Fields()))
-					for _, field := range record.Fields() {
-						mapping[field.Name.String()] = record.Value(field.Name)
-					}
-					value = mapping
-
-				} else {
-					value = record.Value(stream.fields[i])
+		if len(key) == 0 {
+			key = append(key, octosql.Phantom{})
+		}
+
+		_, exists, err := stream.groups.Get(key)
+		if err != nil {
+			return errors.Wrap(err, "couldn't get group key from hashmap")
+		}
+
+		if !exists {
+			groupSize := approximateValueSize(key) + 64*len(stream.aggregates)
+			if !stream.spilling && (stream.memoryUsed > stream.memoryLimit || !stream.budget.TryReserve(groupSize)) {
+				if stream.memoryUsed == 0 {
+					// Spilling wouldn't help, as there's no group in memory to make room for this one.
+					return stream.budget.Reserve(groupSize, "group by")
 				}
-				err := stream.aggregates[i].AddRecord(key, value)
+				stream.spilling = true
+			}
+			if stream.spilling {
+				err := partitions.Write(key, batch.Record(row))
 				if err != nil {
-					return nil, errors.Wrapf(
-						err,
-						"couldn't add record value to aggregate %s with index %v",
-						stream.aggregates[i].String(),
-						i,
-					)
+					return errors.Wrap(err, "couldn't spill record")
 				}
+				continue
+			}
+			stream.memoryUsed += groupSize
+
+			err = stream.groups.Set(key, octosql.Phantom{})
+			if err != nil {
+				return errors.Wrap(err, "couldn't put group key into hashmap")
 			}
 		}
-	}
 
-	key, _, ok := stream.iterator.Next()
-	if !ok {
-		return nil, ErrEndOfStream
-	}
-	typedKey := key.(octosql.Tuple)
+		for i := range stream.aggregates {
+			var value octosql.Value
+			if stream.fields[i] == "*star*" {
+				mapping := make(octosql.Object, len(batch.Fields()))
+				for j, name := range batch.Fields() {
+					mapping[name.String()] = batch.Column(j)[row]
+				}
+				value = mapping
 
-	values := make([]octosql.Value, len(stream.aggregates))
-	for i := range stream.aggregates {
-		var err error
-		values[i], err = stream.aggregates[i].GetAggregated(typedKey)
-		if err != nil {
-			return nil, errors.Wrap(err, "couldn't get aggregate value")
+			} else if fieldIndices[i] != -1 {
+				value = batch.Column(fieldIndices[i])[row]
+			}
+			err := stream.aggregates[i].AddRecord(key, value)
+			if err != nil {
+				return errors.Wrapf(
+					err,
+					"couldn't add record value to aggregate %s with index %v",
+					stream.aggregates[i].String(),
+					i,
+				)
+			}
 		}
 	}
 
-	return NewRecordFromSlice(stream.fieldNames, values), nil
+	return nil
 }
 
 func (stream *GroupByStream) Close() error {
+	closeSpilledPartitions(stream.pending)
+	stream.pending = nil
+	stream.budget.Release(stream.memoryUsed)
+	stream.memoryUsed = 0
+
 	return stream.source.Close()
 }
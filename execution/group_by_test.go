@@ -1,6 +1,7 @@
 package execution
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -50,6 +51,7 @@ func (*AggregateMock) String() string {
 }
 
 func TestGroupBy_AggregateCalling(t *testing.T) {
+	ctx := context.Background()
 	fields := []octosql.VariableName{"cat", "livesleft", "ownerid"}
 
 	firstAggregate := &AggregateMock{
@@ -119,12 +121,15 @@ func TestGroupBy_AggregateCalling(t *testing.T) {
 			NewRecordFromSliceWithNormalize(fields, []interface{}{"Tiger", 4, 3}),
 			NewRecordFromSliceWithNormalize(fields, []interface{}{"Lucy", 3, 3}),
 		}),
-		variables:  octosql.NoVariables(),
-		key:        []Expression{NewVariable("ownerid")},
-		groups:     NewHashMap(),
-		fields:     []octosql.VariableName{"cat", "livesleft"},
-		aggregates: []Aggregate{firstAggregate, secondAggregate},
-		as:         []octosql.VariableName{"", "lives_left"},
+		variables: octosql.NoVariables(),
+		key:       []Expression{NewVariable("ownerid")},
+		fields:    []octosql.VariableName{"cat", "livesleft"},
+		aggregatePrototypes: []AggregatePrototype{
+			func() Aggregate { return firstAggregate },
+			func() Aggregate { return secondAggregate },
+		},
+		as:          []octosql.VariableName{"", "lives_left"},
+		memoryLimit: DefaultGroupMemoryLimit,
 	}
 
 	outFields := []octosql.VariableName{"cat_mock", "lives_left"}
@@ -137,7 +142,7 @@ func TestGroupBy_AggregateCalling(t *testing.T) {
 	var rec *Record
 	var err error
 	i := 0
-	for rec, err = groupby.Next(); err == nil; rec, err = groupby.Next() {
+	for rec, err = groupby.Next(ctx); err == nil; rec, err = groupby.Next(ctx) {
 		if !reflect.DeepEqual(rec, expectedOutput[i]) {
 			t.Errorf("Record got %+v wanted %+v", rec, expectedOutput[i])
 			return
@@ -163,3 +168,85 @@ func TestGroupBy_AggregateCalling(t *testing.T) {
 		t.Errorf("invalid secondAggregate get call count: still waiting for %v", secondAggregate.getKeySet)
 	}
 }
+
+// sumAggregate sums up integer values for each key.
+type sumAggregate struct {
+	sums *HashMap
+}
+
+func newSumAggregate() Aggregate {
+	return &sumAggregate{sums: NewHashMap()}
+}
+
+func (agg *sumAggregate) Document() docs.Documentation {
+	panic("implement me")
+}
+
+func (agg *sumAggregate) AddRecord(key octosql.Tuple, value octosql.Value) error {
+	sum, ok, err := agg.sums.Get(key)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		sum = octosql.MakeInt(0)
+	}
+	return agg.sums.Set(key, sum.(octosql.Int)+value.(octosql.Int))
+}
+
+func (agg *sumAggregate) GetAggregated(key octosql.Tuple) (octosql.Value, error) {
+	sum, _, err := agg.sums.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	return sum.(octosql.Value), nil
+}
+
+func (*sumAggregate) String() string {
+	return "sum"
+}
+
+func TestGroupBy_Spill(t *testing.T) {
+	ctx := context.Background()
+	fields := []octosql.VariableName{"ownerid", "livesleft"}
+	var records []*Record
+	sums := make(map[int]int)
+	for i := 0; i < 200; i++ {
+		records = append(records, NewRecordFromSlice(fields, []octosql.Value{
+			octosql.MakeInt(i % 37),
+			octosql.MakeInt(i),
+		}))
+		sums[i%37] += i
+	}
+
+	outFields := []octosql.VariableName{"livesleft_sum"}
+	var expected []*Record
+	for _, sum := range sums {
+		expected = append(expected, NewRecordFromSlice(outFields, []octosql.Value{
+			octosql.MakeInt(sum),
+		}))
+	}
+
+	for _, memoryLimit := range []int{0, 500, 5000, DefaultGroupMemoryLimit} {
+		groupBy := NewGroupBy(
+			NewDummyNode(records),
+			[]Expression{NewVariable("ownerid")},
+			[]octosql.VariableName{"livesleft"},
+			[]AggregatePrototype{newSumAggregate},
+			[]octosql.VariableName{""},
+			memoryLimit,
+		)
+
+		stream, err := groupBy.Get(ctx, octosql.NoVariables())
+		if err != nil {
+			t.Fatalf("couldn't get group by stream with memory limit %v: %v", memoryLimit, err)
+		}
+
+		equal, err := AreStreamsEqualNoOrdering(ctx, NewInMemoryStream(expected), stream)
+		if err != nil {
+			t.Errorf("Error in AreStreamsEqualNoOrdering(): %v", err)
+		}
+		if !equal {
+			t.Errorf("Streams don't match with memory limit %v", memoryLimit)
+		}
+	}
+}
@@ -0,0 +1,276 @@
+package execution
+
+import (
+	"context"
+
+	"github.com/cube2222/octosql"
+	"github.com/pkg/errors"
+)
+
+type JoinType string
+
+const (
+	InnerJoinType JoinType = "inner"
+	LeftJoinType  JoinType = "left"
+	FullJoinType  JoinType = "full"
+)
+
+// HashJoin joins records on equal key values.
+// The joined node is read once into a hash map keyed by the joinedKey expressions,
+// which is then probed with the sourceKey expressions of each source record.
+// Matching pairs must additionally satisfy the filter formula.
+// Left and full joins also return unmatched source records,
+// full joins also return unmatched joined records after all the source records.
+type HashJoin struct {
+	source    Node
+	joined    Node
+	sourceKey []Expression
+	joinedKey []Expression
+	filter    Formula
+	joinType  JoinType
+}
+
+func NewHashJoin(source Node, joined Node, sourceKey []Expression, joinedKey []Expression, filter Formula, joinType JoinType) *HashJoin {
+	return &HashJoin{
+		source:    source,
+		joined:    joined,
+		sourceKey: sourceKey,
+		joinedKey: joinedKey,
+		filter:    filter,
+		joinType:  joinType,
+	}
+}
+
+func (node *HashJoin) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	joinedStream, err := node.joined.Get(ctx, variables)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get joined record stream")
+	}
+
+	table, buckets, err := node.buildTable(ctx, variables, joinedStream)
+	closeErr := joinedStream.Close()
+	if err != nil {
+		return nil, err
+	}
+	if closeErr != nil {
+		return nil, errors.Wrap(closeErr, "couldn't close joined record stream")
+	}
+
+	source, err := node.source.Get(ctx, variables)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get source record stream")
+	}
+
+	return &HashJoinedStream{
+		sourceBinding: newRecordBinding(variables, node.sourceKey, nil),
+		filterBinding: newRecordBinding(variables, nil, node.filter),
+		source:        source,
+		joinType:      node.joinType,
+		table:         table,
+		buckets:       buckets,
+	}, nil
+}
+
+// buildTable reads the joined records into buckets of records with equal keys.
+func (node *HashJoin) buildTable(ctx context.Context, variables octosql.Variables, joinedStream RecordStream) (*HashMap, []*hashJoinBucket, error) {
+	table := NewHashMap()
+	joinedBinding := newRecordBinding(variables, node.joinedKey, nil)
+	var buckets []*hashJoinBucket
+	for {
+		record, err := joinedStream.Next(ctx)
+		if err != nil {
+			if err == ErrEndOfStream {
+				break
+			}
+			return nil, nil, errors.Wrap(err, "couldn't get joined record")
+		}
+
+		key, err := evaluateJoinKey(ctx, joinedBinding, record)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "couldn't evaluate joined record key")
+		}
+		if key == nil {
+			// Null keys never match, they're only interesting for full joins.
+			if node.joinType == FullJoinType {
+				buckets = append(buckets, &hashJoinBucket{records: []*Record{record}, matched: []bool{false}})
+			}
+			continue
+		}
+
+		data, ok, err := table.Get(key)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "couldn't get bucket out of hash join table")
+		}
+		if !ok {
+			data = &hashJoinBucket{}
+			err := table.Set(key, data)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "couldn't put bucket into hash join table")
+			}
+			buckets = append(buckets, data.(*hashJoinBucket))
+		}
+		bucket := data.(*hashJoinBucket)
+		bucket.records = append(bucket.records, record)
+		bucket.matched = append(bucket.matched, false)
+	}
+
+	return table, buckets, nil
+}
+
+type hashJoinBucket struct {
+	records []*Record
+	matched []bool
+}
+
+// evaluateJoinKey returns nil if any of the key values is null.
+func evaluateJoinKey(ctx context.Context, binding *recordBinding, record *Record) (octosql.Tuple, error) {
+	err := binding.Set(record)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't merge given variables with record variables")
+	}
+
+	key := make(octosql.Tuple, len(binding.expressions))
+	for i := range key {
+		value, err := binding.Value(ctx, i)
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't evaluate key expression with index %v", i)
+		}
+		if value == nil {
+			return nil, nil
+		}
+		key[i] = value
+	}
+
+	return key, nil
+}
+
+type HashJoinedStream struct {
+	sourceBinding *recordBinding
+	filterBinding *recordBinding
+	source        RecordStream
+	joinType      JoinType
+	table         *HashMap
+	buckets       []*hashJoinBucket
+	schemas       concatSchemas
+
+	curRecord       *Record
+	curBucket       *hashJoinBucket
+	curIndex        int
+	joinedAnyRecord bool
+
+	sourceDone     bool
+	unmatchedIndex int
+	unmatchedInner int
+}
+
+func (stream *HashJoinedStream) Close() error {
+	err := stream.source.Close()
+	if err != nil {
+		return errors.Wrap(err, "Couldn't close source stream")
+	}
+
+	return nil
+}
+
+func (stream *HashJoinedStream) Next(ctx context.Context) (*Record, error) {
+	for !stream.sourceDone {
+		if stream.curRecord == nil {
+			srcRecord, err := stream.source.Next(ctx)
+			if err != nil {
+				if err == ErrEndOfStream {
+					stream.sourceDone = true
+					break
+				}
+				return nil, errors.Wrap(err, "couldn't get source record")
+			}
+
+			key, err := evaluateJoinKey(ctx, stream.sourceBinding, srcRecord)
+			if err != nil {
+				return nil, errors.Wrap(err, "couldn't evaluate source record key")
+			}
+
+			stream.curBucket = nil
+			if key != nil {
+				data, ok, err := stream.table.Get(key)
+				if err != nil {
+					return nil, errors.Wrap(err, "couldn't get bucket out of hash join table")
+				}
+				if ok {
+					stream.curBucket = data.(*hashJoinBucket)
+				}
+			}
+
+			stream.curRecord = srcRecord
+			stream.curIndex = 0
+			stream.joinedAnyRecord = false
+		}
+
+		if stream.curBucket == nil || stream.curIndex == len(stream.curBucket.records) {
+			toReturn := stream.curRecord
+			stream.curRecord = nil
+			if !stream.joinedAnyRecord && stream.joinType != InnerJoinType {
+				return toReturn, nil
+			}
+			continue
+		}
+
+		index := stream.curIndex
+		stream.curIndex++
+
+		record, ok, err := stream.joinRecords(ctx, stream.curRecord, stream.curBucket.records[index])
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		stream.joinedAnyRecord = true
+		stream.curBucket.matched[index] = true
+
+		return record, nil
+	}
+
+	if stream.joinType != FullJoinType {
+		return nil, ErrEndOfStream
+	}
+
+	for stream.unmatchedIndex < len(stream.buckets) {
+		bucket := stream.buckets[stream.unmatchedIndex]
+		if stream.unmatchedInner == len(bucket.records) {
+			stream.unmatchedIndex++
+			stream.unmatchedInner = 0
+			continue
+		}
+
+		index := stream.unmatchedInner
+		stream.unmatchedInner++
+		if !bucket.matched[index] {
+			return bucket.records[index], nil
+		}
+	}
+
+	return nil, ErrEndOfStream
+}
+
+// joinRecords returns the joined record and true if the pair of records satisfies the filter.
+func (stream *HashJoinedStream) joinRecords(ctx context.Context, srcRecord, joinedRecord *Record) (*Record, bool, error) {
+	record, err := concatRecords(&stream.schemas, srcRecord, joinedRecord)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "couldn't merge current record variables with joined record variables")
+	}
+
+	err = stream.filterBinding.Set(record)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "couldn't merge given variables with joined record variables")
+	}
+
+	ok, err := stream.filterBinding.Holds(ctx)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "couldn't evaluate join filter")
+	}
+	if !ok {
+		return nil, false, nil
+	}
+
+	return record, true, nil
+}
@@ -0,0 +1,243 @@
+package execution
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cube2222/octosql"
+	"github.com/pkg/errors"
+)
+
+func TestHashJoin_Get(t *testing.T) {
+	ctx := context.Background()
+	userFields := []octosql.VariableName{"e.user_id", "e.action"}
+	nameFields := []octosql.VariableName{"u.id", "u.name"}
+	joinedFields := []octosql.VariableName{"e.user_id", "e.action", "u.id", "u.name"}
+
+	events := func() Node {
+		return NewDummyNode([]*Record{
+			NewRecordFromSliceWithNormalize(userFields, []interface{}{1, "a"}),
+			NewRecordFromSliceWithNormalize(userFields, []interface{}{2, "b"}),
+			NewRecordFromSliceWithNormalize(userFields, []interface{}{1, "c"}),
+			NewRecordFromSlice(userFields, []octosql.Value{nil, octosql.MakeString("d")}),
+		})
+	}
+	users := func() Node {
+		return NewDummyNode([]*Record{
+			NewRecordFromSliceWithNormalize(nameFields, []interface{}{1, "alice"}),
+			NewRecordFromSliceWithNormalize(nameFields, []interface{}{3, "carol"}),
+			NewRecordFromSliceWithNormalize(nameFields, []interface{}{1, "alicia"}),
+		})
+	}
+
+	type args struct {
+		source    Node
+		joined    Node
+		sourceKey []Expression
+		joinedKey []Expression
+		filter    Formula
+		joinType  JoinType
+	}
+	tests := []struct {
+		name string
+		args args
+		want RecordStream
+	}{
+		{
+			name: "inner join",
+			args: args{
+				source:    events(),
+				joined:    users(),
+				sourceKey: []Expression{NewVariable("e.user_id")},
+				joinedKey: []Expression{NewVariable("u.id")},
+				filter:    NewConstant(true),
+				joinType:  InnerJoinType,
+			},
+			want: NewInMemoryStream([]*Record{
+				NewRecordFromSliceWithNormalize(joinedFields, []interface{}{1, "a", 1, "alice"}),
+				NewRecordFromSliceWithNormalize(joinedFields, []interface{}{1, "a", 1, "alicia"}),
+				NewRecordFromSliceWithNormalize(joinedFields, []interface{}{1, "c", 1, "alice"}),
+				NewRecordFromSliceWithNormalize(joinedFields, []interface{}{1, "c", 1, "alicia"}),
+			}),
+		},
+		{
+			name: "inner join with filter",
+			args: args{
+				source:    events(),
+				joined:    users(),
+				sourceKey: []Expression{NewVariable("e.user_id")},
+				joinedKey: []Expression{NewVariable("u.id")},
+				filter:    NewPredicate(NewVariable("u.name"), &Equal{}, NewDummyValue(octosql.MakeString("alicia"))),
+				joinType:  InnerJoinType,
+			},
+			want: NewInMemoryStream([]*Record{
+				NewRecordFromSliceWithNormalize(joinedFields, []interface{}{1, "a", 1, "alicia"}),
+				NewRecordFromSliceWithNormalize(joinedFields, []interface{}{1, "c", 1, "alicia"}),
+			}),
+		},
+		{
+			name: "left join",
+			args: args{
+				source:    events(),
+				joined:    users(),
+				sourceKey: []Expression{NewVariable("e.user_id")},
+				joinedKey: []Expression{NewVariable("u.id")},
+				filter:    NewPredicate(NewVariable("u.name"), &Equal{}, NewDummyValue(octosql.MakeString("alice"))),
+				joinType:  LeftJoinType,
+			},
+			want: NewInMemoryStream([]*Record{
+				NewRecordFromSliceWithNormalize(joinedFields, []interface{}{1, "a", 1, "alice"}),
+				NewRecordFromSliceWithNormalize(userFields, []interface{}{2, "b"}),
+				NewRecordFromSliceWithNormalize(joinedFields, []interface{}{1, "c", 1, "alice"}),
+				NewRecordFromSlice(userFields, []octosql.Value{nil, octosql.MakeString("d")}),
+			}),
+		},
+		{
+			name: "full join",
+			args: args{
+				source:    events(),
+				joined:    users(),
+				sourceKey: []Expression{NewVariable("e.user_id")},
+				joinedKey: []Expression{NewVariable("u.id")},
+				filter:    NewPredicate(NewVariable("u.name"), &Equal{}, NewDummyValue(octosql.MakeString("alice"))),
+				joinType:  FullJoinType,
+			},
+			want: NewInMemoryStream([]*Record{
+				NewRecordFromSliceWithNormalize(joinedFields, []interface{}{1, "a", 1, "alice"}),
+				NewRecordFromSliceWithNormalize(userFields, []interface{}{2, "b"}),
+				NewRecordFromSliceWithNormalize(joinedFields, []interface{}{1, "c", 1, "alice"}),
+				NewRecordFromSlice(userFields, []octosql.Value{nil, octosql.MakeString("d")}),
+				NewRecordFromSliceWithNormalize(nameFields, []interface{}{3, "carol"}),
+				NewRecordFromSliceWithNormalize(nameFields, []interface{}{1, "alicia"}),
+			}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := NewHashJoin(tt.args.source, tt.args.joined, tt.args.sourceKey, tt.args.joinedKey, tt.args.filter, tt.args.joinType)
+
+			stream, err := node.Get(ctx, octosql.NoVariables())
+			if err != nil {
+				t.Errorf("HashJoin.Get() error = %v", err)
+				return
+			}
+
+			equal, err := AreStreamsEqualNoOrdering(ctx, stream, tt.want)
+			if err != nil {
+				t.Errorf("HashJoin.Get() AreStreamsEqualNoOrdering error = %v", err)
+			}
+			if !equal {
+				t.Errorf("HashJoin.Get() streams not equal")
+			}
+		})
+	}
+}
+
+// trackedNode returns streams of its records followed by its error, counting the streams which weren't closed.
+type trackedNode struct {
+	records []*Record
+	err     error
+	open    int
+}
+
+func (node *trackedNode) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	node.open++
+	return &trackedStream{failingStream: failingStream{records: node.records, err: node.err}, node: node}, nil
+}
+
+type trackedStream struct {
+	failingStream
+	node *trackedNode
+}
+
+func (stream *trackedStream) Close() error {
+	stream.node.open--
+	return nil
+}
+
+// failingNode fails to return a stream.
+type failingNode struct{}
+
+func (node *failingNode) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	return nil, errors.New("no stream")
+}
+
+func TestJoins_CloseStreams(t *testing.T) {
+	ctx := context.Background()
+	fields := []octosql.VariableName{"a.id"}
+	records := []*Record{NewRecordFromSliceWithNormalize(fields, []interface{}{1})}
+	key := []Expression{NewVariable("a.id")}
+
+	tests := []struct {
+		name      string
+		node      func(source, joined Node) Node
+		sourceErr error
+		joined    Node
+		wantErr   bool
+	}{
+		{
+			name: "hash join",
+			node: func(source, joined Node) Node {
+				return NewHashJoin(source, joined, key, key, NewConstant(true), InnerJoinType)
+			},
+			joined: &trackedNode{records: records, err: ErrEndOfStream},
+		},
+		{
+			name: "hash join with failing joined stream",
+			node: func(source, joined Node) Node {
+				return NewHashJoin(source, joined, key, key, NewConstant(true), InnerJoinType)
+			},
+			joined:  &trackedNode{records: records, err: errors.New("broken")},
+			wantErr: true,
+		},
+		{
+			name: "merge join with failing joined node",
+			node: func(source, joined Node) Node {
+				return NewMergeJoin(source, joined, key, key, NewConstant(true), InnerJoinType)
+			},
+			joined:  &failingNode{},
+			wantErr: true,
+		},
+		{
+			name: "top n",
+			node: func(source, joined Node) Node {
+				return NewTopN(key, []OrderDirection{Ascending}, NewDummyValue(octosql.MakeInt(1)), nil, source)
+			},
+			joined: &failingNode{},
+		},
+		{
+			name: "top n with failing source stream",
+			node: func(source, joined Node) Node {
+				return NewTopN(key, []OrderDirection{Ascending}, NewDummyValue(octosql.MakeInt(1)), nil, source)
+			},
+			sourceErr: errors.New("broken"),
+			joined:    &failingNode{},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := &trackedNode{records: records, err: ErrEndOfStream}
+			if tt.sourceErr != nil {
+				source.err = tt.sourceErr
+			}
+
+			stream, err := tt.node(source, tt.joined).Get(ctx, octosql.NoVariables())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Get() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil {
+				if err := stream.Close(); err != nil {
+					t.Fatalf("Close() error = %v", err)
+				}
+			}
+
+			if source.open != 0 {
+				t.Errorf("%v source streams weren't closed", source.open)
+			}
+			if tracked, ok := tt.joined.(*trackedNode); ok && tracked.open != 0 {
+				t.Errorf("%v joined streams weren't closed", tracked.open)
+			}
+		})
+	}
+}
@@ -57,6 +57,30 @@ func (hm *HashMap) Get(key octosql.Value) (interface{}, bool, error) {
 	return nil, false, nil
 }
 
+func (hm *HashMap) Delete(key octosql.Value) error {
+	hash, err := hashstructure.Hash(key, nil)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't hash %+v", key)
+	}
+
+	list := hm.container[hash]
+	for i := range list {
+		if octosql.AreEqual(list[i].key, key) {
+			list[i] = list[len(list)-1]
+			list[len(list)-1] = entry{}
+			list = list[:len(list)-1]
+			break
+		}
+	}
+	if len(list) == 0 {
+		delete(hm.container, hash)
+	} else {
+		hm.container[hash] = list
+	}
+
+	return nil
+}
+
 func (hm *HashMap) GetIterator() *Iterator {
 	hashes := make([]uint64, 0, len(hm.container))
 	for k := range hm.container {
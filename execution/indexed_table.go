@@ -0,0 +1,368 @@
+package execution
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/cube2222/octosql"
+	"github.com/pkg/errors"
+)
+
+// IndexLookup is a comparison between a column of an indexed table and an expression which doesn't use the table's columns.
+// The relation is one of Equal, In, MoreThan, LessThan, GreaterEqual and LessEqual, with the column as its left operand.
+type IndexLookup struct {
+	Column   octosql.VariableName
+	Relation Relation
+	Value    Expression
+}
+
+// IndexedTable loads all the records of its source into memory on the first Get, and indexes them on the columns of its lookups.
+// Each Get finds the candidate records using the most selective of the lookups, and returns the ones satisfying the whole filter.
+// The records are reserved in the query memory budget for as long as the query runs.
+type IndexedTable struct {
+	source  Node
+	lookups []IndexLookup
+	filter  Formula
+
+	mutex sync.Mutex
+	table *indexedRecords
+}
+
+func NewIndexedTable(source Node, lookups []IndexLookup, filter Formula) *IndexedTable {
+	return &IndexedTable{
+		source:  source,
+		lookups: lookups,
+		filter:  filter,
+	}
+}
+
+type indexedRecords struct {
+	records []*Record
+	// hashIndexes map the values of columns to the ascending indices of the records with them.
+	hashIndexes map[octosql.VariableName]*HashMap
+	// sortedIndexes hold the sortable values of columns grouped by type, each group sorted by value.
+	sortedIndexes map[octosql.VariableName]map[reflect.Type][]indexedValue
+}
+
+type indexedValue struct {
+	value octosql.Value
+	row   int
+}
+
+func (node *IndexedTable) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	table, err := node.load(ctx, variables)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't load indexed table")
+	}
+
+	rows, err := node.lookup(ctx, variables, table)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't look up records in indexed table")
+	}
+
+	var records []*Record
+	if rows == nil {
+		records = table.records
+	} else {
+		records = make([]*Record, len(rows))
+		for i, row := range rows {
+			records[i] = table.records[row]
+		}
+	}
+
+	return &FilteredStream{
+		formula:   node.filter,
+		variables: variables,
+		source:    NewInMemoryStream(records),
+	}, nil
+}
+
+// load reads and indexes the source records, unless that's already been done.
+func (node *IndexedTable) load(ctx context.Context, variables octosql.Variables) (*indexedRecords, error) {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	if node.table != nil {
+		return node.table, nil
+	}
+
+	stream, err := node.source.Get(ctx, variables)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get source record stream")
+	}
+	defer stream.Close()
+
+	budget := MemoryBudgetFromContext(ctx)
+	var records []*Record
+	for {
+		record, err := stream.Next(ctx)
+		if err == ErrEndOfStream {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't get source record")
+		}
+		if _, err := budget.ReserveRecord(record, "indexed table"); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	table := &indexedRecords{
+		records:       records,
+		hashIndexes:   make(map[octosql.VariableName]*HashMap),
+		sortedIndexes: make(map[octosql.VariableName]map[reflect.Type][]indexedValue),
+	}
+	for _, lookup := range node.lookups {
+		switch lookup.Relation.(type) {
+		case *Equal, *In:
+			if _, ok := table.hashIndexes[lookup.Column]; ok {
+				continue
+			}
+			index, err := buildHashIndex(records, lookup.Column)
+			if err != nil {
+				return nil, errors.Wrapf(err, "couldn't build hash index on %v", lookup.Column)
+			}
+			table.hashIndexes[lookup.Column] = index
+
+		default:
+			if _, ok := table.sortedIndexes[lookup.Column]; ok {
+				continue
+			}
+			index, err := buildSortedIndex(records, lookup.Column)
+			if err != nil {
+				return nil, errors.Wrapf(err, "couldn't build sorted index on %v", lookup.Column)
+			}
+			table.sortedIndexes[lookup.Column] = index
+		}
+	}
+
+	node.table = table
+	return table, nil
+}
+
+func buildHashIndex(records []*Record, column octosql.VariableName) (*HashMap, error) {
+	index := NewHashMap()
+	for row, record := range records {
+		value := record.Value(column)
+		rows, _, err := index.Get(value)
+		if err != nil {
+			return nil, err
+		}
+		if rows == nil {
+			rows = []int{}
+		}
+		if err := index.Set(value, append(rows.([]int), row)); err != nil {
+			return nil, err
+		}
+	}
+	return index, nil
+}
+
+func buildSortedIndex(records []*Record, column octosql.VariableName) (map[reflect.Type][]indexedValue, error) {
+	index := make(map[reflect.Type][]indexedValue)
+	for row, record := range records {
+		value := record.Value(column)
+		if value == nil || !isSorteable(value) {
+			continue
+		}
+		valueType := reflect.TypeOf(value)
+		index[valueType] = append(index[valueType], indexedValue{value: value, row: row})
+	}
+
+	for _, values := range index {
+		var err error
+		sort.SliceStable(values, func(i, j int) bool {
+			cmp, cmpErr := compare(values[i].value, values[j].value)
+			if cmpErr != nil {
+				err = cmpErr
+			}
+			return cmp < 0
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't sort values")
+		}
+	}
+	return index, nil
+}
+
+// lookup returns the ascending indices of the candidate records found by the most selective lookup,
+// or nil if none of the lookups can be used with the given variables.
+// Ranges on the same column are intersected before their sizes are compared.
+func (node *IndexedTable) lookup(ctx context.Context, variables octosql.Variables, table *indexedRecords) ([]int, error) {
+	var best []int
+	bestSize := 0
+	found := false
+
+	var ranges []valueRange
+	for _, lookup := range node.lookups {
+		value, err := lookup.Value.ExpressionValue(ctx, variables)
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't get value of lookup on %v", lookup.Column)
+		}
+
+		switch lookup.Relation.(type) {
+		case *Equal, *In:
+			values := []octosql.Value{value}
+			if _, ok := lookup.Relation.(*In); ok {
+				if set, ok := value.(octosql.Tuple); ok {
+					values = set.AsSlice()
+				}
+			}
+
+			rows, err := hashLookup(table.hashIndexes[lookup.Column], values)
+			if err != nil {
+				return nil, errors.Wrapf(err, "couldn't look up %v", lookup.Column)
+			}
+			if !found || len(rows) < bestSize {
+				best = rows
+				bestSize = len(rows)
+				found = true
+			}
+
+		default:
+			if value == nil || !isSorteable(value) {
+				continue
+			}
+			lookupRange, err := newValueRange(lookup.Column, table.sortedIndexes[lookup.Column], lookup.Relation, value)
+			if err != nil {
+				return nil, errors.Wrapf(err, "couldn't look up %v", lookup.Column)
+			}
+			ranges = intersectRange(ranges, lookupRange)
+		}
+	}
+
+	var bestRange *valueRange
+	for i := range ranges {
+		if size := ranges[i].size(); !found || size < bestSize {
+			bestRange = &ranges[i]
+			bestSize = size
+			found = true
+		}
+	}
+	if bestRange != nil {
+		best = bestRange.rows()
+	}
+
+	if !found {
+		return nil, nil
+	}
+	if best == nil {
+		best = []int{}
+	}
+	return best, nil
+}
+
+func hashLookup(index *HashMap, values []octosql.Value) ([]int, error) {
+	var out []int
+	for _, value := range values {
+		rows, ok, err := index.Get(value)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			out = append(out, rows.([]int)...)
+		}
+	}
+
+	if len(values) > 1 {
+		sort.Ints(out)
+		deduplicated := out[:0]
+		for i := range out {
+			if i == 0 || out[i] != out[i-1] {
+				deduplicated = append(deduplicated, out[i])
+			}
+		}
+		out = deduplicated
+	}
+	return out, nil
+}
+
+// valueRange is the range of positions from (inclusive) to (exclusive) in the sorted values of a column with a single type.
+type valueRange struct {
+	column    octosql.VariableName
+	valueType reflect.Type
+	values    []indexedValue
+	from, to  int
+}
+
+// newValueRange creates the range of values of the index, which have the type of the given value and satisfy the relation with it.
+func newValueRange(column octosql.VariableName, index map[reflect.Type][]indexedValue, relation Relation, value octosql.Value) (valueRange, error) {
+	valueType := reflect.TypeOf(value)
+	values := index[valueType]
+
+	var err error
+	// firstNotLess is the position of the first value which isn't less than the given one, firstMore of the first one more than it.
+	firstNotLess := sort.Search(len(values), func(i int) bool {
+		cmp, cmpErr := compare(values[i].value, value)
+		if cmpErr != nil {
+			err = cmpErr
+		}
+		return cmp >= 0
+	})
+	firstMore := sort.Search(len(values), func(i int) bool {
+		cmp, cmpErr := compare(values[i].value, value)
+		if cmpErr != nil {
+			err = cmpErr
+		}
+		return cmp > 0
+	})
+	if err != nil {
+		return valueRange{}, errors.Wrap(err, "couldn't compare values")
+	}
+
+	out := valueRange{column: column, valueType: valueType, values: values, from: 0, to: len(values)}
+	switch relation.(type) {
+	case *MoreThan:
+		out.from = firstMore
+	case *GreaterEqual:
+		out.from = firstNotLess
+	case *LessThan:
+		out.to = firstNotLess
+	case *LessEqual:
+		out.to = firstMore
+	default:
+		return valueRange{}, errors.Errorf("invalid index lookup relation %T", relation)
+	}
+	return out, nil
+}
+
+// intersectRange adds the range to the list, intersecting it with a range over the same column and type if there's one already.
+func intersectRange(ranges []valueRange, other valueRange) []valueRange {
+	for i := range ranges {
+		if ranges[i].column != other.column || ranges[i].valueType != other.valueType {
+			continue
+		}
+		if other.from > ranges[i].from {
+			ranges[i].from = other.from
+		}
+		if other.to < ranges[i].to {
+			ranges[i].to = other.to
+		}
+		return ranges
+	}
+	return append(ranges, other)
+}
+
+func (r *valueRange) size() int {
+	if r.from >= r.to {
+		return 0
+	}
+	return r.to - r.from
+}
+
+// rows returns the ascending indices of the records with values in the range.
+func (r *valueRange) rows() []int {
+	if r.size() == 0 {
+		return []int{}
+	}
+
+	rows := make([]int, 0, r.to-r.from)
+	for _, value := range r.values[r.from:r.to] {
+		rows = append(rows, value.row)
+	}
+	sort.Ints(rows)
+	return rows
+}
@@ -0,0 +1,129 @@
+package execution
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cube2222/octosql"
+)
+
+// countingGetsNode counts how many times its records have been read.
+type countingGetsNode struct {
+	Node
+	gets int
+}
+
+func (node *countingGetsNode) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	node.gets++
+	return node.Node.Get(ctx, variables)
+}
+
+func TestIndexedTable(t *testing.T) {
+	fields := []octosql.VariableName{"t.id", "t.name"}
+	var records []*Record
+	for i := 0; i < 20; i++ {
+		records = append(records, NewRecordFromSlice(fields, []octosql.Value{
+			octosql.MakeInt(i % 10),
+			octosql.MakeString(string(rune('a' + i))),
+		}))
+	}
+	records = append(records, NewRecordFromSlice(fields, []octosql.Value{nil, octosql.MakeString("null")}))
+
+	matching := func(predicate func(id int, name string) bool) []*Record {
+		var out []*Record
+		for _, record := range records {
+			id, ok := record.Value("t.id").(octosql.Int)
+			if ok && predicate(id.AsInt(), record.Value("t.name").(octosql.String).AsString()) {
+				out = append(out, record)
+			}
+		}
+		return out
+	}
+
+	tests := []struct {
+		name      string
+		lookups   []IndexLookup
+		filter    Formula
+		variables []octosql.Variables
+		want      [][]*Record
+	}{
+		{
+			name: "equality lookup",
+			lookups: []IndexLookup{
+				{Column: "t.id", Relation: NewEqual(), Value: NewVariable("s.id")},
+			},
+			filter: NewPredicate(NewVariable("t.id"), NewEqual(), NewVariable("s.id")),
+			variables: []octosql.Variables{
+				{"s.id": octosql.MakeInt(3)},
+				{"s.id": octosql.MakeInt(7)},
+				{"s.id": octosql.MakeInt(42)},
+			},
+			want: [][]*Record{
+				matching(func(id int, name string) bool { return id == 3 }),
+				matching(func(id int, name string) bool { return id == 7 }),
+				nil,
+			},
+		},
+		{
+			name: "in lookup with rest of filter",
+			lookups: []IndexLookup{
+				{Column: "t.id", Relation: NewIn(), Value: NewVariable("s.ids")},
+			},
+			filter: NewAnd(
+				NewPredicate(NewVariable("t.id"), NewIn(), NewVariable("s.ids")),
+				NewPredicate(NewVariable("t.name"), NewMoreThan(), NewDummyValue(octosql.MakeString("e"))),
+			),
+			variables: []octosql.Variables{
+				{"s.ids": octosql.MakeTuple([]octosql.Value{octosql.MakeInt(1), octosql.MakeInt(5), octosql.MakeInt(1)})},
+			},
+			want: [][]*Record{
+				matching(func(id int, name string) bool { return (id == 1 || id == 5) && name > "e" }),
+			},
+		},
+		{
+			name: "range lookups",
+			lookups: []IndexLookup{
+				{Column: "t.id", Relation: NewGreaterEqual(), Value: NewVariable("s.from")},
+				{Column: "t.id", Relation: NewLessThan(), Value: NewVariable("s.to")},
+			},
+			filter: NewAnd(
+				NewPredicate(NewVariable("t.id"), NewGreaterEqual(), NewVariable("s.from")),
+				NewPredicate(NewVariable("t.id"), NewLessThan(), NewVariable("s.to")),
+			),
+			variables: []octosql.Variables{
+				{"s.from": octosql.MakeInt(2), "s.to": octosql.MakeInt(4)},
+				{"s.from": octosql.MakeInt(8), "s.to": octosql.MakeInt(100)},
+			},
+			want: [][]*Record{
+				matching(func(id int, name string) bool { return id >= 2 && id < 4 }),
+				matching(func(id int, name string) bool { return id >= 8 }),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			source := &countingGetsNode{Node: NewDummyNode(records)}
+			node := NewIndexedTable(source, tt.lookups, tt.filter)
+
+			for i := range tt.variables {
+				got, err := node.Get(ctx, tt.variables[i])
+				if err != nil {
+					t.Fatalf("Get() error: %v", err)
+				}
+
+				equal, err := AreStreamsEqual(ctx, NewInMemoryStream(tt.want[i]), got)
+				if err != nil {
+					t.Errorf("Error in AreStreamsEqual(): %v", err)
+				}
+				if !equal {
+					t.Errorf("Streams for variables %v don't match", tt.variables[i])
+				}
+			}
+
+			if source.gets != 1 {
+				t.Errorf("source was read %v times, want 1", source.gets)
+			}
+		})
+	}
+}
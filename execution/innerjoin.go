@@ -1,26 +1,43 @@
 package execution
 
 import (
+	"context"
+
 	"github.com/cube2222/octosql"
 	"github.com/pkg/errors"
 )
 
-// InnerJoin currently only supports lookup joins.
+// InnerJoin currently only supports lookup joins, which get batched if the joined node supports it.
+// Otherwise, if prefetch is more than one, the joined streams for that many source records are fetched concurrently.
+// The joined node may override the prefetch setting by implementing PrefetchNode.
 type InnerJoin struct {
-	source Node
-	joined Node
+	source   Node
+	joined   Node
+	prefetch int
 }
 
-func NewInnerJoin(source Node, joined Node) *InnerJoin {
-	return &InnerJoin{source: source, joined: joined}
+func NewInnerJoin(source Node, joined Node, prefetch int) *InnerJoin {
+	return &InnerJoin{source: source, joined: joined, prefetch: prefetch}
 }
 
-func (node *InnerJoin) Get(variables octosql.Variables) (RecordStream, error) {
-	recordStream, err := node.source.Get(variables)
+func (node *InnerJoin) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	recordStream, err := node.source.Get(ctx, variables)
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't get record stream")
 	}
 
+	if joined, ok := node.joined.(BatchLookupNode); ok && joined.BatchSize() > 1 {
+		return newBatchedLookupJoinedStream(variables, recordStream, joined, false), nil
+	}
+
+	prefetch := node.prefetch
+	if joined, ok := node.joined.(PrefetchNode); ok && joined.Prefetch() > 0 {
+		prefetch = joined.Prefetch()
+	}
+	if prefetch > 1 {
+		return newPrefetchingLookupJoinedStream(ctx, variables, recordStream, node.joined, prefetch, false), nil
+	}
+
 	return &InnerJoinedStream{
 		variables:       variables,
 		source:          recordStream,
@@ -36,6 +53,7 @@ type InnerJoinedStream struct {
 	joined          Node
 	curRecord       *Record
 	curJoinedStream RecordStream
+	schemas         concatSchemas
 }
 
 func (stream *InnerJoinedStream) Close() error {
@@ -52,10 +70,10 @@ func (stream *InnerJoinedStream) Close() error {
 	return nil
 }
 
-func (stream *InnerJoinedStream) Next() (*Record, error) {
+func (stream *InnerJoinedStream) Next(ctx context.Context) (*Record, error) {
 	for {
 		if stream.curRecord == nil {
-			srcRecord, err := stream.source.Next()
+			srcRecord, err := stream.source.Next(ctx)
 			if err != nil {
 				if err == ErrEndOfStream {
 					return nil, ErrEndOfStream
@@ -68,7 +86,7 @@ func (stream *InnerJoinedStream) Next() (*Record, error) {
 				return nil, errors.Wrap(err, "couldn't merge given variables with source record variables")
 			}
 
-			stream.curJoinedStream, err = stream.joined.Get(variables)
+			stream.curJoinedStream, err = stream.joined.Get(ctx, variables)
 			if err != nil {
 				return nil, errors.Wrap(err, "couldn't get joined stream")
 			}
@@ -76,7 +94,7 @@ func (stream *InnerJoinedStream) Next() (*Record, error) {
 			stream.curRecord = srcRecord
 		}
 
-		joinedRecord, err := stream.curJoinedStream.Next()
+		joinedRecord, err := stream.curJoinedStream.Next(ctx)
 		if err != nil {
 			if err == ErrEndOfStream {
 				stream.curRecord = nil
@@ -86,16 +104,11 @@ func (stream *InnerJoinedStream) Next() (*Record, error) {
 			return nil, errors.Wrap(err, "couldn't get joined record")
 		}
 
-		fields := stream.curRecord.fieldNames
-		for _, field := range joinedRecord.Fields() {
-			fields = append(fields, field.Name)
-		}
-
-		allVariableValues, err := stream.curRecord.AsVariables().MergeWith(joinedRecord.AsVariables())
+		record, err := concatRecords(&stream.schemas, stream.curRecord, joinedRecord)
 		if err != nil {
 			return nil, errors.Wrap(err, "couldn't merge current record variables with joined record variables")
 		}
 
-		return NewRecord(fields, allVariableValues), nil
+		return record, nil
 	}
 }
@@ -1,26 +1,43 @@
 package execution
 
 import (
+	"context"
+
 	"github.com/cube2222/octosql"
 	"github.com/pkg/errors"
 )
 
-// LeftJoin currently only supports lookup joins.
+// LeftJoin currently only supports lookup joins, which get batched if the joined node supports it.
+// Otherwise, if prefetch is more than one, the joined streams for that many source records are fetched concurrently.
+// The joined node may override the prefetch setting by implementing PrefetchNode.
 type LeftJoin struct {
-	source Node
-	joined Node
+	source   Node
+	joined   Node
+	prefetch int
 }
 
-func NewLeftJoin(source Node, joined Node) *LeftJoin {
-	return &LeftJoin{source: source, joined: joined}
+func NewLeftJoin(source Node, joined Node, prefetch int) *LeftJoin {
+	return &LeftJoin{source: source, joined: joined, prefetch: prefetch}
 }
 
-func (node *LeftJoin) Get(variables octosql.Variables) (RecordStream, error) {
-	recordStream, err := node.source.Get(variables)
+func (node *LeftJoin) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	recordStream, err := node.source.Get(ctx, variables)
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't get record stream")
 	}
 
+	if joined, ok := node.joined.(BatchLookupNode); ok && joined.BatchSize() > 1 {
+		return newBatchedLookupJoinedStream(variables, recordStream, joined, true), nil
+	}
+
+	prefetch := node.prefetch
+	if joined, ok := node.joined.(PrefetchNode); ok && joined.Prefetch() > 0 {
+		prefetch = joined.Prefetch()
+	}
+	if prefetch > 1 {
+		return newPrefetchingLookupJoinedStream(ctx, variables, recordStream, node.joined, prefetch, true), nil
+	}
+
 	return &LeftJoinedStream{
 		variables:       variables,
 		source:          recordStream,
@@ -37,6 +54,7 @@ type LeftJoinedStream struct {
 	curRecord       *Record
 	curJoinedStream RecordStream
 	joinedAnyRecord bool
+	schemas         concatSchemas
 }
 
 func (stream *LeftJoinedStream) Close() error {
@@ -53,10 +71,10 @@ func (stream *LeftJoinedStream) Close() error {
 	return nil
 }
 
-func (stream *LeftJoinedStream) Next() (*Record, error) {
+func (stream *LeftJoinedStream) Next(ctx context.Context) (*Record, error) {
 	for {
 		if stream.curRecord == nil {
-			srcRecord, err := stream.source.Next()
+			srcRecord, err := stream.source.Next(ctx)
 			if err != nil {
 				if err == ErrEndOfStream {
 					return nil, ErrEndOfStream
@@ -69,7 +87,7 @@ func (stream *LeftJoinedStream) Next() (*Record, error) {
 				return nil, errors.Wrap(err, "couldn't merge given variables with source record variables")
 			}
 
-			stream.curJoinedStream, err = stream.joined.Get(variables)
+			stream.curJoinedStream, err = stream.joined.Get(ctx, variables)
 			if err != nil {
 				return nil, errors.Wrap(err, "couldn't get joined stream")
 			}
@@ -78,7 +96,7 @@ func (stream *LeftJoinedStream) Next() (*Record, error) {
 			stream.joinedAnyRecord = false
 		}
 
-		joinedRecord, err := stream.curJoinedStream.Next()
+		joinedRecord, err := stream.curJoinedStream.Next(ctx)
 		if err != nil {
 			if err == ErrEndOfStream {
 				if !stream.joinedAnyRecord {
@@ -95,16 +113,11 @@ func (stream *LeftJoinedStream) Next() (*Record, error) {
 		}
 		stream.joinedAnyRecord = true
 
-		fields := stream.curRecord.fieldNames
-		for _, field := range joinedRecord.Fields() {
-			fields = append(fields, field.Name)
-		}
-
-		allVariableValues, err := stream.curRecord.AsVariables().MergeWith(joinedRecord.AsVariables())
+		record, err := concatRecords(&stream.schemas, stream.curRecord, joinedRecord)
 		if err != nil {
 			return nil, errors.Wrap(err, "couldn't merge current record variables with joined record variables")
 		}
 
-		return NewRecord(fields, allVariableValues), nil
+		return record, nil
 	}
 }
@@ -1,12 +1,14 @@
 package execution
 
 import (
+	"context"
 	"testing"
 
 	"github.com/cube2222/octosql"
 )
 
 func TestLeftJoinedStream_Next(t *testing.T) {
+	ctx := context.Background()
 	fieldNames := []octosql.VariableName{
 		octosql.NewVariableName("bike"),
 		octosql.NewVariableName("name"),
@@ -79,7 +81,9 @@ func TestLeftJoinedStream_Next(t *testing.T) {
 							fieldNames2,
 							[]interface{}{"green", 2},
 						),
-					})),
+					}),
+					1,
+				),
 			},
 			want: NewInMemoryStream(
 				[]*Record{
@@ -123,7 +127,7 @@ func TestLeftJoinedStream_Next(t *testing.T) {
 				source:    tt.fields.source,
 				joined:    tt.fields.joined,
 			}
-			equal, err := AreStreamsEqual(stream, tt.want)
+			equal, err := AreStreamsEqual(ctx, stream, tt.want)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("LeftJoinedStream.Next() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1,6 +1,8 @@
 package execution
 
 import (
+	"context"
+
 	"github.com/cube2222/octosql"
 	"github.com/pkg/errors"
 )
@@ -14,13 +16,13 @@ func NewLimit(data Node, limit Expression) *Limit {
 	return &Limit{data: data, limitExpr: limit}
 }
 
-func (node *Limit) Get(variables octosql.Variables) (RecordStream, error) {
-	dataStream, err := node.data.Get(variables)
+func (node *Limit) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	dataStream, err := node.data.Get(ctx, variables)
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't get data RecordStream")
 	}
 
-	exprVal, err := node.limitExpr.ExpressionValue(variables)
+	exprVal, err := node.limitExpr.ExpressionValue(ctx, variables)
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't extract value from limit subexpression")
 	}
@@ -57,10 +59,10 @@ func (node *LimitedStream) Close() error {
 	return nil
 }
 
-func (node *LimitedStream) Next() (*Record, error) {
+func (node *LimitedStream) Next(ctx context.Context) (*Record, error) {
 	if node.limit > 0 {
 		node.limit--
-		record, err := node.rs.Next()
+		record, err := node.rs.Next(ctx)
 		if err != nil {
 			if err == ErrEndOfStream {
 				node.limit = 0
@@ -1,12 +1,14 @@
 package execution
 
 import (
+	"context"
+
 	"github.com/cube2222/octosql"
 	"github.com/pkg/errors"
 )
 
 type Formula interface {
-	Evaluate(variables octosql.Variables) (bool, error)
+	Evaluate(ctx context.Context, variables octosql.Variables) (bool, error)
 }
 
 type Constant struct {
@@ -17,7 +19,7 @@ func NewConstant(value bool) *Constant {
 	return &Constant{Value: value}
 }
 
-func (f Constant) Evaluate(variables octosql.Variables) (bool, error) {
+func (f Constant) Evaluate(ctx context.Context, variables octosql.Variables) (bool, error) {
 	return f.Value, nil
 }
 
@@ -29,12 +31,12 @@ func NewAnd(left Formula, right Formula) *And {
 	return &And{Left: left, Right: right}
 }
 
-func (f *And) Evaluate(variables octosql.Variables) (bool, error) {
-	left, err := f.Left.Evaluate(variables)
+func (f *And) Evaluate(ctx context.Context, variables octosql.Variables) (bool, error) {
+	left, err := f.Left.Evaluate(ctx, variables)
 	if err != nil {
 		return false, errors.Wrap(err, "couldn't evaluate left operand in and")
 	}
-	right, err := f.Right.Evaluate(variables)
+	right, err := f.Right.Evaluate(ctx, variables)
 	if err != nil {
 		return false, errors.Wrap(err, "couldn't evaluate right operand in and")
 	}
@@ -50,13 +52,13 @@ func NewOr(left Formula, right Formula) *Or {
 	return &Or{Left: left, Right: right}
 }
 
-func (f *Or) Evaluate(variables octosql.Variables) (bool, error) {
-	left, err := f.Left.Evaluate(variables)
+func (f *Or) Evaluate(ctx context.Context, variables octosql.Variables) (bool, error) {
+	left, err := f.Left.Evaluate(ctx, variables)
 	if err != nil {
 		return false, errors.Wrap(err, "couldn't evaluate left operand in or")
 	}
 
-	right, err := f.Right.Evaluate(variables)
+	right, err := f.Right.Evaluate(ctx, variables)
 	if err != nil {
 		return false, errors.Wrap(err, "couldn't evaluate right operand in or")
 	}
@@ -72,8 +74,8 @@ func NewNot(child Formula) *Not {
 	return &Not{Child: child}
 }
 
-func (f *Not) Evaluate(variables octosql.Variables) (bool, error) {
-	child, err := f.Child.Evaluate(variables)
+func (f *Not) Evaluate(ctx context.Context, variables octosql.Variables) (bool, error) {
+	child, err := f.Child.Evaluate(ctx, variables)
 	if err != nil {
 		return false, errors.Wrap(err, "couldn't evaluate child formula in not")
 	}
@@ -91,6 +93,6 @@ func NewPredicate(left Expression, relation Relation, right Expression) *Predica
 	return &Predicate{Left: left, Relation: relation, Right: right}
 }
 
-func (f *Predicate) Evaluate(variables octosql.Variables) (bool, error) {
-	return f.Relation.Apply(variables, f.Left, f.Right)
+func (f *Predicate) Evaluate(ctx context.Context, variables octosql.Variables) (bool, error) {
+	return f.Relation.Apply(ctx, variables, f.Left, f.Right)
 }
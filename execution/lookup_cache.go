@@ -0,0 +1,240 @@
+package execution
+
+import (
+	"container/list"
+	"context"
+	"sync"
+
+	"github.com/cube2222/octosql"
+	"github.com/pkg/errors"
+)
+
+// LookupCache caches the records of its source for the most recently used lookup keys,
+// so that lookups of keys shared by many source records of a lookup join don't query the data source again.
+// It holds at most size records, counting each cached lookup as at least one record.
+// Lookups returning more records than that aren't cached.
+type LookupCache struct {
+	source LookupKeyNode
+	size   int
+
+	mutex   sync.Mutex
+	entries *HashMap
+	// recent holds the *lookupCacheEntry of each cached key, the most recently used first.
+	recent *list.List
+	cached int
+}
+
+// BatchLookupCache is a LookupCache of a source which handles batches of lookups.
+// Only the lookups which aren't cached are passed on to the source.
+type BatchLookupCache struct {
+	*LookupCache
+	batchSource BatchLookupNode
+}
+
+type lookupCacheEntry struct {
+	key     octosql.Value
+	records []*Record
+}
+
+// NewLookupCache creates a cache of the given number of records for the source.
+// It's a BatchLookupCache if the source is a BatchLookupNode.
+func NewLookupCache(source LookupKeyNode, size int) Node {
+	cache := &LookupCache{
+		source:  source,
+		size:    size,
+		entries: NewHashMap(),
+		recent:  list.New(),
+	}
+	if batchSource, ok := source.(BatchLookupNode); ok {
+		return &BatchLookupCache{
+			LookupCache: cache,
+			batchSource: batchSource,
+		}
+	}
+	return cache
+}
+
+// Prefetch is the prefetch of the source, if it sets one.
+func (cache *LookupCache) Prefetch() int {
+	if prefetchNode, ok := cache.source.(PrefetchNode); ok {
+		return prefetchNode.Prefetch()
+	}
+	return 0
+}
+
+func (cache *LookupCache) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	key, err := cache.source.LookupKey(ctx, variables)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get lookup key")
+	}
+
+	records, ok, err := cache.get(key)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return NewInMemoryStream(records), nil
+	}
+
+	stream, err := cache.source.Get(ctx, variables)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get source record stream")
+	}
+	records, err = readAllRecords(ctx, stream)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cache.put(key, records); err != nil {
+		return nil, err
+	}
+	return NewInMemoryStream(records), nil
+}
+
+func (cache *BatchLookupCache) BatchSize() int {
+	return cache.batchSource.BatchSize()
+}
+
+func (cache *BatchLookupCache) GetBatch(ctx context.Context, batch []octosql.Variables) ([]RecordStream, error) {
+	streams := make([]RecordStream, len(batch))
+
+	// missing maps the keys which aren't cached to the indices of the lookups with them.
+	missing := NewHashMap()
+	var missingKeys []octosql.Value
+	var missingBatch []octosql.Variables
+	for i := range batch {
+		key, err := cache.source.LookupKey(ctx, batch[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't get lookup key with index %v", i)
+		}
+
+		records, ok, err := cache.get(key)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			streams[i] = NewInMemoryStream(records)
+			continue
+		}
+
+		indices, ok, err := missing.Get(key)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't get lookup out of hashmap")
+		}
+		if !ok {
+			indices = []int{}
+			missingKeys = append(missingKeys, key)
+			missingBatch = append(missingBatch, batch[i])
+		}
+		if err := missing.Set(key, append(indices.([]int), i)); err != nil {
+			return nil, errors.Wrap(err, "couldn't put lookup into hashmap")
+		}
+	}
+
+	if len(missingBatch) == 0 {
+		return streams, nil
+	}
+
+	missingStreams, err := cache.batchSource.GetBatch(ctx, missingBatch)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get source record streams for batch")
+	}
+	for i := range missingStreams {
+		records, err := readAllRecords(ctx, missingStreams[i])
+		if err != nil {
+			return nil, err
+		}
+		if err := cache.put(missingKeys[i], records); err != nil {
+			return nil, err
+		}
+
+		indices, _, err := missing.Get(missingKeys[i])
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't get lookup out of hashmap")
+		}
+		for _, index := range indices.([]int) {
+			streams[index] = NewInMemoryStream(records)
+		}
+	}
+
+	return streams, nil
+}
+
+// get returns the cached records of the key, marking it as the most recently used.
+func (cache *LookupCache) get(key octosql.Value) ([]*Record, bool, error) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	element, ok, err := cache.entries.Get(key)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "couldn't get lookup out of cache")
+	}
+	if !ok {
+		return nil, false, nil
+	}
+
+	cache.recent.MoveToFront(element.(*list.Element))
+	return element.(*list.Element).Value.(*lookupCacheEntry).records, true, nil
+}
+
+// put caches the records of the key, evicting the least recently used keys to make room for them.
+func (cache *LookupCache) put(key octosql.Value, records []*Record) error {
+	cost := lookupCacheCost(records)
+	if cost > cache.size {
+		return nil
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if _, ok, err := cache.entries.Get(key); err != nil {
+		return errors.Wrap(err, "couldn't get lookup out of cache")
+	} else if ok {
+		return nil
+	}
+
+	for cache.cached+cost > cache.size {
+		oldest := cache.recent.Back()
+		entry := cache.recent.Remove(oldest).(*lookupCacheEntry)
+		if err := cache.entries.Delete(entry.key); err != nil {
+			return errors.Wrap(err, "couldn't evict lookup from cache")
+		}
+		cache.cached -= lookupCacheCost(entry.records)
+	}
+
+	element := cache.recent.PushFront(&lookupCacheEntry{key: key, records: records})
+	if err := cache.entries.Set(key, element); err != nil {
+		return errors.Wrap(err, "couldn't put lookup into cache")
+	}
+	cache.cached += cost
+
+	return nil
+}
+
+func lookupCacheCost(records []*Record) int {
+	if len(records) == 0 {
+		return 1
+	}
+	return len(records)
+}
+
+// readAllRecords reads all the records of the stream and closes it.
+func readAllRecords(ctx context.Context, stream RecordStream) ([]*Record, error) {
+	var records []*Record
+	for {
+		record, err := stream.Next(ctx)
+		if err == ErrEndOfStream {
+			break
+		}
+		if err != nil {
+			stream.Close()
+			return nil, errors.Wrap(err, "couldn't get source record")
+		}
+		records = append(records, record)
+	}
+
+	if err := stream.Close(); err != nil {
+		return nil, errors.Wrap(err, "couldn't close source stream")
+	}
+	return records, nil
+}
@@ -0,0 +1,151 @@
+package execution
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cube2222/octosql"
+)
+
+// keyedNode returns a record with the value of the variable x for each of its copies, and counts the lookups it gets.
+type keyedNode struct {
+	copies  int
+	lookups int
+}
+
+func (node *keyedNode) LookupKey(ctx context.Context, variables octosql.Variables) (octosql.Value, error) {
+	return variables["x"], nil
+}
+
+func (node *keyedNode) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	node.lookups++
+	var records []*Record
+	for i := 0; i < node.copies; i++ {
+		records = append(records, NewRecordFromSlice([]octosql.VariableName{"out"}, []octosql.Value{variables["x"]}))
+	}
+	return NewInMemoryStream(records), nil
+}
+
+type batchKeyedNode struct {
+	keyedNode
+}
+
+func (node *batchKeyedNode) BatchSize() int {
+	return 10
+}
+
+func (node *batchKeyedNode) GetBatch(ctx context.Context, batch []octosql.Variables) ([]RecordStream, error) {
+	streams := make([]RecordStream, len(batch))
+	for i := range batch {
+		var err error
+		streams[i], err = node.Get(ctx, batch[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return streams, nil
+}
+
+func TestLookupCache(t *testing.T) {
+	tests := []struct {
+		name        string
+		copies      int
+		size        int
+		xs          []int
+		wantLookups int
+	}{
+		{
+			name:        "repeated keys",
+			copies:      2,
+			size:        10,
+			xs:          []int{1, 2, 1, 2, 1, 3},
+			wantLookups: 3,
+		},
+		{
+			name:        "least recently used evicted",
+			copies:      2,
+			size:        4,
+			xs:          []int{1, 2, 1, 3, 1, 2},
+			wantLookups: 4,
+		},
+		{
+			name:        "empty results",
+			copies:      0,
+			size:        2,
+			xs:          []int{1, 2, 1, 2},
+			wantLookups: 2,
+		},
+		{
+			name:        "results bigger than cache",
+			copies:      3,
+			size:        2,
+			xs:          []int{1, 1},
+			wantLookups: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			source := &keyedNode{copies: tt.copies}
+			cache := NewLookupCache(source, tt.size)
+
+			for _, x := range tt.xs {
+				stream, err := cache.Get(ctx, octosql.Variables{"x": octosql.MakeInt(x)})
+				if err != nil {
+					t.Fatalf("Get() error: %v", err)
+				}
+
+				var want []*Record
+				for i := 0; i < tt.copies; i++ {
+					want = append(want, NewRecordFromSlice([]octosql.VariableName{"out"}, []octosql.Value{octosql.MakeInt(x)}))
+				}
+				equal, err := AreStreamsEqual(ctx, NewInMemoryStream(want), stream)
+				if err != nil {
+					t.Errorf("Error in AreStreamsEqual(): %v", err)
+				}
+				if !equal {
+					t.Errorf("Streams for x = %v don't match", x)
+				}
+			}
+
+			if source.lookups != tt.wantLookups {
+				t.Errorf("source got %v lookups, want %v", source.lookups, tt.wantLookups)
+			}
+		})
+	}
+}
+
+func TestBatchLookupCache(t *testing.T) {
+	ctx := context.Background()
+	source := &batchKeyedNode{keyedNode{copies: 1}}
+	cache, ok := NewLookupCache(source, 10).(BatchLookupNode)
+	if !ok {
+		t.Fatalf("cache of batch lookup node isn't a batch lookup node")
+	}
+
+	for _, xs := range [][]int{{1, 2, 1}, {2, 3, 3}} {
+		var batch []octosql.Variables
+		for _, x := range xs {
+			batch = append(batch, octosql.Variables{"x": octosql.MakeInt(x)})
+		}
+
+		streams, err := cache.GetBatch(ctx, batch)
+		if err != nil {
+			t.Fatalf("GetBatch() error: %v", err)
+		}
+		for i, x := range xs {
+			want := NewInMemoryStream([]*Record{NewRecordFromSlice([]octosql.VariableName{"out"}, []octosql.Value{octosql.MakeInt(x)})})
+			equal, err := AreStreamsEqual(ctx, want, streams[i])
+			if err != nil {
+				t.Errorf("Error in AreStreamsEqual(): %v", err)
+			}
+			if !equal {
+				t.Errorf("Streams for x = %v don't match", x)
+			}
+		}
+	}
+
+	if source.lookups != 3 {
+		t.Errorf("source got %v lookups, want %v", source.lookups, 3)
+	}
+}
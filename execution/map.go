@@ -1,32 +1,54 @@
 package execution
 
 import (
+	"context"
+	"sync"
+
 	"github.com/cube2222/octosql"
 	"github.com/pkg/errors"
 )
 
+// Map evaluates its expressions for each source record.
+// With parallelism greater than 1, records are evaluated concurrently in batches by that many workers.
 type Map struct {
 	expressions []NamedExpression
 	source      Node
 	keep        bool
+	parallelism int
 }
 
-func NewMap(expressions []NamedExpression, child Node, keep bool) *Map {
-	return &Map{expressions: expressions, source: child, keep: keep}
+func NewMap(expressions []NamedExpression, child Node, keep bool, parallelism int) *Map {
+	return &Map{expressions: expressions, source: child, keep: keep, parallelism: parallelism}
 }
 
-func (node *Map) Get(variables octosql.Variables) (RecordStream, error) {
-	recordStream, err := node.source.Get(variables)
+func (node *Map) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	recordStream, err := node.source.Get(ctx, variables)
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't get record stream")
 	}
 
-	return &MappedStream{
+	stream := &MappedStream{
 		expressions: node.expressions,
 		variables:   variables,
 		source:      recordStream,
 		keep:        node.keep,
-	}, nil
+	}
+	if node.parallelism > 1 {
+		// Workers evaluate records concurrently, so each of them needs its own binding.
+		bindings := sync.Pool{
+			New: func() interface{} {
+				return stream.newBinding()
+			},
+		}
+		mapRecord := func(ctx context.Context, record *Record) (*Record, error) {
+			binding := bindings.Get().(*recordBinding)
+			defer bindings.Put(binding)
+			return stream.mapRecord(ctx, binding, record)
+		}
+		return newParallelStream(ctx, recordStream, mapRecord, node.parallelism), nil
+	}
+
+	return stream, nil
 }
 
 type MappedStream struct {
@@ -34,6 +56,75 @@ type MappedStream struct {
 	variables   octosql.Variables
 	source      RecordStream
 	keep        bool
+
+	// binding is reused for all the records, unless they're mapped by parallel workers.
+	binding *recordBinding
+
+	// mapping is resolved for the schema of the last source record.
+	// It's guarded by a mutex, as parallel workers map records concurrently.
+	mappingMutex sync.Mutex
+	mapping      *recordMapping
+
+	batches *BatchReader
+}
+
+// recordMapping is what a MappedStream resolves once for each schema of its source records.
+type recordMapping struct {
+	source *Schema
+	output *Schema
+	// variableIndices contain the index of the source field for each expression which is a plain variable of a source field,
+	// and -1 for the other expressions, which have to be evaluated.
+	variableIndices []int
+	evaluated       bool
+	// keptIndices are the indices of the kept source fields.
+	keptIndices []int
+}
+
+func (stream *MappedStream) mappingFor(schema *Schema) (*recordMapping, error) {
+	stream.mappingMutex.Lock()
+	defer stream.mappingMutex.Unlock()
+
+	if stream.mapping != nil && stream.mapping.source.Equal(schema) {
+		return stream.mapping, nil
+	}
+
+	for _, field := range schema.fields {
+		if vOld, ok := stream.variables[field]; ok {
+			return nil, errors.Errorf("%v already defined as %+v", field, vOld)
+		}
+	}
+
+	mapping := &recordMapping{
+		source:          schema,
+		variableIndices: make([]int, len(stream.expressions)),
+	}
+	fieldNames := make([]octosql.VariableName, len(stream.expressions))
+	mapped := make(map[octosql.VariableName]bool, len(stream.expressions))
+	for i, expr := range stream.expressions {
+		fieldNames[i] = expr.Name()
+		mapped[expr.Name()] = true
+
+		mapping.variableIndices[i] = -1
+		if variable, ok := expr.(*Variable); ok {
+			mapping.variableIndices[i] = schema.Index(variable.name)
+		}
+		if mapping.variableIndices[i] == -1 {
+			mapping.evaluated = true
+		}
+	}
+
+	if stream.keep {
+		for i, name := range schema.fields {
+			if !mapped[name] {
+				fieldNames = append(fieldNames, name)
+				mapping.keptIndices = append(mapping.keptIndices, i)
+			}
+		}
+	}
+	mapping.output = NewSchema(fieldNames)
+
+	stream.mapping = mapping
+	return mapping, nil
 }
 
 func (stream *MappedStream) Close() error {
@@ -45,8 +136,8 @@ func (stream *MappedStream) Close() error {
 	return nil
 }
 
-func (stream *MappedStream) Next() (*Record, error) {
-	srcRecord, err := stream.source.Next()
+func (stream *MappedStream) Next(ctx context.Context) (*Record, error) {
+	srcRecord, err := stream.source.Next(ctx)
 	if err != nil {
 		if err == ErrEndOfStream {
 			return nil, ErrEndOfStream
@@ -54,31 +145,111 @@ func (stream *MappedStream) Next() (*Record, error) {
 		return nil, errors.Wrap(err, "couldn't get source record")
 	}
 
-	variables, err := stream.variables.MergeWith(srcRecord.AsVariables())
+	if stream.binding == nil {
+		stream.binding = stream.newBinding()
+	}
+
+	return stream.mapRecord(ctx, stream.binding, srcRecord)
+}
+
+func (stream *MappedStream) newBinding() *recordBinding {
+	expressions := make([]Expression, len(stream.expressions))
+	for i := range stream.expressions {
+		expressions[i] = stream.expressions[i]
+	}
+	return newRecordBinding(stream.variables, expressions, nil)
+}
+
+func (stream *MappedStream) mapRecord(ctx context.Context, binding *recordBinding, srcRecord *Record) (*Record, error) {
+	mapping, err := stream.mappingFor(srcRecord.schema)
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't merge given variables with record variables")
 	}
 
-	fieldNames := make([]octosql.VariableName, 0)
-	outValues := make(map[octosql.VariableName]octosql.Value)
-	for _, expr := range stream.expressions {
-		fieldNames = append(fieldNames, expr.Name())
+	if mapping.evaluated {
+		err = binding.Set(srcRecord)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't merge given variables with record variables")
+		}
+	}
+
+	outValues := make([]octosql.Value, 0, mapping.output.Len())
+	for i, expr := range stream.expressions {
+		if index := mapping.variableIndices[i]; index != -1 {
+			outValues = append(outValues, srcRecord.data[index])
+			continue
+		}
 
-		value, err := expr.ExpressionValue(variables)
+		value, err := binding.Value(ctx, i)
 		if err != nil {
 			return nil, errors.Wrapf(err, "couldn't get expression %v", expr.Name())
 		}
-		outValues[expr.Name()] = value
+		outValues = append(outValues, value)
 	}
 
-	if stream.keep {
-		for _, name := range srcRecord.fieldNames {
-			if _, ok := outValues[name]; !ok {
-				fieldNames = append(fieldNames, name)
-				outValues[name] = srcRecord.Value(name)
+	for _, index := range mapping.keptIndices {
+		outValues = append(outValues, srcRecord.data[index])
+	}
+
+	return NewRecordFromSchema(mapping.output, outValues), nil
+}
+
+// NextBatch evaluates the expressions for a whole batch of source records at a time.
+// Columns of plain variables and kept source columns are reused as they are.
+func (stream *MappedStream) NextBatch(ctx context.Context, maxSize int) (*RecordBatch, error) {
+	if stream.batches == nil {
+		stream.batches = NewBatchReader(stream.source)
+	}
+	if stream.binding == nil {
+		stream.binding = stream.newBinding()
+	}
+
+	batch, err := stream.batches.NextBatch(ctx, maxSize)
+	if err != nil {
+		if err == ErrEndOfStream {
+			return nil, ErrEndOfStream
+		}
+		return nil, errors.Wrap(err, "couldn't get source record batch")
+	}
+
+	mapping, err := stream.mappingFor(batch.schema)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't merge given variables with record variables")
+	}
+
+	columns := make([][]octosql.Value, 0, mapping.output.Len())
+	for i := range stream.expressions {
+		if index := mapping.variableIndices[i]; index != -1 {
+			columns = append(columns, batch.Column(index))
+		} else {
+			columns = append(columns, make([]octosql.Value, batch.Len()))
+		}
+	}
+
+	if mapping.evaluated {
+		for row := 0; row < batch.Len(); row++ {
+			err := stream.binding.SetBatchRow(batch, row)
+			if err != nil {
+				return nil, errors.Wrap(err, "couldn't merge given variables with record variables")
+			}
+
+			for i, expr := range stream.expressions {
+				if mapping.variableIndices[i] != -1 {
+					continue
+				}
+
+				value, err := stream.binding.Value(ctx, i)
+				if err != nil {
+					return nil, errors.Wrapf(err, "couldn't get expression %v", expr.Name())
+				}
+				columns[i][row] = value
 			}
 		}
 	}
 
-	return NewRecord(fieldNames, outValues), nil
+	for _, index := range mapping.keptIndices {
+		columns = append(columns, batch.Column(index))
+	}
+
+	return NewRecordBatch(mapping.output, columns, batch.Len()), nil
 }
@@ -0,0 +1,107 @@
+package execution
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+// ErrMemoryBudgetExceeded is the cause of errors of operators, which can't keep the records they need in memory within the budget.
+var ErrMemoryBudgetExceeded = errors.New("query memory budget exceeded")
+
+// MemoryBudget accounts for the approximate number of bytes held in memory by all the operators of a query.
+// Operators which can spill to disk do so when their reservations fail, the others fail the query.
+// A nil budget is unlimited.
+type MemoryBudget struct {
+	limit int64
+	used  int64
+}
+
+func NewMemoryBudget(limit int) *MemoryBudget {
+	return &MemoryBudget{limit: int64(limit)}
+}
+
+type memoryBudgetKey struct{}
+
+// WithMemoryBudget makes the budget the memory budget of the query executed with the context.
+func WithMemoryBudget(ctx context.Context, budget *MemoryBudget) context.Context {
+	return context.WithValue(ctx, memoryBudgetKey{}, budget)
+}
+
+// MemoryBudgetFromContext returns the memory budget of the query, nil if it's unlimited.
+func MemoryBudgetFromContext(ctx context.Context) *MemoryBudget {
+	budget, _ := ctx.Value(memoryBudgetKey{}).(*MemoryBudget)
+	return budget
+}
+
+// TryReserve reserves the bytes if they fit in the budget, and returns whether they did.
+func (budget *MemoryBudget) TryReserve(bytes int) bool {
+	if budget == nil {
+		return true
+	}
+
+	for {
+		used := atomic.LoadInt64(&budget.used)
+		if used+int64(bytes) > budget.limit {
+			return false
+		}
+		if atomic.CompareAndSwapInt64(&budget.used, used, used+int64(bytes)) {
+			return true
+		}
+	}
+}
+
+// Reserve reserves the bytes for the holder, or returns an error caused by ErrMemoryBudgetExceeded if they don't fit in the budget.
+func (budget *MemoryBudget) Reserve(bytes int, holder string) error {
+	if budget.TryReserve(bytes) {
+		return nil
+	}
+	return errors.Wrapf(
+		ErrMemoryBudgetExceeded,
+		"%s couldn't reserve %d bytes, %d of %d bytes are in use",
+		holder,
+		bytes,
+		atomic.LoadInt64(&budget.used),
+		budget.limit,
+	)
+}
+
+// ReserveRecord reserves the approximate size of the record for the holder, like Reserve, and returns the number of bytes reserved.
+func (budget *MemoryBudget) ReserveRecord(record *Record, holder string) (int, error) {
+	size := approximateRecordSize(record)
+	if err := budget.Reserve(size, holder); err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
+// Release gives back previously reserved bytes.
+func (budget *MemoryBudget) Release(bytes int) {
+	if budget == nil {
+		return
+	}
+	atomic.AddInt64(&budget.used, -int64(bytes))
+}
+
+// Used returns the number of bytes reserved at the moment.
+func (budget *MemoryBudget) Used() int {
+	if budget == nil {
+		return 0
+	}
+	return int(atomic.LoadInt64(&budget.used))
+}
+
+// budgetedStream releases the bytes reserved for the records held by its source, once it's closed.
+type budgetedStream struct {
+	RecordStream
+	budget   *MemoryBudget
+	reserved int
+}
+
+func (stream *budgetedStream) Close() error {
+	stream.budget.Release(stream.reserved)
+	stream.reserved = 0
+
+	return stream.RecordStream.Close()
+}
@@ -0,0 +1,241 @@
+package execution
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cube2222/octosql"
+	"github.com/pkg/errors"
+)
+
+func TestMemoryBudget(t *testing.T) {
+	budget := NewMemoryBudget(100)
+
+	if !budget.TryReserve(60) {
+		t.Errorf("TryReserve(60) failed with 100 bytes free")
+	}
+	if budget.TryReserve(50) {
+		t.Errorf("TryReserve(50) succeeded with 40 bytes free")
+	}
+	if err := budget.Reserve(50, "test"); errors.Cause(err) != ErrMemoryBudgetExceeded {
+		t.Errorf("Reserve(50) error is %v, want %v", err, ErrMemoryBudgetExceeded)
+	}
+
+	budget.Release(60)
+	if err := budget.Reserve(100, "test"); err != nil {
+		t.Errorf("Reserve(100) error after release: %v", err)
+	}
+	if budget.Used() != 100 {
+		t.Errorf("Used() = %v, want %v", budget.Used(), 100)
+	}
+
+	var unlimited *MemoryBudget
+	if err := unlimited.Reserve(1<<40, "test"); err != nil {
+		t.Errorf("Reserve() on nil budget error: %v", err)
+	}
+	unlimited.Release(1 << 40)
+}
+
+func TestMemoryBudget_Spill(t *testing.T) {
+	fields := []octosql.VariableName{"id", "name"}
+	var records []*Record
+	var expected []*Record
+	for i := 0; i < 200; i++ {
+		record := NewRecordFromSlice(fields, []octosql.Value{
+			octosql.MakeInt(i % 53),
+			octosql.MakeString(string(rune('a' + i%53%26))),
+		})
+		records = append(records, record)
+		if i < 53 {
+			expected = append(expected, record)
+		}
+	}
+
+	tests := []struct {
+		name string
+		node Node
+		want Node
+	}{
+		{
+			name: "order by",
+			node: NewOrderBy([]Expression{NewVariable("id")}, []OrderDirection{Ascending}, NewDummyNode(records), DefaultSortMemoryLimit),
+			want: NewOrderBy([]Expression{NewVariable("id")}, []OrderDirection{Ascending}, NewDummyNode(records), DefaultSortMemoryLimit),
+		},
+		{
+			name: "distinct",
+			node: NewDistinct(NewDummyNode(records), DefaultGroupMemoryLimit),
+			want: NewDummyNode(expected),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, err := tt.want.Get(context.Background(), octosql.NoVariables())
+			if err != nil {
+				t.Fatalf("couldn't get expected stream: %v", err)
+			}
+
+			budget := NewMemoryBudget(1000)
+			ctx := WithMemoryBudget(context.Background(), budget)
+			got, err := tt.node.Get(ctx, octosql.NoVariables())
+			if err != nil {
+				t.Fatalf("couldn't get stream: %v", err)
+			}
+
+			equal, err := AreStreamsEqualNoOrdering(ctx, want, got)
+			if err != nil {
+				t.Errorf("Error in AreStreamsEqualNoOrdering(): %v", err)
+			}
+			if !equal {
+				t.Errorf("Streams don't match")
+			}
+
+			if err := got.Close(); err != nil {
+				t.Errorf("Close() error: %v", err)
+			}
+			if budget.Used() != 0 {
+				t.Errorf("%v bytes are still reserved after Close()", budget.Used())
+			}
+		})
+	}
+}
+
+func TestMemoryBudget_Exceeded(t *testing.T) {
+	fields := []octosql.VariableName{"id"}
+	var records []*Record
+	for i := 0; i < 10; i++ {
+		records = append(records, NewRecordFromSlice(fields, []octosql.Value{octosql.MakeInt(i)}))
+	}
+
+	ctx := WithMemoryBudget(context.Background(), NewMemoryBudget(150))
+
+	_, err := NewNodeExpression(NewDummyNode(records)).ExpressionValue(ctx, octosql.NoVariables())
+	if errors.Cause(err) != ErrMemoryBudgetExceeded {
+		t.Errorf("NodeExpression.ExpressionValue() error is %v, want %v", err, ErrMemoryBudgetExceeded)
+	}
+
+	ctx = WithMemoryBudget(context.Background(), NewMemoryBudget(1))
+	stream, err := NewDistinct(NewDummyNode(records), DefaultGroupMemoryLimit).Get(ctx, octosql.NoVariables())
+	if err != nil {
+		t.Fatalf("couldn't get distinct stream: %v", err)
+	}
+	if _, err := stream.Next(ctx); errors.Cause(err) != ErrMemoryBudgetExceeded {
+		t.Errorf("DistinctStream.Next() error is %v, want %v", err, ErrMemoryBudgetExceeded)
+	}
+}
+
+// releasingNode returns its records, releasing the bytes from the query memory budget after the given number of them.
+type releasingNode struct {
+	records []*Record
+	after   int
+	bytes   int
+}
+
+func (node *releasingNode) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	return &releasingStream{records: node.records, after: node.after, bytes: node.bytes, budget: MemoryBudgetFromContext(ctx)}, nil
+}
+
+type releasingStream struct {
+	records []*Record
+	after   int
+	bytes   int
+	budget  *MemoryBudget
+}
+
+func (stream *releasingStream) Next(ctx context.Context) (*Record, error) {
+	if stream.after == 0 {
+		stream.budget.Release(stream.bytes)
+	}
+	stream.after--
+
+	if len(stream.records) == 0 {
+		return nil, ErrEndOfStream
+	}
+	record := stream.records[0]
+	stream.records = stream.records[1:]
+	return record, nil
+}
+
+func (stream *releasingStream) Close() error {
+	return nil
+}
+
+func TestMemoryBudget_SpillWhileMemoryIsReleased(t *testing.T) {
+	fields := []octosql.VariableName{"id", "value"}
+	var records []*Record
+	sums := make(map[int]int)
+	for i := 0; i < 3*DefaultBatchSize; i++ {
+		records = append(records, NewRecordFromSlice(fields, []octosql.Value{
+			octosql.MakeInt(i % 20),
+			octosql.MakeInt(1),
+		}))
+		sums[i%20]++
+	}
+
+	var distinct []*Record
+	var grouped []*Record
+	for id, sum := range sums {
+		distinct = append(distinct, NewRecordFromSlice(fields, []octosql.Value{octosql.MakeInt(id), octosql.MakeInt(1)}))
+		grouped = append(grouped, NewRecordFromSlice([]octosql.VariableName{"value_sum"}, []octosql.Value{octosql.MakeInt(sum)}))
+	}
+
+	tests := []struct {
+		name string
+		node func(source Node) Node
+		want []*Record
+	}{
+		{
+			name: "group by",
+			node: func(source Node) Node {
+				return NewGroupBy(
+					source,
+					[]Expression{NewVariable("id")},
+					[]octosql.VariableName{"value"},
+					[]AggregatePrototype{newSumAggregate},
+					[]octosql.VariableName{""},
+					DefaultGroupMemoryLimit,
+				)
+			},
+			want: grouped,
+		},
+		{
+			name: "distinct",
+			node: func(source Node) Node {
+				return NewDistinct(source, DefaultGroupMemoryLimit)
+			},
+			want: distinct,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			budget := NewMemoryBudget(1000)
+			ctx := WithMemoryBudget(context.Background(), budget)
+
+			// Another operator holds most of the budget, until it releases it in the middle of the source,
+			// after the group by has aggregated the first batches.
+			if err := budget.Reserve(800, "test"); err != nil {
+				t.Fatal(err)
+			}
+			source := &releasingNode{records: records, after: 2 * DefaultBatchSize, bytes: 800}
+
+			got, err := tt.node(source).Get(ctx, octosql.NoVariables())
+			if err != nil {
+				t.Fatalf("couldn't get stream: %v", err)
+			}
+
+			equal, err := AreStreamsEqualNoOrdering(ctx, NewInMemoryStream(tt.want), got)
+			if err != nil {
+				t.Errorf("Error in AreStreamsEqualNoOrdering(): %v", err)
+			}
+			if !equal {
+				t.Errorf("Streams don't match")
+			}
+
+			if err := got.Close(); err != nil {
+				t.Errorf("Close() error: %v", err)
+			}
+			if budget.Used() != 0 {
+				t.Errorf("%v bytes are still reserved after Close()", budget.Used())
+			}
+		})
+	}
+}
@@ -0,0 +1,335 @@
+package execution
+
+import (
+	"context"
+
+	"github.com/cube2222/octosql"
+	"github.com/pkg/errors"
+)
+
+// MergeJoin joins records on equal key values, streaming through both of its inputs,
+// which have to be sorted by their keys in ascending order. An unsorted input results in an error.
+// Only the joined records with the current key are held in memory.
+// Matching pairs must additionally satisfy the filter formula.
+// Left and full joins also return unmatched source records,
+// full joins also return unmatched joined records.
+type MergeJoin struct {
+	source    Node
+	joined    Node
+	sourceKey []Expression
+	joinedKey []Expression
+	filter    Formula
+	joinType  JoinType
+}
+
+func NewMergeJoin(source Node, joined Node, sourceKey []Expression, joinedKey []Expression, filter Formula, joinType JoinType) *MergeJoin {
+	return &MergeJoin{
+		source:    source,
+		joined:    joined,
+		sourceKey: sourceKey,
+		joinedKey: joinedKey,
+		filter:    filter,
+		joinType:  joinType,
+	}
+}
+
+func (node *MergeJoin) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	source, err := node.source.Get(ctx, variables)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get source record stream")
+	}
+
+	joined, err := node.joined.Get(ctx, variables)
+	if err != nil {
+		source.Close()
+		return nil, errors.Wrap(err, "couldn't get joined record stream")
+	}
+
+	return &MergeJoinedStream{
+		sourceBinding: newRecordBinding(variables, node.sourceKey, nil),
+		joinedBinding: newRecordBinding(variables, node.joinedKey, nil),
+		filterBinding: newRecordBinding(variables, nil, node.filter),
+		source:        source,
+		joined:        joined,
+		joinType:      node.joinType,
+	}, nil
+}
+
+type MergeJoinedStream struct {
+	sourceBinding *recordBinding
+	joinedBinding *recordBinding
+	filterBinding *recordBinding
+	source        RecordStream
+	joined        RecordStream
+	joinType      JoinType
+	schemas       concatSchemas
+
+	lastSourceKey octosql.Tuple
+	lastJoinedKey octosql.Tuple
+
+	// group contains the joined records with the current key.
+	group        []*Record
+	groupKey     octosql.Tuple
+	groupMatched []bool
+
+	nextJoined    *Record
+	nextJoinedKey octosql.Tuple
+	joinedDone    bool
+
+	sourceDone bool
+	finished   bool
+	queue      []*Record
+}
+
+func (stream *MergeJoinedStream) Close() error {
+	err := stream.source.Close()
+	if err != nil {
+		return errors.Wrap(err, "Couldn't close source stream")
+	}
+
+	err = stream.joined.Close()
+	if err != nil {
+		return errors.Wrap(err, "Couldn't close joined stream")
+	}
+
+	return nil
+}
+
+func (stream *MergeJoinedStream) Next(ctx context.Context) (*Record, error) {
+	for len(stream.queue) == 0 {
+		if stream.finished {
+			return nil, ErrEndOfStream
+		}
+
+		if stream.sourceDone {
+			if stream.joinType == FullJoinType {
+				// Flush all the remaining joined records as unmatched.
+				for {
+					ok, err := stream.advanceGroup(ctx)
+					if err != nil {
+						return nil, err
+					}
+					if !ok {
+						break
+					}
+				}
+			}
+			stream.finished = true
+			continue
+		}
+
+		err := stream.joinNextSourceRecord(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	record := stream.queue[0]
+	stream.queue = stream.queue[1:]
+	return record, nil
+}
+
+// joinNextSourceRecord reads the next source record and queues all the records resulting from it.
+func (stream *MergeJoinedStream) joinNextSourceRecord(ctx context.Context) error {
+	srcRecord, err := stream.source.Next(ctx)
+	if err != nil {
+		if err == ErrEndOfStream {
+			stream.sourceDone = true
+			return nil
+		}
+		return errors.Wrap(err, "couldn't get source record")
+	}
+
+	key, err := evaluateJoinKey(ctx, stream.sourceBinding, srcRecord)
+	if err != nil {
+		return errors.Wrap(err, "couldn't evaluate source record key")
+	}
+	if key == nil {
+		// Null keys never match.
+		if stream.joinType != InnerJoinType {
+			stream.queue = append(stream.queue, srcRecord)
+		}
+		return nil
+	}
+	if stream.lastSourceKey != nil {
+		cmp, err := compareKeys(stream.lastSourceKey, key)
+		if err != nil {
+			return errors.Wrap(err, "couldn't compare source record keys")
+		}
+		if cmp > 0 {
+			return errors.Errorf("source records aren't sorted by join key: %v after %v", key, stream.lastSourceKey)
+		}
+	}
+	stream.lastSourceKey = key
+
+	cmp := -1
+	for {
+		if stream.groupKey != nil {
+			cmp, err = compareKeys(stream.groupKey, key)
+			if err != nil {
+				return errors.Wrap(err, "couldn't compare source and joined record keys")
+			}
+			if cmp >= 0 {
+				break
+			}
+		}
+
+		ok, err := stream.advanceGroup(ctx)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+	}
+
+	joinedAnyRecord := false
+	if stream.groupKey != nil && cmp == 0 {
+		for i := range stream.group {
+			record, ok, err := stream.joinRecords(ctx, srcRecord, stream.group[i])
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+			joinedAnyRecord = true
+			stream.groupMatched[i] = true
+			stream.queue = append(stream.queue, record)
+		}
+	}
+
+	if !joinedAnyRecord && stream.joinType != InnerJoinType {
+		stream.queue = append(stream.queue, srcRecord)
+	}
+
+	return nil
+}
+
+// advanceGroup replaces the current group with the joined records with the next key.
+// Unmatched records of the replaced group are queued in full joins.
+// It returns false if there are no more joined records.
+func (stream *MergeJoinedStream) advanceGroup(ctx context.Context) (bool, error) {
+	if stream.joinType == FullJoinType {
+		for i := range stream.group {
+			if !stream.groupMatched[i] {
+				stream.queue = append(stream.queue, stream.group[i])
+			}
+		}
+	}
+	stream.group = nil
+	stream.groupKey = nil
+	stream.groupMatched = nil
+
+	if stream.nextJoined == nil {
+		err := stream.readJoined(ctx)
+		if err != nil {
+			return false, err
+		}
+		if stream.nextJoined == nil {
+			return false, nil
+		}
+	}
+
+	stream.groupKey = stream.nextJoinedKey
+	for stream.nextJoined != nil {
+		cmp, err := compareKeys(stream.groupKey, stream.nextJoinedKey)
+		if err != nil {
+			return false, errors.Wrap(err, "couldn't compare joined record keys")
+		}
+		if cmp != 0 {
+			break
+		}
+		stream.group = append(stream.group, stream.nextJoined)
+		stream.groupMatched = append(stream.groupMatched, false)
+
+		err = stream.readJoined(ctx)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// readJoined reads the next joined record with a non-null key into nextJoined, which is nil at the end of the stream.
+func (stream *MergeJoinedStream) readJoined(ctx context.Context) error {
+	stream.nextJoined = nil
+	stream.nextJoinedKey = nil
+
+	for !stream.joinedDone {
+		record, err := stream.joined.Next(ctx)
+		if err != nil {
+			if err == ErrEndOfStream {
+				stream.joinedDone = true
+				return nil
+			}
+			return errors.Wrap(err, "couldn't get joined record")
+		}
+
+		key, err := evaluateJoinKey(ctx, stream.joinedBinding, record)
+		if err != nil {
+			return errors.Wrap(err, "couldn't evaluate joined record key")
+		}
+		if key == nil {
+			// Null keys never match, they're only interesting for full joins.
+			if stream.joinType == FullJoinType {
+				stream.queue = append(stream.queue, record)
+			}
+			continue
+		}
+		if stream.lastJoinedKey != nil {
+			cmp, err := compareKeys(stream.lastJoinedKey, key)
+			if err != nil {
+				return errors.Wrap(err, "couldn't compare joined record keys")
+			}
+			if cmp > 0 {
+				return errors.Errorf("joined records aren't sorted by join key: %v after %v", key, stream.lastJoinedKey)
+			}
+		}
+		stream.lastJoinedKey = key
+
+		stream.nextJoined = record
+		stream.nextJoinedKey = key
+		return nil
+	}
+
+	return nil
+}
+
+// joinRecords returns the joined record and true if the pair of records satisfies the filter.
+func (stream *MergeJoinedStream) joinRecords(ctx context.Context, srcRecord, joinedRecord *Record) (*Record, bool, error) {
+	record, err := concatRecords(&stream.schemas, srcRecord, joinedRecord)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "couldn't merge current record variables with joined record variables")
+	}
+
+	err = stream.filterBinding.Set(record)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "couldn't merge given variables with joined record variables")
+	}
+
+	ok, err := stream.filterBinding.Holds(ctx)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "couldn't evaluate join filter")
+	}
+	if !ok {
+		return nil, false, nil
+	}
+
+	return record, true, nil
+}
+
+// compareKeys compares the keys lexicographically.
+func compareKeys(x, y octosql.Tuple) (int, error) {
+	for i := range x {
+		cmp, err := compare(x[i], y[i])
+		if err != nil {
+			return 0, errors.Wrapf(err, "couldn't compare key values with index %v", i)
+		}
+		if cmp != 0 {
+			return cmp, nil
+		}
+	}
+	return 0, nil
+}
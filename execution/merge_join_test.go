@@ -0,0 +1,151 @@
+package execution
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cube2222/octosql"
+)
+
+func TestMergeJoin_Get(t *testing.T) {
+	ctx := context.Background()
+	userFields := []octosql.VariableName{"e.user_id", "e.action"}
+	nameFields := []octosql.VariableName{"u.id", "u.name"}
+	joinedFields := []octosql.VariableName{"e.user_id", "e.action", "u.id", "u.name"}
+
+	events := func() Node {
+		return NewDummyNode([]*Record{
+			NewRecordFromSliceWithNormalize(userFields, []interface{}{1, "a"}),
+			NewRecordFromSlice(userFields, []octosql.Value{nil, octosql.MakeString("b")}),
+			NewRecordFromSliceWithNormalize(userFields, []interface{}{1, "c"}),
+			NewRecordFromSliceWithNormalize(userFields, []interface{}{2, "d"}),
+			NewRecordFromSliceWithNormalize(userFields, []interface{}{4, "e"}),
+		})
+	}
+	users := func() Node {
+		return NewDummyNode([]*Record{
+			NewRecordFromSliceWithNormalize(nameFields, []interface{}{1, "alice"}),
+			NewRecordFromSliceWithNormalize(nameFields, []interface{}{1, "alicia"}),
+			NewRecordFromSliceWithNormalize(nameFields, []interface{}{3, "carol"}),
+			NewRecordFromSliceWithNormalize(nameFields, []interface{}{4, "dave"}),
+			NewRecordFromSliceWithNormalize(nameFields, []interface{}{5, "eve"}),
+		})
+	}
+
+	type args struct {
+		source    Node
+		joined    Node
+		sourceKey []Expression
+		joinedKey []Expression
+		filter    Formula
+		joinType  JoinType
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    RecordStream
+		wantErr bool
+	}{
+		{
+			name: "inner join",
+			args: args{
+				source:    events(),
+				joined:    users(),
+				sourceKey: []Expression{NewVariable("e.user_id")},
+				joinedKey: []Expression{NewVariable("u.id")},
+				filter:    NewConstant(true),
+				joinType:  InnerJoinType,
+			},
+			want: NewInMemoryStream([]*Record{
+				NewRecordFromSliceWithNormalize(joinedFields, []interface{}{1, "a", 1, "alice"}),
+				NewRecordFromSliceWithNormalize(joinedFields, []interface{}{1, "a", 1, "alicia"}),
+				NewRecordFromSliceWithNormalize(joinedFields, []interface{}{1, "c", 1, "alice"}),
+				NewRecordFromSliceWithNormalize(joinedFields, []interface{}{1, "c", 1, "alicia"}),
+				NewRecordFromSliceWithNormalize(joinedFields, []interface{}{4, "e", 4, "dave"}),
+			}),
+		},
+		{
+			name: "left join with filter",
+			args: args{
+				source:    events(),
+				joined:    users(),
+				sourceKey: []Expression{NewVariable("e.user_id")},
+				joinedKey: []Expression{NewVariable("u.id")},
+				filter:    NewPredicate(NewVariable("u.name"), &NotEqual{}, NewDummyValue(octosql.MakeString("alicia"))),
+				joinType:  LeftJoinType,
+			},
+			want: NewInMemoryStream([]*Record{
+				NewRecordFromSliceWithNormalize(joinedFields, []interface{}{1, "a", 1, "alice"}),
+				NewRecordFromSlice(userFields, []octosql.Value{nil, octosql.MakeString("b")}),
+				NewRecordFromSliceWithNormalize(joinedFields, []interface{}{1, "c", 1, "alice"}),
+				NewRecordFromSliceWithNormalize(userFields, []interface{}{2, "d"}),
+				NewRecordFromSliceWithNormalize(joinedFields, []interface{}{4, "e", 4, "dave"}),
+			}),
+		},
+		{
+			name: "full join",
+			args: args{
+				source:    events(),
+				joined:    users(),
+				sourceKey: []Expression{NewVariable("e.user_id")},
+				joinedKey: []Expression{NewVariable("u.id")},
+				filter:    NewPredicate(NewVariable("u.name"), &NotEqual{}, NewDummyValue(octosql.MakeString("alicia"))),
+				joinType:  FullJoinType,
+			},
+			want: NewInMemoryStream([]*Record{
+				NewRecordFromSliceWithNormalize(joinedFields, []interface{}{1, "a", 1, "alice"}),
+				NewRecordFromSlice(userFields, []octosql.Value{nil, octosql.MakeString("b")}),
+				NewRecordFromSliceWithNormalize(joinedFields, []interface{}{1, "c", 1, "alice"}),
+				NewRecordFromSliceWithNormalize(nameFields, []interface{}{1, "alicia"}),
+				NewRecordFromSliceWithNormalize(userFields, []interface{}{2, "d"}),
+				NewRecordFromSliceWithNormalize(nameFields, []interface{}{3, "carol"}),
+				NewRecordFromSliceWithNormalize(joinedFields, []interface{}{4, "e", 4, "dave"}),
+				NewRecordFromSliceWithNormalize(nameFields, []interface{}{5, "eve"}),
+			}),
+		},
+		{
+			name: "unsorted input",
+			args: args{
+				source: events(),
+				joined: NewDummyNode([]*Record{
+					NewRecordFromSliceWithNormalize(nameFields, []interface{}{3, "carol"}),
+					NewRecordFromSliceWithNormalize(nameFields, []interface{}{1, "alice"}),
+				}),
+				sourceKey: []Expression{NewVariable("e.user_id")},
+				joinedKey: []Expression{NewVariable("u.id")},
+				filter:    NewConstant(true),
+				joinType:  InnerJoinType,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := NewMergeJoin(tt.args.source, tt.args.joined, tt.args.sourceKey, tt.args.joinedKey, tt.args.filter, tt.args.joinType)
+
+			stream, err := node.Get(ctx, octosql.NoVariables())
+			if err != nil {
+				t.Errorf("MergeJoin.Get() error = %v", err)
+				return
+			}
+
+			if tt.wantErr {
+				for err == nil {
+					_, err = stream.Next(ctx)
+				}
+				if err == ErrEndOfStream {
+					t.Errorf("MergeJoinedStream.Next() wanted error")
+				}
+				return
+			}
+
+			equal, err := AreStreamsEqual(ctx, stream, tt.want)
+			if err != nil {
+				t.Errorf("MergeJoin.Get() AreStreamsEqual error = %v", err)
+			}
+			if !equal {
+				t.Errorf("MergeJoin.Get() streams not equal")
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package execution
 
 import (
+	"context"
+
 	"github.com/cube2222/octosql"
 	"github.com/pkg/errors"
 )
@@ -14,13 +16,13 @@ func NewOffset(data Node, offsetExpr Expression) *Offset {
 	return &Offset{data: data, offsetExpr: offsetExpr}
 }
 
-func (node *Offset) Get(variables octosql.Variables) (RecordStream, error) {
-	dataStream, err := node.data.Get(variables)
+func (node *Offset) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	dataStream, err := node.data.Get(ctx, variables)
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't get data record stream")
 	}
 
-	exprVal, err := node.offsetExpr.ExpressionValue(variables)
+	exprVal, err := node.offsetExpr.ExpressionValue(ctx, variables)
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't extract value from offset subexpression")
 	}
@@ -34,7 +36,7 @@ func (node *Offset) Get(variables octosql.Variables) (RecordStream, error) {
 	}
 
 	for ; offsetVal > 0; offsetVal-- {
-		_, err := dataStream.Next()
+		_, err := dataStream.Next(ctx)
 		if err != nil {
 			if err == ErrEndOfStream {
 				return dataStream, nil
@@ -1,6 +1,7 @@
 package execution
 
 import (
+	"context"
 	"reflect"
 	"sort"
 
@@ -15,17 +16,24 @@ const (
 	Descending OrderDirection = "desc"
 )
 
+// DefaultSortMemoryLimit is the default approximate number of bytes of records an OrderBy keeps in memory.
+const DefaultSortMemoryLimit = 256 * 1024 * 1024
+
+// OrderBy sorts its source records. If they don't fit in memoryLimit bytes,
+// they get sorted in runs, which are spilled to disk and later merged.
 type OrderBy struct {
 	expressions []Expression
 	directions  []OrderDirection
 	source      Node
+	memoryLimit int
 }
 
-func NewOrderBy(exprs []Expression, directions []OrderDirection, source Node) *OrderBy {
+func NewOrderBy(exprs []Expression, directions []OrderDirection, source Node, memoryLimit int) *OrderBy {
 	return &OrderBy{
 		expressions: exprs,
 		directions:  directions,
 		source:      source,
+		memoryLimit: memoryLimit,
 	}
 }
 
@@ -118,13 +126,13 @@ func compare(x, y octosql.Value) (int, error) {
 	}
 }
 
-func (ob *OrderBy) Get(variables octosql.Variables) (RecordStream, error) {
-	sourceStream, err := ob.source.Get(variables)
+func (ob *OrderBy) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	sourceStream, err := ob.source.Get(ctx, variables)
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't get underlying stream in order by")
 	}
 
-	orderedStream, err := createOrderedStream(ob.expressions, ob.directions, variables, sourceStream)
+	orderedStream, err := createOrderedStream(ctx, ob.expressions, ob.directions, variables, sourceStream, ob.memoryLimit)
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't create ordered stream from source stream")
 	}
@@ -132,79 +140,166 @@ func (ob *OrderBy) Get(variables octosql.Variables) (RecordStream, error) {
 	return orderedStream, nil
 }
 
-func createOrderedStream(expressions []Expression, directions []OrderDirection, variables octosql.Variables, sourceStream RecordStream) (stream RecordStream, outErr error) {
-	records := make([]*Record, 0)
+// createOrderedStream sorts the source stream in runs of records, which use up to memoryLimit bytes of memory.
+// A run also ends when the query memory budget can't fit its next record.
+// If all the records fit in a single run, they're sorted in memory.
+// Otherwise, the sorted runs get spilled to temporary files and are later merged.
+func createOrderedStream(ctx context.Context, expressions []Expression, directions []OrderDirection, variables octosql.Variables, sourceStream RecordStream, memoryLimit int) (RecordStream, error) {
+	var run []sortedRecord
+	runSize := 0
+	var spilled []*sortRunFile
+	binding := newRecordBinding(variables, expressions, nil)
+	budget := MemoryBudgetFromContext(ctx)
+	defer func() {
+		budget.Release(runSize)
+	}()
 
 	for {
-		rec, err := sourceStream.Next()
+		rec, err := sourceStream.Next(ctx)
 		if err == ErrEndOfStream {
 			break
 		} else if err != nil {
+			closeSortRunFiles(spilled)
 			return nil, errors.Wrap(err, "couldn't get all records")
 		}
 
-		records = append(records, rec)
-	}
-
-	defer func() {
-		if err := recover(); err != nil {
-			stream = nil
-			outErr = errors.Wrap(err.(error), "couldn't sort records")
+		key, err := evaluateSortKey(ctx, binding, rec)
+		if err != nil {
+			closeSortRunFiles(spilled)
+			return nil, err
 		}
-	}()
-	sort.Slice(records, func(i, j int) bool {
-		iRec := records[i]
-		jRec := records[j]
 
-		for num, expr := range expressions {
-			// TODO: Aggressive caching of these expressions...
-			iVars, err := variables.MergeWith(iRec.AsVariables())
-			if err != nil {
-				panic(errors.Wrap(err, "couldn't merge variables"))
+		size := approximateRecordSize(rec)
+		if !budget.TryReserve(size) {
+			if len(run) == 0 {
+				closeSortRunFiles(spilled)
+				return nil, budget.Reserve(size, "order by")
 			}
-			jVars, err := variables.MergeWith(jRec.AsVariables())
+			file, err := spillSortRun(run, directions)
 			if err != nil {
-				panic(errors.Wrap(err, "couldn't merge variables"))
+				closeSortRunFiles(spilled)
+				return nil, errors.Wrap(err, "couldn't spill sorted run to disk")
 			}
-
-			x, err := expr.ExpressionValue(iVars)
-			if err != nil {
-				panic(errors.Wrapf(err, "couldn't get order by expression with index %v value", num))
+			spilled = append(spilled, file)
+			run = nil
+			budget.Release(runSize)
+			runSize = 0
+
+			if err := budget.Reserve(size, "order by"); err != nil {
+				closeSortRunFiles(spilled)
+				return nil, err
 			}
-			y, err := expr.ExpressionValue(jVars)
+		}
+		run = append(run, sortedRecord{key: key, record: rec})
+		runSize += size
+
+		if runSize > memoryLimit {
+			file, err := spillSortRun(run, directions)
 			if err != nil {
-				panic(errors.Wrapf(err, "couldn't get order by expression with index %v value", num))
+				closeSortRunFiles(spilled)
+				return nil, errors.Wrap(err, "couldn't spill sorted run to disk")
 			}
+			spilled = append(spilled, file)
+			run = nil
+			budget.Release(runSize)
+			runSize = 0
+		}
+	}
 
-			if !isSorteable(x) {
-				panic(errors.Errorf("value %v of type %v is not comparable", x, reflect.TypeOf(x).String()))
-			}
-			if !isSorteable(y) {
-				panic(errors.Errorf("value %v of type %v is not comparable", y, reflect.TypeOf(y).String()))
-			}
+	if len(spilled) == 0 {
+		err := sortRun(run, directions)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't sort records")
+		}
 
-			cmp, err := compare(x, y)
-			if err != nil {
-				panic(errors.Errorf("failed to compare values %v and %v", x, y))
-			}
+		records := make([]*Record, len(run))
+		for i := range run {
+			records[i] = run[i].record
+		}
 
-			answer := false
+		// The sorted records stay in memory until the stream is closed.
+		stream := &budgetedStream{
+			RecordStream: NewInMemoryStream(records),
+			budget:       budget,
+			reserved:     runSize,
+		}
+		runSize = 0
+		return stream, nil
+	}
 
-			if cmp == 0 {
-				continue
-			} else if cmp > 0 {
-				answer = true
-			}
+	if len(run) > 0 {
+		file, err := spillSortRun(run, directions)
+		if err != nil {
+			closeSortRunFiles(spilled)
+			return nil, errors.Wrap(err, "couldn't spill sorted run to disk")
+		}
+		spilled = append(spilled, file)
+	}
 
-			if directions[num] == Ascending {
-				answer = !answer
-			}
+	stream, err := newMergedSortRunsStream(spilled, directions)
+	if err != nil {
+		closeSortRunFiles(spilled)
+		return nil, errors.Wrap(err, "couldn't merge sorted runs")
+	}
+	return stream, nil
+}
+
+type sortedRecord struct {
+	key    octosql.Tuple
+	record *Record
+}
+
+func evaluateSortKey(ctx context.Context, binding *recordBinding, rec *Record) (octosql.Tuple, error) {
+	err := binding.Set(rec)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't merge variables")
+	}
+
+	key := make(octosql.Tuple, len(binding.expressions))
+	for num := range key {
+		key[num], err = binding.Value(ctx, num)
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't get order by expression with index %v value", num)
+		}
+		if !isSorteable(key[num]) {
+			return nil, errors.Errorf("value %v of type %v is not comparable", key[num], reflect.TypeOf(key[num]))
+		}
+	}
+
+	return key, nil
+}
 
-			return answer
+// compareSortKeys compares sort keys taking the directions into account.
+func compareSortKeys(x, y octosql.Tuple, directions []OrderDirection) (int, error) {
+	for num := range x {
+		cmp, err := compare(x[num], y[num])
+		if err != nil {
+			return 0, errors.Errorf("failed to compare values %v and %v", x[num], y[num])
+		}
+		if cmp == 0 {
+			continue
+		}
+		if directions[num] == Descending {
+			return -cmp, nil
 		}
+		return cmp, nil
+	}
 
-		return false
+	return 0, nil
+}
+
+func sortRun(run []sortedRecord, directions []OrderDirection) error {
+	var sortErr error
+	sort.SliceStable(run, func(i, j int) bool {
+		cmp, err := compareSortKeys(run[i].key, run[j].key, directions)
+		if err != nil {
+			if sortErr == nil {
+				sortErr = err
+			}
+			return false
+		}
+		return cmp < 0
 	})
 
-	return NewInMemoryStream(records), nil
+	return sortErr
 }
@@ -1,6 +1,7 @@
 package execution
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 )
 
 func TestOrderBy_Get(t *testing.T) {
+	ctx := context.Background()
 	now := time.Now()
 
 	type args struct {
@@ -248,7 +250,7 @@ func TestOrderBy_Get(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			ordered, err := createOrderedStream(tt.args.expressions, tt.args.directions, octosql.NoVariables(), tt.args.stream)
+			ordered, err := createOrderedStream(ctx, tt.args.expressions, tt.args.directions, octosql.NoVariables(), tt.args.stream, DefaultSortMemoryLimit)
 			if err != nil && !tt.wantErr {
 				t.Errorf("Error in create stream: %v", err)
 				return
@@ -256,7 +258,7 @@ func TestOrderBy_Get(t *testing.T) {
 				return
 			}
 
-			equal, err := AreStreamsEqual(tt.want, ordered)
+			equal, err := AreStreamsEqual(ctx, tt.want, ordered)
 			if err != nil {
 				t.Errorf("Error in AreStreamsEqual(): %v", err)
 				return
@@ -286,3 +288,42 @@ var FuncIdentity = Function{
 		return args[0], nil
 	},
 }
+
+func TestOrderBy_Spill(t *testing.T) {
+	ctx := context.Background()
+	fields := []octosql.VariableName{"name", "age", "note"}
+	var records []*Record
+	for i := 0; i < 50; i++ {
+		records = append(records, NewRecordFromSlice(fields, []octosql.Value{
+			octosql.MakeString(string(rune('a' + (i*7)%26))),
+			octosql.MakeInt(i % 5),
+			nil,
+		}))
+	}
+
+	expressions := []Expression{NewVariable("age"), NewVariable("name")}
+	directions := []OrderDirection{Descending, Ascending}
+
+	for _, memoryLimit := range []int{0, 500, 5000} {
+		want, err := createOrderedStream(ctx, expressions, directions, octosql.NoVariables(), NewInMemoryStream(records), DefaultSortMemoryLimit)
+		if err != nil {
+			t.Fatalf("Error in create in memory ordered stream: %v", err)
+		}
+
+		got, err := createOrderedStream(ctx, expressions, directions, octosql.NoVariables(), NewInMemoryStream(records), memoryLimit)
+		if err != nil {
+			t.Fatalf("Error in create spilled ordered stream with memory limit %v: %v", memoryLimit, err)
+		}
+		if _, ok := got.(*MergedSortRunsStream); !ok {
+			t.Errorf("records weren't spilled with memory limit %v", memoryLimit)
+		}
+
+		equal, err := AreStreamsEqual(ctx, want, got)
+		if err != nil {
+			t.Errorf("Error in AreStreamsEqual(): %v", err)
+		}
+		if !equal {
+			t.Errorf("Streams don't match with memory limit %v", memoryLimit)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package execution
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// parallelBatchSize is the number of records per worker in a single batch of a ParallelStream.
+const parallelBatchSize = 64
+
+// processRecord transforms a record, a nil result means the record is dropped.
+type processRecord func(ctx context.Context, record *Record) (*Record, error)
+
+// ParallelStream processes the records of its source in batches, evaluating a batch concurrently in workers.
+// The processed records are returned in source order.
+// The source is read through an exchange, so reading the next batch overlaps with processing the current one.
+type ParallelStream struct {
+	source  RecordStream
+	process processRecord
+	workers int
+
+	batch []*Record
+	index int
+	done  bool
+}
+
+func newParallelStream(ctx context.Context, source RecordStream, process processRecord, workers int) *ParallelStream {
+	return &ParallelStream{
+		source:  newExchangeStream(ctx, []RecordStream{source}, workers*parallelBatchSize),
+		process: process,
+		workers: workers,
+	}
+}
+
+func (stream *ParallelStream) Close() error {
+	err := stream.source.Close()
+	if err != nil {
+		return errors.Wrap(err, "Couldn't close underlying stream")
+	}
+
+	return nil
+}
+
+func (stream *ParallelStream) Next(ctx context.Context) (*Record, error) {
+	for stream.index == len(stream.batch) {
+		if stream.done {
+			return nil, ErrEndOfStream
+		}
+
+		err := stream.processBatch(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	record := stream.batch[stream.index]
+	stream.index++
+	return record, nil
+}
+
+// processBatch reads the next batch of source records and processes it.
+func (stream *ParallelStream) processBatch(ctx context.Context) error {
+	records := make([]*Record, 0, stream.workers*parallelBatchSize)
+	for len(records) < cap(records) {
+		record, err := stream.source.Next(ctx)
+		if err != nil {
+			if err == ErrEndOfStream {
+				stream.done = true
+				break
+			}
+			return errors.Wrap(err, "couldn't get source record")
+		}
+		records = append(records, record)
+	}
+
+	results := make([]*Record, len(records))
+	errs := make([]error, stream.workers)
+
+	var wg sync.WaitGroup
+	for worker := 0; worker < stream.workers; worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for i := worker; i < len(records); i += stream.workers {
+				result, err := stream.process(ctx, records[i])
+				if err != nil {
+					errs[worker] = err
+					return
+				}
+				results[i] = result
+			}
+		}(worker)
+	}
+	wg.Wait()
+
+	for i := range errs {
+		if errs[i] != nil {
+			return errs[i]
+		}
+	}
+
+	stream.batch = stream.batch[:0]
+	for i := range results {
+		if results[i] != nil {
+			stream.batch = append(stream.batch, results[i])
+		}
+	}
+	stream.index = 0
+
+	return nil
+}
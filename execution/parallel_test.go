@@ -0,0 +1,84 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cube2222/octosql"
+)
+
+func TestParallelStream(t *testing.T) {
+	ctx := context.Background()
+	fields := []octosql.VariableName{"id", "name"}
+	var records []*Record
+	for i := 0; i < 1000; i++ {
+		records = append(records, NewRecordFromSlice(fields, []octosql.Value{
+			octosql.MakeInt(i),
+			octosql.MakeString(fmt.Sprintf("name%d", i)),
+		}))
+	}
+
+	newNode := func(parallelism int) Node {
+		return NewMap(
+			[]NamedExpression{
+				NewAliasedExpression("renamed", NewVariable("name")),
+			},
+			NewFilter(
+				NewPredicate(NewVariable("id"), &MoreThan{}, NewDummyValue(octosql.MakeInt(100))),
+				NewDummyNode(records),
+				parallelism,
+			),
+			true,
+			parallelism,
+		)
+	}
+
+	for _, parallelism := range []int{2, 4, 7} {
+		want, err := newNode(1).Get(ctx, octosql.NoVariables())
+		if err != nil {
+			t.Fatalf("couldn't get serial stream: %v", err)
+		}
+
+		got, err := newNode(parallelism).Get(ctx, octosql.NoVariables())
+		if err != nil {
+			t.Fatalf("couldn't get stream with parallelism %v: %v", parallelism, err)
+		}
+		if _, ok := got.(*ParallelStream); !ok {
+			t.Errorf("stream with parallelism %v isn't parallel", parallelism)
+		}
+
+		equal, err := AreStreamsEqual(ctx, want, got)
+		if err != nil {
+			t.Errorf("Error in AreStreamsEqual(): %v", err)
+		}
+		if !equal {
+			t.Errorf("Streams don't match with parallelism %v", parallelism)
+		}
+	}
+}
+
+func TestParallelStream_Error(t *testing.T) {
+	ctx := context.Background()
+	fields := []octosql.VariableName{"id"}
+	var records []*Record
+	for i := 0; i < 100; i++ {
+		records = append(records, NewRecordFromSlice(fields, []octosql.Value{octosql.MakeInt(i)}))
+	}
+
+	node := NewFilter(
+		NewPredicate(NewVariable("id"), &MoreThan{}, NewDummyValue(octosql.MakeString("text"))),
+		NewDummyNode(records),
+		4,
+	)
+
+	stream, err := node.Get(ctx, octosql.NoVariables())
+	if err != nil {
+		t.Fatalf("couldn't get stream: %v", err)
+	}
+	defer stream.Close()
+
+	if _, err := stream.Next(ctx); err == nil {
+		t.Errorf("expected error for incomparable values")
+	}
+}
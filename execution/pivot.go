@@ -0,0 +1,254 @@
+package execution
+
+import (
+	"context"
+
+	"github.com/cube2222/octosql"
+	"github.com/cube2222/octosql/docs"
+	"github.com/pkg/errors"
+)
+
+const (
+	pivotKeyField   = octosql.VariableName("*pivot_key*")
+	pivotInputField = octosql.VariableName("*pivot_input*")
+)
+
+// Pivot turns the distinct values of the pivot field into separate, aggregated columns.
+// Records are grouped by all the fields other than the pivot and value fields.
+// Columns for values, which didn't occur in a group, are null.
+//
+// It's executed as a group by with an aggregate for each pivot value, which only aggregates the records with that pivot value.
+// As the other fields aren't known upfront, they're packed into a single key field before grouping and unpacked afterwards.
+type Pivot struct {
+	source Node
+
+	pivotField         octosql.VariableName
+	valueField         octosql.VariableName
+	aggregatePrototype AggregatePrototype
+
+	values []Expression
+	as     []octosql.VariableName
+
+	memoryLimit int
+}
+
+func NewPivot(source Node, pivotField, valueField octosql.VariableName, aggregatePrototype AggregatePrototype, values []Expression, as []octosql.VariableName, memoryLimit int) *Pivot {
+	return &Pivot{
+		source:             source,
+		pivotField:         pivotField,
+		valueField:         valueField,
+		aggregatePrototype: aggregatePrototype,
+		values:             values,
+		as:                 as,
+		memoryLimit:        memoryLimit,
+	}
+}
+
+func (node *Pivot) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	fields := []octosql.VariableName{pivotKeyField}
+	aggregatePrototypes := []AggregatePrototype{func() Aggregate { return &pivotKey{} }}
+	as := []octosql.VariableName{pivotKeyField}
+	for i := range node.values {
+		value, err := node.values[i].ExpressionValue(ctx, variables)
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't evaluate pivot value expression with index %v", i)
+		}
+
+		fields = append(fields, pivotInputField)
+		aggregatePrototypes = append(aggregatePrototypes, func() Aggregate {
+			return &pivotAggregate{
+				pivotValue: value,
+				aggregate:  node.aggregatePrototype(),
+				present:    NewHashMap(),
+			}
+		})
+		as = append(as, node.as[i])
+	}
+
+	input := &pivotInput{
+		source:     node.source,
+		pivotField: node.pivotField,
+		valueField: node.valueField,
+	}
+	groupBy := NewGroupBy(input, []Expression{NewVariable(pivotKeyField)}, fields, aggregatePrototypes, as, node.memoryLimit)
+
+	groups, err := groupBy.Get(ctx, variables)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get group by stream in pivot")
+	}
+
+	return &PivotStream{
+		source: groups,
+		as:     node.as,
+	}, nil
+}
+
+// PivotStream unpacks the grouped fields out of the key of the pivot groups.
+type PivotStream struct {
+	source RecordStream
+	as     []octosql.VariableName
+}
+
+func (stream *PivotStream) Next(ctx context.Context) (*Record, error) {
+	record, err := stream.source.Next(ctx)
+	if err != nil {
+		if err == ErrEndOfStream {
+			return nil, ErrEndOfStream
+		}
+		return nil, errors.Wrap(err, "couldn't get next pivot group")
+	}
+
+	key := record.Value(pivotKeyField).(octosql.Tuple)
+	names := key[0].(octosql.Tuple)
+	values := key[1].(octosql.Tuple)
+
+	fields := make([]octosql.VariableName, 0, len(names)+len(stream.as))
+	for i := range names {
+		fields = append(fields, octosql.NewVariableName(names[i].(octosql.String).AsString()))
+	}
+	fields = append(fields, stream.as...)
+
+	data := make([]octosql.Value, 0, len(fields))
+	data = append(data, values...)
+	data = append(data, record.data[1:]...)
+
+	return NewRecordFromSlice(fields, data), nil
+}
+
+func (stream *PivotStream) Close() error {
+	return stream.source.Close()
+}
+
+// pivotInput maps the source records to their pivot key, being the names and values of the grouped fields,
+// and their pivot input, being the pivot and value field values.
+type pivotInput struct {
+	source Node
+
+	pivotField octosql.VariableName
+	valueField octosql.VariableName
+}
+
+func (node *pivotInput) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	source, err := node.source.Get(ctx, variables)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get stream for source in pivot")
+	}
+
+	return &pivotInputStream{
+		source:     source,
+		pivotField: node.pivotField,
+		valueField: node.valueField,
+		schema:     NewSchema([]octosql.VariableName{pivotKeyField, pivotInputField}),
+	}, nil
+}
+
+type pivotInputStream struct {
+	source RecordStream
+
+	pivotField octosql.VariableName
+	valueField octosql.VariableName
+
+	schema *Schema
+}
+
+func (stream *pivotInputStream) Next(ctx context.Context) (*Record, error) {
+	record, err := stream.source.Next(ctx)
+	if err != nil {
+		if err == ErrEndOfStream {
+			return nil, ErrEndOfStream
+		}
+		return nil, errors.Wrap(err, "couldn't get next source record")
+	}
+
+	names := make(octosql.Tuple, 0, len(record.schema.fields))
+	values := make(octosql.Tuple, 0, len(record.schema.fields))
+	for i, name := range record.schema.fields {
+		if name == stream.pivotField || name == stream.valueField {
+			continue
+		}
+		names = append(names, octosql.MakeString(name.String()))
+		values = append(values, record.data[i])
+	}
+
+	var value octosql.Value
+	if stream.valueField == "*star*" {
+		mapping := make(octosql.Object, len(record.schema.fields))
+		for i, name := range record.schema.fields {
+			mapping[name.String()] = record.data[i]
+		}
+		value = mapping
+	} else {
+		value = record.Value(stream.valueField)
+	}
+
+	return NewRecordFromSchema(stream.schema, []octosql.Value{
+		octosql.Tuple{names, values},
+		octosql.Tuple{record.Value(stream.pivotField), value},
+	}), nil
+}
+
+func (stream *pivotInputStream) Close() error {
+	return stream.source.Close()
+}
+
+// pivotKey passes the pivot key through the group by.
+type pivotKey struct{}
+
+func (agg *pivotKey) Document() docs.Documentation {
+	return docs.Section(agg.String(), docs.Body())
+}
+
+func (agg *pivotKey) AddRecord(key octosql.Tuple, value octosql.Value) error {
+	return nil
+}
+
+func (agg *pivotKey) GetAggregated(key octosql.Tuple) (octosql.Value, error) {
+	return key[0], nil
+}
+
+func (agg *pivotKey) String() string {
+	return "pivot_key"
+}
+
+// pivotAggregate aggregates the values of the records with its pivot value.
+// It's null for groups without such records.
+type pivotAggregate struct {
+	pivotValue octosql.Value
+	aggregate  Aggregate
+	// present contains the keys of the groups, which had records with the pivot value.
+	present *HashMap
+}
+
+func (agg *pivotAggregate) Document() docs.Documentation {
+	return agg.aggregate.Document()
+}
+
+func (agg *pivotAggregate) AddRecord(key octosql.Tuple, value octosql.Value) error {
+	input := value.(octosql.Tuple)
+	if !octosql.AreEqual(input[0], agg.pivotValue) {
+		return nil
+	}
+
+	err := agg.present.Set(key, octosql.Phantom{})
+	if err != nil {
+		return errors.Wrap(err, "couldn't put group key into hashmap")
+	}
+
+	return agg.aggregate.AddRecord(key, input[1])
+}
+
+func (agg *pivotAggregate) GetAggregated(key octosql.Tuple) (octosql.Value, error) {
+	_, ok, err := agg.present.Get(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get group key out of hashmap")
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	return agg.aggregate.GetAggregated(key)
+}
+
+func (agg *pivotAggregate) String() string {
+	return agg.aggregate.String()
+}
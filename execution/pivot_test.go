@@ -0,0 +1,137 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cube2222/octosql"
+	"github.com/cube2222/octosql/docs"
+	"github.com/pkg/errors"
+)
+
+type testIntSum struct {
+	sums *HashMap
+}
+
+func (agg *testIntSum) Document() docs.Documentation {
+	panic("implement me")
+}
+
+func (agg *testIntSum) AddRecord(key octosql.Tuple, value octosql.Value) error {
+	sum, ok, err := agg.sums.Get(key)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		sum = octosql.MakeInt(0)
+	}
+	return agg.sums.Set(key, sum.(octosql.Int)+value.(octosql.Int))
+}
+
+func (agg *testIntSum) GetAggregated(key octosql.Tuple) (octosql.Value, error) {
+	sum, ok, err := agg.sums.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("sum for key not found")
+	}
+	return sum.(octosql.Int), nil
+}
+
+func (agg *testIntSum) String() string {
+	return "sum"
+}
+
+func TestPivot_Get(t *testing.T) {
+	ctx := context.Background()
+	fields := []octosql.VariableName{"s.region", "s.month", "s.amount"}
+
+	type args struct {
+		source     Node
+		pivotField octosql.VariableName
+		valueField octosql.VariableName
+		values     []Expression
+		as         []octosql.VariableName
+	}
+	tests := []struct {
+		name string
+		args args
+		want []*Record
+	}{
+		{
+			name: "simple pivot",
+			args: args{
+				source: NewDummyNode([]*Record{
+					NewRecordFromSliceWithNormalize(fields, []interface{}{"north", "jan", 1}),
+					NewRecordFromSliceWithNormalize(fields, []interface{}{"north", "feb", 2}),
+					NewRecordFromSliceWithNormalize(fields, []interface{}{"south", "jan", 3}),
+					NewRecordFromSliceWithNormalize(fields, []interface{}{"north", "jan", 4}),
+					NewRecordFromSliceWithNormalize(fields, []interface{}{"south", "mar", 5}),
+					NewRecordFromSliceWithNormalize(fields, []interface{}{"east", "mar", 6}),
+				}),
+				pivotField: "s.month",
+				valueField: "s.amount",
+				values:     []Expression{NewDummyValue(octosql.MakeString("jan")), NewDummyValue(octosql.MakeString("feb"))},
+				as:         []octosql.VariableName{"jan", "february"},
+			},
+			want: []*Record{
+				NewRecordFromSlice(
+					[]octosql.VariableName{"s.region", "jan", "february"},
+					[]octosql.Value{octosql.MakeString("north"), octosql.MakeInt(5), octosql.MakeInt(2)}),
+				NewRecordFromSlice(
+					[]octosql.VariableName{"s.region", "jan", "february"},
+					[]octosql.Value{octosql.MakeString("south"), octosql.MakeInt(3), nil}),
+				NewRecordFromSlice(
+					[]octosql.VariableName{"s.region", "jan", "february"},
+					[]octosql.Value{octosql.MakeString("east"), nil, nil}),
+			},
+		},
+		{
+			name: "empty source",
+			args: args{
+				source:     NewDummyNode(nil),
+				pivotField: "s.month",
+				valueField: "s.amount",
+				values:     []Expression{NewDummyValue(octosql.MakeString("jan"))},
+				as:         []octosql.VariableName{"jan"},
+			},
+			want: []*Record{},
+		},
+	}
+
+	for _, tt := range tests {
+		for _, memoryLimit := range []int{DefaultGroupMemoryLimit, 0} {
+			t.Run(fmt.Sprintf("%s with memory limit %d", tt.name, memoryLimit), func(t *testing.T) {
+				node := NewPivot(
+					tt.args.source,
+					tt.args.pivotField,
+					tt.args.valueField,
+					func() Aggregate { return &testIntSum{sums: NewHashMap()} },
+					tt.args.values,
+					tt.args.as,
+					memoryLimit,
+				)
+
+				stream, err := node.Get(ctx, octosql.NoVariables())
+				if err != nil {
+					t.Errorf("Error in Get(): %v", err)
+					return
+				}
+
+				want := NewInMemoryStream(tt.want)
+				equal, err := AreStreamsEqualNoOrdering(ctx, want, stream)
+				if err != nil {
+					t.Errorf("Error in AreStreamsEqualNoOrdering(): %v", err)
+					return
+				}
+
+				if !equal {
+					t.Errorf("Streams don't match")
+					return
+				}
+			})
+		}
+	}
+}
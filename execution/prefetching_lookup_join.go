@@ -0,0 +1,155 @@
+package execution
+
+import (
+	"context"
+
+	"github.com/cube2222/octosql"
+	"github.com/pkg/errors"
+)
+
+// PrefetchingLookupJoinedStream is a lookup join which fetches the joined records
+// for up to prefetch source records concurrently, while still returning records in source order.
+// If keepUnmatched is set, source records without any joined record are returned too, as in a left join.
+// Closing the stream cancels the pending lookups.
+type PrefetchingLookupJoinedStream struct {
+	variables     octosql.Variables
+	source        RecordStream
+	joined        Node
+	prefetch      int
+	keepUnmatched bool
+
+	// ctx is the context of the lookups, which is cancelled by cancel on Close.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	sourceDone bool
+	pending    []*lookup
+	curIndex   int
+	schemas    concatSchemas
+}
+
+// lookup holds the joined records of a single source record, which are available after done is closed.
+type lookup struct {
+	srcRecord *Record
+	done      chan struct{}
+	records   []*Record
+	err       error
+}
+
+func newPrefetchingLookupJoinedStream(ctx context.Context, variables octosql.Variables, source RecordStream, joined Node, prefetch int, keepUnmatched bool) *PrefetchingLookupJoinedStream {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &PrefetchingLookupJoinedStream{
+		variables:     variables,
+		source:        source,
+		joined:        joined,
+		prefetch:      prefetch,
+		keepUnmatched: keepUnmatched,
+		ctx:           ctx,
+		cancel:        cancel,
+	}
+}
+
+func (stream *PrefetchingLookupJoinedStream) Close() error {
+	stream.cancel()
+	for _, cur := range stream.pending {
+		<-cur.done
+	}
+	stream.pending = nil
+
+	err := stream.source.Close()
+	if err != nil {
+		return errors.Wrap(err, "Couldn't close source stream")
+	}
+
+	return nil
+}
+
+func (stream *PrefetchingLookupJoinedStream) Next(ctx context.Context) (*Record, error) {
+	for {
+		err := stream.fillPending(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(stream.pending) == 0 {
+			return nil, ErrEndOfStream
+		}
+
+		cur := stream.pending[0]
+		<-cur.done
+		if cur.err != nil {
+			return nil, cur.err
+		}
+
+		if stream.curIndex == len(cur.records) {
+			stream.pending = stream.pending[1:]
+			stream.curIndex = 0
+			if stream.keepUnmatched && len(cur.records) == 0 {
+				return cur.srcRecord, nil
+			}
+			continue
+		}
+
+		joinedRecord := cur.records[stream.curIndex]
+		stream.curIndex++
+
+		record, err := concatRecords(&stream.schemas, cur.srcRecord, joinedRecord)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't merge current record variables with joined record variables")
+		}
+
+		return record, nil
+	}
+}
+
+// fillPending starts lookups for the next source records, until prefetch lookups are pending.
+func (stream *PrefetchingLookupJoinedStream) fillPending(ctx context.Context) error {
+	for !stream.sourceDone && len(stream.pending) < stream.prefetch {
+		srcRecord, err := stream.source.Next(ctx)
+		if err != nil {
+			if err == ErrEndOfStream {
+				stream.sourceDone = true
+				break
+			}
+			return errors.Wrap(err, "couldn't get source record")
+		}
+
+		variables, err := stream.variables.MergeWith(srcRecord.AsVariables())
+		if err != nil {
+			return errors.Wrap(err, "couldn't merge given variables with source record variables")
+		}
+
+		cur := &lookup{
+			srcRecord: srcRecord,
+			done:      make(chan struct{}),
+		}
+		stream.pending = append(stream.pending, cur)
+
+		go func() {
+			defer close(cur.done)
+			cur.records, cur.err = stream.getJoinedRecords(stream.ctx, variables)
+		}()
+	}
+
+	return nil
+}
+
+func (stream *PrefetchingLookupJoinedStream) getJoinedRecords(ctx context.Context, variables octosql.Variables) ([]*Record, error) {
+	joinedStream, err := stream.joined.Get(ctx, variables)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get joined stream")
+	}
+	defer joinedStream.Close()
+
+	var records []*Record
+	for {
+		record, err := joinedStream.Next(ctx)
+		if err != nil {
+			if err == ErrEndOfStream {
+				return records, nil
+			}
+			return nil, errors.Wrap(err, "couldn't get joined record")
+		}
+		records = append(records, record)
+	}
+}
@@ -0,0 +1,204 @@
+package execution
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cube2222/octosql"
+)
+
+// concurrencyTrackingNode records the maximum number of concurrent calls to Get of the underlying node.
+type concurrencyTrackingNode struct {
+	Node
+	delay time.Duration
+
+	mutex          sync.Mutex
+	inFlight       int
+	maxConcurrency int
+}
+
+func (node *concurrencyTrackingNode) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	node.mutex.Lock()
+	node.inFlight++
+	if node.inFlight > node.maxConcurrency {
+		node.maxConcurrency = node.inFlight
+	}
+	node.mutex.Unlock()
+
+	time.Sleep(node.delay)
+
+	node.mutex.Lock()
+	node.inFlight--
+	node.mutex.Unlock()
+
+	return node.Node.Get(ctx, variables)
+}
+
+func TestPrefetchingLookupJoin(t *testing.T) {
+	ctx := context.Background()
+	sourceFields := []octosql.VariableName{"e.user_id", "e.action"}
+	joinedFields := []octosql.VariableName{"u.id", "u.name"}
+	allFields := []octosql.VariableName{"e.user_id", "e.action", "u.id", "u.name"}
+
+	source := []*Record{
+		NewRecordFromSliceWithNormalize(sourceFields, []interface{}{1, "a"}),
+		NewRecordFromSliceWithNormalize(sourceFields, []interface{}{2, "b"}),
+		NewRecordFromSliceWithNormalize(sourceFields, []interface{}{3, "c"}),
+		NewRecordFromSliceWithNormalize(sourceFields, []interface{}{1, "d"}),
+		NewRecordFromSliceWithNormalize(sourceFields, []interface{}{4, "e"}),
+	}
+	joined := []*Record{
+		NewRecordFromSliceWithNormalize(joinedFields, []interface{}{1, "alice"}),
+		NewRecordFromSliceWithNormalize(joinedFields, []interface{}{3, "carol"}),
+		NewRecordFromSliceWithNormalize(joinedFields, []interface{}{3, "charlie"}),
+	}
+
+	tests := []struct {
+		name     string
+		left     bool
+		prefetch int
+		want     []*Record
+	}{
+		{
+			name:     "inner join",
+			prefetch: 3,
+			want: []*Record{
+				NewRecordFromSliceWithNormalize(allFields, []interface{}{1, "a", 1, "alice"}),
+				NewRecordFromSliceWithNormalize(allFields, []interface{}{3, "c", 3, "carol"}),
+				NewRecordFromSliceWithNormalize(allFields, []interface{}{3, "c", 3, "charlie"}),
+				NewRecordFromSliceWithNormalize(allFields, []interface{}{1, "d", 1, "alice"}),
+			},
+		},
+		{
+			name:     "left join",
+			left:     true,
+			prefetch: 10,
+			want: []*Record{
+				NewRecordFromSliceWithNormalize(allFields, []interface{}{1, "a", 1, "alice"}),
+				NewRecordFromSliceWithNormalize(sourceFields, []interface{}{2, "b"}),
+				NewRecordFromSliceWithNormalize(allFields, []interface{}{3, "c", 3, "carol"}),
+				NewRecordFromSliceWithNormalize(allFields, []interface{}{3, "c", 3, "charlie"}),
+				NewRecordFromSliceWithNormalize(allFields, []interface{}{1, "d", 1, "alice"}),
+				NewRecordFromSliceWithNormalize(sourceFields, []interface{}{4, "e"}),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := &concurrencyTrackingNode{
+				Node: &batchLookupTable{
+					records:        joined,
+					keyField:       "u.id",
+					lookupVariable: "e.user_id",
+					batchSize:      1,
+				},
+				delay: 10 * time.Millisecond,
+			}
+
+			var node Node = NewInnerJoin(NewDummyNode(source), table, tt.prefetch)
+			if tt.left {
+				node = NewLeftJoin(NewDummyNode(source), table, tt.prefetch)
+			}
+
+			stream, err := node.Get(ctx, octosql.NoVariables())
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+
+			equal, err := AreStreamsEqual(ctx, stream, NewInMemoryStream(tt.want))
+			if err != nil {
+				t.Errorf("AreStreamsEqual() error = %v", err)
+			}
+			if !equal {
+				t.Errorf("streams not equal")
+			}
+			if table.maxConcurrency < 2 || table.maxConcurrency > tt.prefetch {
+				t.Errorf("max concurrent lookups = %v, want between 2 and %v", table.maxConcurrency, tt.prefetch)
+			}
+		})
+	}
+}
+
+// blockingLookupNode returns streams of its records for the unblocked key,
+// while lookups of other keys block until their context is cancelled.
+type blockingLookupNode struct {
+	records        []*Record
+	lookupVariable octosql.VariableName
+	unblocked      octosql.Value
+
+	mutex     sync.Mutex
+	open      int
+	cancelled int
+}
+
+func (node *blockingLookupNode) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	value, err := variables.Get(node.lookupVariable)
+	if err != nil {
+		return nil, err
+	}
+
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+	if !octosql.AreEqual(value, node.unblocked) {
+		node.mutex.Unlock()
+		<-ctx.Done()
+		node.mutex.Lock()
+		node.cancelled++
+		return nil, ctx.Err()
+	}
+
+	node.open++
+	return &blockingLookupStream{failingStream: failingStream{records: node.records, err: ErrEndOfStream}, node: node}, nil
+}
+
+type blockingLookupStream struct {
+	failingStream
+	node *blockingLookupNode
+}
+
+func (stream *blockingLookupStream) Close() error {
+	stream.node.mutex.Lock()
+	defer stream.node.mutex.Unlock()
+	stream.node.open--
+	return nil
+}
+
+func TestPrefetchingLookupJoin_Close(t *testing.T) {
+	ctx := context.Background()
+	sourceFields := []octosql.VariableName{"e.user_id"}
+	joinedFields := []octosql.VariableName{"u.id"}
+
+	source := []*Record{
+		NewRecordFromSliceWithNormalize(sourceFields, []interface{}{1}),
+		NewRecordFromSliceWithNormalize(sourceFields, []interface{}{2}),
+		NewRecordFromSliceWithNormalize(sourceFields, []interface{}{3}),
+	}
+	joined := &blockingLookupNode{
+		records:        []*Record{NewRecordFromSliceWithNormalize(joinedFields, []interface{}{1})},
+		lookupVariable: "e.user_id",
+		unblocked:      octosql.MakeInt(1),
+	}
+
+	stream, err := NewInnerJoin(NewDummyNode(source), joined, 3).Get(ctx, octosql.NoVariables())
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	if _, err := stream.Next(ctx); err != nil {
+		t.Fatalf("Next() error = %v", err)
+	}
+	if err := stream.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	joined.mutex.Lock()
+	defer joined.mutex.Unlock()
+	if joined.cancelled != 2 {
+		t.Errorf("cancelled lookups = %v, want 2", joined.cancelled)
+	}
+	if joined.open != 0 {
+		t.Errorf("joined streams left open = %v, want 0", joined.open)
+	}
+}
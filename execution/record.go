@@ -1,6 +1,7 @@
 package execution
 
 import (
+	"context"
 	"io"
 
 	"github.com/cube2222/octosql"
@@ -11,34 +12,46 @@ type Field struct {
 	Name octosql.VariableName
 }
 
+// Record is a row of values of the fields of its schema.
+// Records mustn't be modified, as their schemas and values may be shared with other records.
 type Record struct {
-	fieldNames []octosql.VariableName
-	data       []octosql.Value
+	schema *Schema
+	data   []octosql.Value
 }
 
+// NewRecord creates a record with a new schema out of the given fields.
+// Streams creating many records with the same fields should share a schema using NewRecordFromSchema instead.
 func NewRecord(fields []octosql.VariableName, data map[octosql.VariableName]octosql.Value) *Record {
 	dataInner := make([]octosql.Value, len(fields))
 	for i := range fields {
 		dataInner[i] = data[fields[i]]
 	}
+	return NewRecordFromSchema(NewSchema(fields), dataInner)
+}
+
+// NewRecordFromSchema creates a record with the given values of the fields of the schema, in schema order.
+func NewRecordFromSchema(schema *Schema, data []octosql.Value) *Record {
 	return &Record{
-		fieldNames: fields,
-		data:       dataInner,
+		schema: schema,
+		data:   data,
 	}
 }
 
 func (r *Record) Value(field octosql.VariableName) octosql.Value {
-	for i := range r.fieldNames {
-		if r.fieldNames[i] == field {
-			return r.data[i]
-		}
+	i := r.schema.Index(field)
+	if i == -1 {
+		return nil
 	}
-	return nil
+	return r.data[i]
+}
+
+func (r *Record) Schema() *Schema {
+	return r.schema
 }
 
 func (r *Record) Fields() []Field {
 	fields := make([]Field, 0)
-	for _, fieldName := range r.fieldNames {
+	for _, fieldName := range r.schema.fields {
 		fields = append(fields, Field{
 			Name: fieldName,
 		})
@@ -48,9 +61,9 @@ func (r *Record) Fields() []Field {
 }
 
 func (r *Record) AsVariables() octosql.Variables {
-	out := make(octosql.Variables)
-	for i := range r.fieldNames {
-		out[r.fieldNames[i]] = r.data[i]
+	out := make(octosql.Variables, len(r.data))
+	for i := range r.schema.fields {
+		out[r.schema.fields[i]] = r.data[i]
 	}
 
 	return out
@@ -61,16 +74,11 @@ func (r *Record) AsTuple() octosql.Tuple {
 }
 
 func (r *Record) Equal(other *Record) bool {
-	myFields := r.Fields()
-	otherFields := other.Fields()
-	if len(myFields) != len(otherFields) {
+	if !r.schema.Equal(other.schema) {
 		return false
 	}
 
-	for i := range myFields {
-		if myFields[i] != otherFields[i] {
-			return false
-		}
+	for i := range r.data {
 		if !octosql.AreEqual(r.data[i], other.data[i]) {
 			return false
 		}
@@ -79,7 +87,7 @@ func (r *Record) Equal(other *Record) bool {
 }
 
 type RecordStream interface {
-	Next() (*Record, error)
+	Next(ctx context.Context) (*Record, error)
 	io.Closer
 }
 
@@ -0,0 +1,336 @@
+package execution
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"math"
+	"time"
+
+	"github.com/cube2222/octosql"
+	"github.com/pkg/errors"
+)
+
+// Value type tags used in the binary encoding.
+const (
+	nullTag byte = iota
+	phantomTag
+	boolTag
+	intTag
+	floatTag
+	stringTag
+	timeTag
+	durationTag
+	tupleTag
+	objectTag
+)
+
+// Record field name tags, field names are only written if they differ from the previous record's.
+const (
+	sameFieldsTag byte = iota
+	newFieldsTag
+)
+
+// recordEncoder writes records and values in a compact binary format, readable by a recordDecoder.
+type recordEncoder struct {
+	w          *bufio.Writer
+	lastSchema *Schema
+	buf        [binary.MaxVarintLen64]byte
+}
+
+func newRecordEncoder(w io.Writer) *recordEncoder {
+	return &recordEncoder{w: bufio.NewWriter(w)}
+}
+
+func (enc *recordEncoder) EncodeRecord(record *Record) error {
+	if enc.lastSchema != nil && enc.lastSchema.Equal(record.schema) {
+		if err := enc.w.WriteByte(sameFieldsTag); err != nil {
+			return err
+		}
+	} else {
+		if err := enc.w.WriteByte(newFieldsTag); err != nil {
+			return err
+		}
+		enc.writeUvarint(uint64(record.schema.Len()))
+		for i := range record.schema.fields {
+			enc.writeString(record.schema.fields[i].String())
+		}
+		enc.lastSchema = record.schema
+	}
+
+	for i := range record.data {
+		if err := enc.EncodeValue(record.data[i]); err != nil {
+			return errors.Wrapf(err, "couldn't encode value of field %v", record.schema.fields[i])
+		}
+	}
+
+	return nil
+}
+
+func (enc *recordEncoder) EncodeValue(value octosql.Value) error {
+	switch value := value.(type) {
+	case nil:
+		return enc.w.WriteByte(nullTag)
+	case octosql.Phantom:
+		return enc.w.WriteByte(phantomTag)
+	case octosql.Bool:
+		enc.w.WriteByte(boolTag)
+		if value {
+			return enc.w.WriteByte(1)
+		}
+		return enc.w.WriteByte(0)
+	case octosql.Int:
+		enc.w.WriteByte(intTag)
+		enc.writeVarint(int64(value))
+	case octosql.Float:
+		enc.w.WriteByte(floatTag)
+		binary.LittleEndian.PutUint64(enc.buf[:8], math.Float64bits(float64(value)))
+		enc.w.Write(enc.buf[:8])
+	case octosql.String:
+		enc.w.WriteByte(stringTag)
+		enc.writeString(string(value))
+	case octosql.Time:
+		data, err := time.Time(value).MarshalBinary()
+		if err != nil {
+			return errors.Wrap(err, "couldn't marshal time")
+		}
+		enc.w.WriteByte(timeTag)
+		enc.writeString(string(data))
+	case octosql.Duration:
+		enc.w.WriteByte(durationTag)
+		enc.writeVarint(int64(value))
+	case octosql.Tuple:
+		enc.w.WriteByte(tupleTag)
+		enc.writeUvarint(uint64(len(value)))
+		for i := range value {
+			if err := enc.EncodeValue(value[i]); err != nil {
+				return err
+			}
+		}
+	case octosql.Object:
+		enc.w.WriteByte(objectTag)
+		enc.writeUvarint(uint64(len(value)))
+		for k, v := range value {
+			enc.writeString(k)
+			if err := enc.EncodeValue(v); err != nil {
+				return err
+			}
+		}
+	default:
+		return errors.Errorf("unsupported value type for encoding: %T", value)
+	}
+
+	return nil
+}
+
+// Flush writes any buffered data to the underlying writer.
+func (enc *recordEncoder) Flush() error {
+	return enc.w.Flush()
+}
+
+func (enc *recordEncoder) writeUvarint(x uint64) {
+	n := binary.PutUvarint(enc.buf[:], x)
+	enc.w.Write(enc.buf[:n])
+}
+
+func (enc *recordEncoder) writeVarint(x int64) {
+	n := binary.PutVarint(enc.buf[:], x)
+	enc.w.Write(enc.buf[:n])
+}
+
+func (enc *recordEncoder) writeString(s string) {
+	enc.writeUvarint(uint64(len(s)))
+	enc.w.WriteString(s)
+}
+
+func sameFields(x, y []octosql.VariableName) bool {
+	if x == nil || len(x) != len(y) {
+		return false
+	}
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// recordDecoder reads records and values written by a recordEncoder.
+type recordDecoder struct {
+	r          *bufio.Reader
+	lastSchema *Schema
+}
+
+func newRecordDecoder(r io.Reader) *recordDecoder {
+	return &recordDecoder{r: bufio.NewReader(r)}
+}
+
+// DecodeRecord returns io.EOF if there are no more records.
+func (dec *recordDecoder) DecodeRecord() (*Record, error) {
+	tag, err := dec.r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tag {
+	case sameFieldsTag:
+		if dec.lastSchema == nil {
+			return nil, errors.New("missing record field names")
+		}
+	case newFieldsTag:
+		count, err := binary.ReadUvarint(dec.r)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't read field count")
+		}
+		fields := make([]octosql.VariableName, count)
+		for i := range fields {
+			name, err := dec.readString()
+			if err != nil {
+				return nil, errors.Wrap(err, "couldn't read field name")
+			}
+			fields[i] = octosql.VariableName(name)
+		}
+		dec.lastSchema = NewSchema(fields)
+	default:
+		return nil, errors.Errorf("invalid record tag: %v", tag)
+	}
+
+	data := make([]octosql.Value, dec.lastSchema.Len())
+	for i := range data {
+		data[i], err = dec.DecodeValue()
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't decode value of field %v", dec.lastSchema.fields[i])
+		}
+	}
+
+	return NewRecordFromSchema(dec.lastSchema, data), nil
+}
+
+func (dec *recordDecoder) DecodeValue() (octosql.Value, error) {
+	tag, err := dec.r.ReadByte()
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't read value tag")
+	}
+
+	switch tag {
+	case nullTag:
+		return nil, nil
+	case phantomTag:
+		return octosql.MakePhantom(), nil
+	case boolTag:
+		b, err := dec.r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		return octosql.MakeBool(b == 1), nil
+	case intTag:
+		x, err := binary.ReadVarint(dec.r)
+		if err != nil {
+			return nil, err
+		}
+		return octosql.MakeInt(int(x)), nil
+	case floatTag:
+		var data [8]byte
+		if _, err := io.ReadFull(dec.r, data[:]); err != nil {
+			return nil, err
+		}
+		return octosql.MakeFloat(math.Float64frombits(binary.LittleEndian.Uint64(data[:]))), nil
+	case stringTag:
+		s, err := dec.readString()
+		if err != nil {
+			return nil, err
+		}
+		return octosql.MakeString(s), nil
+	case timeTag:
+		data, err := dec.readString()
+		if err != nil {
+			return nil, err
+		}
+		var t time.Time
+		if err := t.UnmarshalBinary([]byte(data)); err != nil {
+			return nil, errors.Wrap(err, "couldn't unmarshal time")
+		}
+		return octosql.MakeTime(t), nil
+	case durationTag:
+		x, err := binary.ReadVarint(dec.r)
+		if err != nil {
+			return nil, err
+		}
+		return octosql.MakeDuration(time.Duration(x)), nil
+	case tupleTag:
+		count, err := binary.ReadUvarint(dec.r)
+		if err != nil {
+			return nil, err
+		}
+		values := make([]octosql.Value, count)
+		for i := range values {
+			values[i], err = dec.DecodeValue()
+			if err != nil {
+				return nil, err
+			}
+		}
+		return octosql.MakeTuple(values), nil
+	case objectTag:
+		count, err := binary.ReadUvarint(dec.r)
+		if err != nil {
+			return nil, err
+		}
+		object := make(map[string]octosql.Value, count)
+		for i := uint64(0); i < count; i++ {
+			k, err := dec.readString()
+			if err != nil {
+				return nil, err
+			}
+			object[k], err = dec.DecodeValue()
+			if err != nil {
+				return nil, err
+			}
+		}
+		return octosql.MakeObject(object), nil
+	default:
+		return nil, errors.Errorf("invalid value tag: %v", tag)
+	}
+}
+
+func (dec *recordDecoder) readString() (string, error) {
+	length, err := binary.ReadUvarint(dec.r)
+	if err != nil {
+		return "", err
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(dec.r, data); err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// approximateRecordSize estimates the memory used by the record in bytes.
+// The schema is usually shared with other records, so it isn't counted.
+func approximateRecordSize(record *Record) int {
+	size := 48
+	for i := range record.data {
+		size += 16 + approximateValueSize(record.data[i])
+	}
+	return size
+}
+
+func approximateValueSize(value octosql.Value) int {
+	switch value := value.(type) {
+	case octosql.String:
+		return 32 + len(value)
+	case octosql.Tuple:
+		size := 40
+		for i := range value {
+			size += approximateValueSize(value[i])
+		}
+		return size
+	case octosql.Object:
+		size := 64
+		for k, v := range value {
+			size += 32 + len(k) + approximateValueSize(v)
+		}
+		return size
+	default:
+		return 24
+	}
+}
@@ -0,0 +1,60 @@
+package execution
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/cube2222/octosql"
+)
+
+func TestRecordEncoding(t *testing.T) {
+	now := time.Now()
+
+	records := []*Record{
+		NewRecordFromSlice(
+			[]octosql.VariableName{"a.id", "a.name", "a.score", "a.ok"},
+			[]octosql.Value{octosql.MakeInt(-3), octosql.MakeString("alice"), octosql.MakeFloat(1.5), octosql.MakeBool(true)},
+		),
+		NewRecordFromSlice(
+			[]octosql.VariableName{"a.id", "a.name", "a.score", "a.ok"},
+			[]octosql.Value{octosql.MakeInt(7), nil, octosql.MakeFloat(-0.25), octosql.MakeBool(false)},
+		),
+		NewRecordFromSlice(
+			[]octosql.VariableName{"b.time", "b.duration", "b.tuple", "b.object"},
+			[]octosql.Value{
+				octosql.MakeTime(now),
+				octosql.MakeDuration(time.Minute),
+				octosql.MakeTuple([]octosql.Value{octosql.MakeInt(1), octosql.MakeString("x")}),
+				octosql.MakeObject(map[string]octosql.Value{"k": octosql.MakeInt(2)}),
+			},
+		),
+		NewRecordFromSlice([]octosql.VariableName{}, []octosql.Value{}),
+	}
+
+	var buf bytes.Buffer
+	encoder := newRecordEncoder(&buf)
+	for i := range records {
+		if err := encoder.EncodeRecord(records[i]); err != nil {
+			t.Fatalf("EncodeRecord() error = %v", err)
+		}
+	}
+	if err := encoder.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	decoder := newRecordDecoder(&buf)
+	for i := range records {
+		record, err := decoder.DecodeRecord()
+		if err != nil {
+			t.Fatalf("DecodeRecord() error = %v", err)
+		}
+		if !record.Equal(records[i]) {
+			t.Errorf("DecodeRecord() = %v, want %v", record, records[i])
+		}
+	}
+	if _, err := decoder.DecodeRecord(); err != io.EOF {
+		t.Errorf("DecodeRecord() error = %v, want %v", err, io.EOF)
+	}
+}
@@ -1,6 +1,7 @@
 package execution
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -8,6 +9,7 @@ import (
 )
 
 func TestEqual_Apply(t *testing.T) {
+	ctx := context.Background()
 	type args struct {
 		variables octosql.Variables
 		left      Expression
@@ -62,7 +64,7 @@ func TestEqual_Apply(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			rel := &Equal{}
-			got, err := rel.Apply(tt.args.variables, tt.args.left, tt.args.right)
+			got, err := rel.Apply(ctx, tt.args.variables, tt.args.left, tt.args.right)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Equal.Apply() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -75,6 +77,7 @@ func TestEqual_Apply(t *testing.T) {
 }
 
 func TestNotEqual_Apply(t *testing.T) {
+	ctx := context.Background()
 	type args struct {
 		variables octosql.Variables
 		left      Expression
@@ -129,7 +132,7 @@ func TestNotEqual_Apply(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			rel := &NotEqual{}
-			got, err := rel.Apply(tt.args.variables, tt.args.left, tt.args.right)
+			got, err := rel.Apply(ctx, tt.args.variables, tt.args.left, tt.args.right)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("NotEqual.Apply() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -142,6 +145,7 @@ func TestNotEqual_Apply(t *testing.T) {
 }
 
 func TestMoreThan_Apply(t *testing.T) {
+	ctx := context.Background()
 	type args struct {
 		variables octosql.Variables
 		left      Expression
@@ -222,7 +226,7 @@ func TestMoreThan_Apply(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			rel := &MoreThan{}
-			got, err := rel.Apply(tt.args.variables, tt.args.left, tt.args.right)
+			got, err := rel.Apply(ctx, tt.args.variables, tt.args.left, tt.args.right)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("MoreThan.Apply() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -230,7 +234,7 @@ func TestMoreThan_Apply(t *testing.T) {
 			if got != tt.want {
 				t.Errorf("MoreThan.Apply() = %v, want %v", got, tt.want)
 			}
-			gotOpposite, err := rel.Apply(tt.args.variables, tt.args.right, tt.args.left)
+			gotOpposite, err := rel.Apply(ctx, tt.args.variables, tt.args.right, tt.args.left)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("MoreThan.Apply() opposite error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -246,6 +250,7 @@ func TestMoreThan_Apply(t *testing.T) {
 }
 
 func TestLessThan_Apply(t *testing.T) {
+	ctx := context.Background()
 	type args struct {
 		variables octosql.Variables
 		left      Expression
@@ -326,7 +331,7 @@ func TestLessThan_Apply(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			rel := &LessThan{}
-			got, err := rel.Apply(tt.args.variables, tt.args.left, tt.args.right)
+			got, err := rel.Apply(ctx, tt.args.variables, tt.args.left, tt.args.right)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("LessThan.Apply() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -334,7 +339,7 @@ func TestLessThan_Apply(t *testing.T) {
 			if got != tt.want {
 				t.Errorf("LessThan.Apply() = %v, want %v", got, tt.want)
 			}
-			gotOpposite, err := rel.Apply(tt.args.variables, tt.args.right, tt.args.left)
+			gotOpposite, err := rel.Apply(ctx, tt.args.variables, tt.args.right, tt.args.left)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("MoreThan.Apply() opposite error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -350,6 +355,7 @@ func TestLessThan_Apply(t *testing.T) {
 }
 
 func TestGreaterEqual_Apply(t *testing.T) {
+	ctx := context.Background()
 	type args struct {
 		variables octosql.Variables
 		left      Expression
@@ -462,7 +468,7 @@ func TestGreaterEqual_Apply(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			rel := &GreaterEqual{}
-			got, err := rel.Apply(tt.args.variables, tt.args.left, tt.args.right)
+			got, err := rel.Apply(ctx, tt.args.variables, tt.args.left, tt.args.right)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("LessThan.Apply() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -475,6 +481,7 @@ func TestGreaterEqual_Apply(t *testing.T) {
 }
 
 func TestLessEqual_Apply(t *testing.T) {
+	ctx := context.Background()
 	type args struct {
 		variables octosql.Variables
 		left      Expression
@@ -588,7 +595,7 @@ func TestLessEqual_Apply(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			rel := &LessEqual{}
-			got, err := rel.Apply(tt.args.variables, tt.args.left, tt.args.right)
+			got, err := rel.Apply(ctx, tt.args.variables, tt.args.left, tt.args.right)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("LessThan.Apply() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -601,6 +608,7 @@ func TestLessEqual_Apply(t *testing.T) {
 }
 
 func TestLike_Apply(t *testing.T) {
+	ctx := context.Background()
 	type args struct {
 		variables octosql.Variables
 		left      Expression
@@ -643,7 +651,7 @@ func TestLike_Apply(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			rel := &Like{}
-			got, err := rel.Apply(tt.args.variables, tt.args.left, tt.args.right)
+			got, err := rel.Apply(ctx, tt.args.variables, tt.args.left, tt.args.right)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Like.Apply() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -656,6 +664,7 @@ func TestLike_Apply(t *testing.T) {
 }
 
 func TestIn_Apply(t *testing.T) {
+	ctx := context.Background()
 	type args struct {
 		variables octosql.Variables
 		left      Expression
@@ -792,7 +801,7 @@ func TestIn_Apply(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			rel := &In{}
-			got, err := rel.Apply(tt.args.variables, tt.args.left, tt.args.right)
+			got, err := rel.Apply(ctx, tt.args.variables, tt.args.left, tt.args.right)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("In.Apply() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -805,6 +814,7 @@ func TestIn_Apply(t *testing.T) {
 }
 
 func TestNotIn_Apply(t *testing.T) {
+	ctx := context.Background()
 	type args struct {
 		variables octosql.Variables
 		left      Expression
@@ -941,7 +951,7 @@ func TestNotIn_Apply(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			rel := &NotIn{}
-			got, err := rel.Apply(tt.args.variables, tt.args.left, tt.args.right)
+			got, err := rel.Apply(ctx, tt.args.variables, tt.args.left, tt.args.right)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("In.Apply() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1,6 +1,7 @@
 package execution
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -18,8 +19,8 @@ func NewRequalifier(qualifier string, child Node) *Requalifier {
 	return &Requalifier{qualifier: qualifier, source: child}
 }
 
-func (node *Requalifier) Get(variables octosql.Variables) (RecordStream, error) {
-	recordStream, err := node.source.Get(variables)
+func (node *Requalifier) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	recordStream, err := node.source.Get(ctx, variables)
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't get record stream")
 	}
@@ -35,6 +36,10 @@ type RequalifiedStream struct {
 	qualifier string
 	variables octosql.Variables
 	source    RecordStream
+
+	// schema is the requalified schema of sourceSchema, the schema of the last source record.
+	sourceSchema *Schema
+	schema       *Schema
 }
 
 // TODO: Do table name validation on logical -> physical plan transformation
@@ -49,30 +54,33 @@ func (stream *RequalifiedStream) Close() error {
 	return nil
 }
 
-func (stream *RequalifiedStream) Next() (*Record, error) {
-	record, err := stream.source.Next()
+func (stream *RequalifiedStream) Next(ctx context.Context) (*Record, error) {
+	record, err := stream.source.Next(ctx)
 	if err != nil {
 		if err == ErrEndOfStream {
 			return nil, ErrEndOfStream
 		}
 		return nil, errors.Wrap(err, "couldn't get source record")
 	}
-	oldFields := record.Fields()
-
-	fields := make([]octosql.VariableName, len(record.Fields()))
-	values := make(map[octosql.VariableName]octosql.Value)
-	for i := range oldFields {
-		name := string(oldFields[i].Name)
-		if dotIndex := strings.Index(name, "."); dotIndex != -1 {
-			if simpleQualifierMatcher.MatchString(name[:dotIndex]) {
-				name = name[dotIndex+1:]
+
+	if stream.schema == nil || !stream.sourceSchema.Equal(record.schema) {
+		oldFields := record.schema.fields
+
+		fields := make([]octosql.VariableName, len(oldFields))
+		for i := range oldFields {
+			name := string(oldFields[i])
+			if dotIndex := strings.Index(name, "."); dotIndex != -1 {
+				if simpleQualifierMatcher.MatchString(name[:dotIndex]) {
+					name = name[dotIndex+1:]
+				}
 			}
+			fields[i] = octosql.VariableName(fmt.Sprintf("%s.%s", stream.qualifier, name))
 		}
-		qualifiedName := octosql.VariableName(fmt.Sprintf("%s.%s", stream.qualifier, name))
 
-		fields[i] = qualifiedName
-		values[qualifiedName] = record.Value(oldFields[i].Name)
+		stream.sourceSchema = record.schema
+		stream.schema = NewSchema(fields)
 	}
 
-	return NewRecord(fields, values), nil
+	// Records are immutable, so the values can be shared.
+	return NewRecordFromSchema(stream.schema, record.data), nil
 }
@@ -0,0 +1,200 @@
+package execution
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/cube2222/octosql"
+	"github.com/pkg/errors"
+)
+
+type SampleMethod string
+
+const (
+	Bernoulli SampleMethod = "bernoulli"
+	System    SampleMethod = "system"
+	Reservoir SampleMethod = "reservoir"
+)
+
+// SystemSampleBlockSize is the number of consecutive records which get taken or skipped together in system sampling.
+const SystemSampleBlockSize = 128
+
+// Sample returns a random sample of its source records.
+// Bernoulli and System sampling take a percentage of the records, Reservoir sampling takes a fixed number of records.
+// If a seed is given, the sample is repeatable.
+type Sample struct {
+	method SampleMethod
+	amount Expression
+	seed   Expression
+	source Node
+}
+
+func NewSample(method SampleMethod, amount Expression, seed Expression, source Node) *Sample {
+	return &Sample{method: method, amount: amount, seed: seed, source: source}
+}
+
+func (node *Sample) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	amountValue, err := node.amount.ExpressionValue(ctx, variables)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't evaluate sample amount expression")
+	}
+
+	random, err := newSampleRandom(ctx, node.seed, variables)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't create random number generator for sampling")
+	}
+
+	var stream RecordStream
+	switch node.method {
+	case Bernoulli, System:
+		percentage, err := samplePercentage(amountValue)
+		if err != nil {
+			return nil, err
+		}
+
+		source, err := node.source.Get(ctx, variables)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't get source stream in sample")
+		}
+
+		blockSize := 1
+		if node.method == System {
+			blockSize = SystemSampleBlockSize
+		}
+
+		stream = &BlockSampledStream{
+			source:      source,
+			random:      random,
+			probability: percentage / 100,
+			blockSize:   blockSize,
+		}
+
+	case Reservoir:
+		count, ok := amountValue.(octosql.Int)
+		if !ok {
+			return nil, errors.Errorf("sample row count must be an int, got %v", amountValue)
+		}
+		if count < 0 {
+			return nil, errors.New("negative sample row count")
+		}
+
+		source, err := node.source.Get(ctx, variables)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't get source stream in sample")
+		}
+
+		stream, err = createReservoirSampledStream(ctx, source, random, count.AsInt())
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't create reservoir sampled stream")
+		}
+
+	default:
+		return nil, errors.Errorf("invalid sample method: %v", node.method)
+	}
+
+	return stream, nil
+}
+
+func newSampleRandom(ctx context.Context, seedExpr Expression, variables octosql.Variables) (*rand.Rand, error) {
+	if seedExpr == nil {
+		return rand.New(rand.NewSource(time.Now().UnixNano())), nil
+	}
+
+	seedValue, err := seedExpr.ExpressionValue(ctx, variables)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't evaluate sample seed expression")
+	}
+	seed, ok := seedValue.(octosql.Int)
+	if !ok {
+		return nil, errors.Errorf("sample seed must be an int, got %v", seedValue)
+	}
+
+	return rand.New(rand.NewSource(int64(seed))), nil
+}
+
+func samplePercentage(value octosql.Value) (float64, error) {
+	var percentage float64
+	switch value := value.(type) {
+	case octosql.Int:
+		percentage = float64(value)
+	case octosql.Float:
+		percentage = float64(value)
+	default:
+		return 0, errors.Errorf("sample percentage must be an int or float, got %v", value)
+	}
+
+	if percentage < 0 || percentage > 100 {
+		return 0, errors.Errorf("sample percentage must be between 0 and 100, got %v", percentage)
+	}
+
+	return percentage, nil
+}
+
+// BlockSampledStream takes or skips whole blocks of consecutive records with the given probability.
+// With a block size of one this is bernoulli sampling.
+type BlockSampledStream struct {
+	source      RecordStream
+	random      *rand.Rand
+	probability float64
+	blockSize   int
+
+	blockPosition int
+	takeBlock     bool
+}
+
+func (stream *BlockSampledStream) Close() error {
+	err := stream.source.Close()
+	if err != nil {
+		return errors.Wrap(err, "Couldn't close underlying stream")
+	}
+
+	return nil
+}
+
+func (stream *BlockSampledStream) Next(ctx context.Context) (*Record, error) {
+	for {
+		record, err := stream.source.Next(ctx)
+		if err != nil {
+			if err == ErrEndOfStream {
+				return nil, ErrEndOfStream
+			}
+			return nil, errors.Wrap(err, "couldn't get source record")
+		}
+
+		if stream.blockPosition == 0 {
+			stream.takeBlock = stream.random.Float64() < stream.probability
+		}
+		stream.blockPosition = (stream.blockPosition + 1) % stream.blockSize
+
+		if stream.takeBlock {
+			return record, nil
+		}
+	}
+}
+
+// createReservoirSampledStream reads the whole source stream, keeping only a uniformly random sample of count records in memory.
+func createReservoirSampledStream(ctx context.Context, source RecordStream, random *rand.Rand, count int) (RecordStream, error) {
+	reservoir := make([]*Record, 0, count)
+
+	for seen := 0; ; seen++ {
+		record, err := source.Next(ctx)
+		if err != nil {
+			if err == ErrEndOfStream {
+				break
+			}
+			return nil, errors.Wrap(err, "couldn't get source record")
+		}
+
+		if seen < count {
+			reservoir = append(reservoir, record)
+			continue
+		}
+
+		if index := random.Intn(seen + 1); index < count {
+			reservoir[index] = record
+		}
+	}
+
+	return NewInMemoryStream(reservoir), nil
+}
@@ -0,0 +1,177 @@
+package execution
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cube2222/octosql"
+)
+
+func sampleTestRecords(count int) []*Record {
+	records := make([]*Record, count)
+	for i := range records {
+		records[i] = NewRecordFromSliceWithNormalize(
+			[]octosql.VariableName{"id"},
+			[]interface{}{i},
+		)
+	}
+	return records
+}
+
+func TestSample_Get(t *testing.T) {
+	ctx := context.Background()
+	type args struct {
+		method SampleMethod
+		amount Expression
+		seed   Expression
+		source Node
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantCount int
+		wantAll   bool
+		wantErr   bool
+	}{
+		{
+			name: "bernoulli everything",
+			args: args{
+				method: Bernoulli,
+				amount: NewDummyValue(octosql.MakeInt(100)),
+				source: NewDummyNode(sampleTestRecords(50)),
+			},
+			wantCount: 50,
+			wantAll:   true,
+		},
+		{
+			name: "bernoulli nothing",
+			args: args{
+				method: Bernoulli,
+				amount: NewDummyValue(octosql.MakeFloat(0)),
+				source: NewDummyNode(sampleTestRecords(50)),
+			},
+			wantCount: 0,
+		},
+		{
+			name: "system everything",
+			args: args{
+				method: System,
+				amount: NewDummyValue(octosql.MakeInt(100)),
+				source: NewDummyNode(sampleTestRecords(300)),
+			},
+			wantCount: 300,
+			wantAll:   true,
+		},
+		{
+			name: "reservoir smaller than source",
+			args: args{
+				method: Reservoir,
+				amount: NewDummyValue(octosql.MakeInt(10)),
+				seed:   NewDummyValue(octosql.MakeInt(42)),
+				source: NewDummyNode(sampleTestRecords(1000)),
+			},
+			wantCount: 10,
+		},
+		{
+			name: "reservoir bigger than source",
+			args: args{
+				method: Reservoir,
+				amount: NewDummyValue(octosql.MakeInt(10)),
+				source: NewDummyNode(sampleTestRecords(5)),
+			},
+			wantCount: 5,
+			wantAll:   true,
+		},
+		{
+			name: "percentage out of range",
+			args: args{
+				method: Bernoulli,
+				amount: NewDummyValue(octosql.MakeInt(101)),
+				source: NewDummyNode(sampleTestRecords(5)),
+			},
+			wantErr: true,
+		},
+		{
+			name: "float row count",
+			args: args{
+				method: Reservoir,
+				amount: NewDummyValue(octosql.MakeFloat(2.5)),
+				source: NewDummyNode(sampleTestRecords(5)),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := NewSample(tt.args.method, tt.args.amount, tt.args.seed, tt.args.source)
+
+			stream, err := node.Get(ctx, octosql.NoVariables())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Sample.Get() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+
+			if tt.wantAll {
+				equal, err := AreStreamsEqual(ctx, stream, NewInMemoryStream(sampleTestRecords(tt.wantCount)))
+				if err != nil {
+					t.Errorf("Sample.Get() AreStreamsEqual error = %v", err)
+				}
+				if !equal {
+					t.Errorf("Sample.Get() streams not equal")
+				}
+				return
+			}
+
+			count := 0
+			for {
+				_, err := stream.Next(ctx)
+				if err == ErrEndOfStream {
+					break
+				} else if err != nil {
+					t.Fatalf("Sample.Get() stream error = %v", err)
+				}
+				count++
+			}
+			if count != tt.wantCount {
+				t.Errorf("Sample.Get() record count = %v, want %v", count, tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestSample_Repeatable(t *testing.T) {
+	ctx := context.Background()
+	methods := []struct {
+		method SampleMethod
+		amount octosql.Value
+	}{
+		{Bernoulli, octosql.MakeInt(30)},
+		{System, octosql.MakeFloat(50)},
+		{Reservoir, octosql.MakeInt(20)},
+	}
+	for _, tt := range methods {
+		t.Run(string(tt.method), func(t *testing.T) {
+			node := NewSample(tt.method, NewDummyValue(tt.amount), NewDummyValue(octosql.MakeInt(7)), NewDummyNode(sampleTestRecords(1000)))
+
+			first, err := node.Get(ctx, octosql.NoVariables())
+			if err != nil {
+				t.Fatalf("Sample.Get() error = %v", err)
+			}
+			second, err := node.Get(ctx, octosql.NoVariables())
+			if err != nil {
+				t.Fatalf("Sample.Get() error = %v", err)
+			}
+
+			equal, err := AreStreamsEqual(ctx, first, second)
+			if err != nil {
+				t.Errorf("AreStreamsEqual() error = %v", err)
+			}
+			if !equal {
+				t.Errorf("samples with the same seed differ")
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package execution
+
+import (
+	"github.com/cube2222/octosql"
+	"github.com/pkg/errors"
+)
+
+// Schema is the list of fields of a record, with the index of each field precomputed.
+// Records of a single stream usually share one schema, so it's resolved once, not for each record.
+// Schemas mustn't be modified after they're created.
+type Schema struct {
+	fields  []octosql.VariableName
+	indices map[octosql.VariableName]int
+}
+
+func NewSchema(fields []octosql.VariableName) *Schema {
+	indices := make(map[octosql.VariableName]int, len(fields))
+	for i := len(fields) - 1; i >= 0; i-- {
+		indices[fields[i]] = i
+	}
+
+	return &Schema{
+		fields:  fields,
+		indices: indices,
+	}
+}
+
+func (schema *Schema) Fields() []octosql.VariableName {
+	return schema.fields
+}
+
+func (schema *Schema) Len() int {
+	return len(schema.fields)
+}
+
+// Index returns the index of the first field with the given name, or -1 if there's no such field.
+func (schema *Schema) Index(field octosql.VariableName) int {
+	index, ok := schema.indices[field]
+	if !ok {
+		return -1
+	}
+	return index
+}
+
+// Equal checks if the schemas have the same fields in the same order.
+func (schema *Schema) Equal(other *Schema) bool {
+	return schema == other || sameFields(schema.fields, other.fields)
+}
+
+// concatSchemas caches the schema of records which are concatenations of records with a pair of schemas,
+// like the records of joins.
+type concatSchemas struct {
+	left, right *Schema
+	out         *Schema
+}
+
+// Get returns the concatenation of the schemas, reusing it if the schemas are the same as in the previous call.
+// Fields of the schemas mustn't overlap.
+func (cache *concatSchemas) Get(left, right *Schema) (*Schema, error) {
+	if cache.out != nil && cache.left.Equal(left) && cache.right.Equal(right) {
+		return cache.out, nil
+	}
+
+	fields := make([]octosql.VariableName, 0, left.Len()+right.Len())
+	fields = append(fields, left.fields...)
+	for _, field := range right.fields {
+		if i := left.Index(field); i != -1 {
+			return nil, errors.Errorf("%v already defined in left record", field)
+		}
+		fields = append(fields, field)
+	}
+
+	cache.left, cache.right = left, right
+	cache.out = NewSchema(fields)
+	return cache.out, nil
+}
+
+// concatRecords creates a record out of the fields of both records, using a schema from the cache.
+func concatRecords(cache *concatSchemas, left, right *Record) (*Record, error) {
+	schema, err := cache.Get(left.schema, right.schema)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]octosql.Value, 0, schema.Len())
+	data = append(data, left.data...)
+	data = append(data, right.data...)
+	return NewRecordFromSchema(schema, data), nil
+}
+
+// recordVariables are the given variables merged with the fields of records.
+// The merged map is built once for each schema and only its values are overwritten for each record,
+// so the returned variables mustn't be retained after the next record is set.
+type recordVariables struct {
+	variables octosql.Variables
+	schema    *Schema
+	merged    octosql.Variables
+}
+
+func newRecordVariables(variables octosql.Variables) *recordVariables {
+	return &recordVariables{
+		variables: variables,
+	}
+}
+
+func (vars *recordVariables) setSchema(schema *Schema) error {
+	if vars.merged != nil && vars.schema.Equal(schema) {
+		return nil
+	}
+
+	merged := make(octosql.Variables, len(vars.variables)+schema.Len())
+	for k, v := range vars.variables {
+		merged[k] = v
+	}
+	for _, field := range schema.fields {
+		if vOld, ok := merged[field]; ok {
+			return errors.Errorf("%v already defined as %+v", field, vOld)
+		}
+		merged[field] = nil
+	}
+
+	vars.schema = schema
+	vars.merged = merged
+	return nil
+}
+
+// Set sets the record variables to the values of the record and returns the merged variables.
+func (vars *recordVariables) Set(record *Record) (octosql.Variables, error) {
+	if err := vars.setSchema(record.schema); err != nil {
+		return nil, err
+	}
+	for i, field := range record.schema.fields {
+		vars.merged[field] = record.data[i]
+	}
+	return vars.merged, nil
+}
+
+// SetBatchRow sets the record variables to the values of the row of the batch and returns the merged variables.
+func (vars *recordVariables) SetBatchRow(batch *RecordBatch, row int) (octosql.Variables, error) {
+	if err := vars.setSchema(batch.schema); err != nil {
+		return nil, err
+	}
+	for i, field := range batch.schema.fields {
+		vars.merged[field] = batch.columns[i][row]
+	}
+	return vars.merged, nil
+}
@@ -0,0 +1,129 @@
+package execution
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cube2222/octosql"
+)
+
+func TestSchema_Index(t *testing.T) {
+	schema := NewSchema([]octosql.VariableName{"a", "b", "a", "c"})
+
+	tests := []struct {
+		field octosql.VariableName
+		want  int
+	}{
+		{field: "a", want: 0},
+		{field: "b", want: 1},
+		{field: "c", want: 3},
+		{field: "d", want: -1},
+	}
+	for _, tt := range tests {
+		if got := schema.Index(tt.field); got != tt.want {
+			t.Errorf("Schema.Index(%v) = %v, want %v", tt.field, got, tt.want)
+		}
+	}
+}
+
+func TestConcatSchemas(t *testing.T) {
+	left := NewSchema([]octosql.VariableName{"a", "b"})
+	right := NewSchema([]octosql.VariableName{"c"})
+
+	var cache concatSchemas
+	first, err := cache.Get(left, right)
+	if err != nil {
+		t.Fatalf("concatSchemas.Get() error: %v", err)
+	}
+	if !first.Equal(NewSchema([]octosql.VariableName{"a", "b", "c"})) {
+		t.Errorf("concatSchemas.Get() = %v, want fields a, b, c", first.Fields())
+	}
+
+	second, err := cache.Get(left, right)
+	if err != nil {
+		t.Fatalf("concatSchemas.Get() error: %v", err)
+	}
+	if first != second {
+		t.Errorf("concatSchemas.Get() didn't reuse the schema for the same pair of schemas")
+	}
+
+	if _, err := cache.Get(left, NewSchema([]octosql.VariableName{"b"})); err == nil {
+		t.Errorf("concatSchemas.Get() with overlapping fields didn't return an error")
+	}
+}
+
+func TestRecordVariables(t *testing.T) {
+	schema := NewSchema([]octosql.VariableName{"a", "b"})
+	vars := newRecordVariables(octosql.NewVariables(map[octosql.VariableName]octosql.Value{
+		"const": octosql.MakeInt(3),
+	}))
+
+	for i := 0; i < 3; i++ {
+		record := NewRecordFromSchema(schema, []octosql.Value{octosql.MakeInt(i), octosql.MakeString("x")})
+		variables, err := vars.Set(record)
+		if err != nil {
+			t.Fatalf("recordVariables.Set() error: %v", err)
+		}
+
+		want := octosql.NewVariables(map[octosql.VariableName]octosql.Value{
+			"const": octosql.MakeInt(3),
+			"a":     octosql.MakeInt(i),
+			"b":     octosql.MakeString("x"),
+		})
+		if len(variables) != len(want) {
+			t.Errorf("recordVariables.Set() = %v, want %v", variables, want)
+		}
+		for k, v := range want {
+			if !octosql.AreEqual(variables[k], v) {
+				t.Errorf("recordVariables.Set() = %v, want %v", variables, want)
+			}
+		}
+	}
+
+	conflicting := NewRecordFromSchema(NewSchema([]octosql.VariableName{"const"}), []octosql.Value{octosql.MakeInt(1)})
+	if _, err := vars.Set(conflicting); err == nil {
+		t.Errorf("recordVariables.Set() with a field already defined as a variable didn't return an error")
+	}
+}
+
+func TestMappedStream_SharesSchema(t *testing.T) {
+	ctx := context.Background()
+	fields := []octosql.VariableName{"a", "b"}
+	node := NewMap(
+		[]NamedExpression{
+			NewAliasedExpression("renamed", NewVariable("a")),
+			NewVariable("b"),
+		},
+		NewDummyNode([]*Record{
+			NewRecordFromSlice(fields, []octosql.Value{octosql.MakeInt(1), octosql.MakeInt(2)}),
+			NewRecordFromSlice(fields, []octosql.Value{octosql.MakeInt(3), octosql.MakeInt(4)}),
+		}),
+		true,
+		1,
+	)
+
+	stream, err := node.Get(ctx, octosql.NoVariables())
+	if err != nil {
+		t.Fatalf("couldn't get stream: %v", err)
+	}
+
+	first, err := stream.Next(ctx)
+	if err != nil {
+		t.Fatalf("Next() error: %v", err)
+	}
+	second, err := stream.Next(ctx)
+	if err != nil {
+		t.Fatalf("Next() error: %v", err)
+	}
+
+	want := NewRecordFromSlice(
+		[]octosql.VariableName{"renamed", "b", "a"},
+		[]octosql.Value{octosql.MakeInt(3), octosql.MakeInt(4), octosql.MakeInt(3)},
+	)
+	if !second.Equal(want) {
+		t.Errorf("Next() = %v, want %v", second, want)
+	}
+	if first.Schema() != second.Schema() {
+		t.Errorf("mapped records don't share a schema")
+	}
+}
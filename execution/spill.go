@@ -0,0 +1,166 @@
+package execution
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/cube2222/octosql"
+	"github.com/mitchellh/hashstructure"
+	"github.com/pkg/errors"
+)
+
+// DefaultGroupMemoryLimit is the default approximate number of bytes of groups a GroupBy or Distinct keeps in memory.
+const DefaultGroupMemoryLimit = 256 * 1024 * 1024
+
+// spillPartitionCount is the number of partitions records get spilled to, when the memory limit is exceeded.
+const spillPartitionCount = 16
+
+// spillFile is a temporary file records are written to, which can afterwards be read back as a record stream.
+type spillFile struct {
+	file    *os.File
+	encoder *recordEncoder
+	decoder *recordDecoder
+}
+
+func newSpillFile() (*spillFile, error) {
+	file, err := ioutil.TempFile("", "octosql-spill-")
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't create temporary file")
+	}
+
+	return &spillFile{
+		file:    file,
+		encoder: newRecordEncoder(file),
+	}, nil
+}
+
+func (f *spillFile) Write(record *Record) error {
+	err := f.encoder.EncodeRecord(record)
+	if err != nil {
+		return errors.Wrap(err, "couldn't encode record")
+	}
+	return nil
+}
+
+// Rewind finishes writing and starts reading the written records from the beginning.
+func (f *spillFile) Rewind() error {
+	err := f.encoder.Flush()
+	if err != nil {
+		return errors.Wrap(err, "couldn't write to temporary file")
+	}
+
+	_, err = f.file.Seek(0, io.SeekStart)
+	if err != nil {
+		return errors.Wrap(err, "couldn't rewind temporary file")
+	}
+	f.decoder = newRecordDecoder(f.file)
+
+	return nil
+}
+
+func (f *spillFile) Next(ctx context.Context) (*Record, error) {
+	record, err := f.decoder.DecodeRecord()
+	if err != nil {
+		if err == io.EOF {
+			return nil, ErrEndOfStream
+		}
+		return nil, errors.Wrap(err, "couldn't decode record")
+	}
+	return record, nil
+}
+
+// Close closes and removes the temporary file.
+func (f *spillFile) Close() error {
+	err := f.file.Close()
+	if err != nil {
+		return errors.Wrap(err, "couldn't close temporary file")
+	}
+	err = os.Remove(f.file.Name())
+	if err != nil {
+		return errors.Wrap(err, "couldn't remove temporary file")
+	}
+
+	return nil
+}
+
+// spilledPartition is a spilled partition waiting to be processed.
+// The level is the number of times its records have been partitioned.
+type spilledPartition struct {
+	file  *spillFile
+	level int
+}
+
+// spillPartitions hash partitions records by a key into spill files, which get created lazily.
+// Records with equal keys always land in the same partition.
+type spillPartitions struct {
+	level int
+	files [spillPartitionCount]*spillFile
+}
+
+func newSpillPartitions(level int) *spillPartitions {
+	return &spillPartitions{
+		level: level,
+	}
+}
+
+func (p *spillPartitions) Write(key octosql.Value, record *Record) error {
+	// The level is hashed too, so that the records of a partition get split up when it's partitioned again.
+	hash, err := hashstructure.Hash([]interface{}{p.level, key}, nil)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't hash %+v", key)
+	}
+	partition := hash % spillPartitionCount
+
+	if p.files[partition] == nil {
+		p.files[partition], err = newSpillFile()
+		if err != nil {
+			return errors.Wrap(err, "couldn't create spill file")
+		}
+	}
+
+	err = p.files[partition].Write(record)
+	if err != nil {
+		return errors.Wrap(err, "couldn't write record to spill file")
+	}
+	return nil
+}
+
+// Finish rewinds the non-empty partitions and returns them, ready to be processed on the next level.
+func (p *spillPartitions) Finish() ([]spilledPartition, error) {
+	var out []spilledPartition
+	for i := range p.files {
+		if p.files[i] == nil {
+			continue
+		}
+		err := p.files[i].Rewind()
+		if err != nil {
+			p.Close()
+			closeSpilledPartitions(out)
+			return nil, errors.Wrapf(err, "couldn't rewind partition %v", i)
+		}
+		out = append(out, spilledPartition{
+			file:  p.files[i],
+			level: p.level + 1,
+		})
+		p.files[i] = nil
+	}
+
+	return out, nil
+}
+
+func (p *spillPartitions) Close() {
+	for i := range p.files {
+		if p.files[i] != nil {
+			p.files[i].Close()
+			p.files[i] = nil
+		}
+	}
+}
+
+func closeSpilledPartitions(partitions []spilledPartition) {
+	for i := range partitions {
+		partitions[i].file.Close()
+	}
+}
@@ -1,6 +1,7 @@
 package execution
 
 import (
+	"context"
 	"sort"
 
 	"github.com/cube2222/octosql"
@@ -82,8 +83,8 @@ func newEntity(name octosql.VariableName, value octosql.Value) entity {
 
 func Normalize(rec *Record) *Record {
 	row := make(row, 0)
-	for k := range rec.fieldNames {
-		fieldName := rec.fieldNames[k]
+	for k := range rec.schema.fields {
+		fieldName := rec.schema.fields[k]
 		value := rec.data[k]
 		row = append(row, newEntity(fieldName, value))
 	}
@@ -92,8 +93,8 @@ func Normalize(rec *Record) *Record {
 		return row[i].fieldName < row[j].fieldName
 	})
 
-	sortedFieldNames := make([]octosql.VariableName, len(rec.fieldNames))
-	values := make([]interface{}, len(rec.fieldNames))
+	sortedFieldNames := make([]octosql.VariableName, len(rec.schema.fields))
+	values := make([]interface{}, len(rec.schema.fields))
 
 	for k := range row {
 		ent := row[k]
@@ -104,10 +105,10 @@ func Normalize(rec *Record) *Record {
 	return NewRecordFromSliceWithNormalize(sortedFieldNames, values)
 }
 
-func AreStreamsEqual(first, second RecordStream) (bool, error) {
+func AreStreamsEqual(ctx context.Context, first, second RecordStream) (bool, error) {
 	for {
-		firstRec, firstErr := first.Next()
-		secondRec, secondErr := second.Next()
+		firstRec, firstErr := first.Next(ctx)
+		secondRec, secondErr := second.Next(ctx)
 
 		if firstErr == secondErr && firstErr == ErrEndOfStream {
 			break
@@ -129,13 +130,13 @@ func AreStreamsEqual(first, second RecordStream) (bool, error) {
 	return true, nil
 }
 
-func AreStreamsEqualNoOrdering(first, second RecordStream) (bool, error) {
+func AreStreamsEqualNoOrdering(ctx context.Context, first, second RecordStream) (bool, error) {
 	firstMultiSet := newMultiSet()
 	secondMultiSet := newMultiSet()
 
 	for {
-		firstRec, firstErr := first.Next()
-		secondRec, secondErr := second.Next()
+		firstRec, firstErr := first.Next(ctx)
+		secondRec, secondErr := second.Next(ctx)
 
 		if firstErr == secondErr && firstErr == ErrEndOfStream {
 			break
@@ -198,10 +199,7 @@ func (rms *recordMultiSet) isContained(other *recordMultiSet) (bool, error) {
 }
 
 func NewRecordFromSlice(fields []octosql.VariableName, data []octosql.Value) *Record {
-	return &Record{
-		fieldNames: fields,
-		data:       data,
-	}
+	return NewRecordFromSchema(NewSchema(fields), data)
 }
 
 func NewRecordFromSliceWithNormalize(fields []octosql.VariableName, data []interface{}) *Record {
@@ -209,10 +207,7 @@ func NewRecordFromSliceWithNormalize(fields []octosql.VariableName, data []inter
 	for i := range data {
 		normalized[i] = octosql.NormalizeType(data[i])
 	}
-	return &Record{
-		fieldNames: fields,
-		data:       normalized,
-	}
+	return NewRecordFromSchema(NewSchema(fields), normalized)
 }
 
 func NewDummyNode(data []*Record) *DummyNode {
@@ -225,7 +220,7 @@ type DummyNode struct {
 	data []*Record
 }
 
-func (dn *DummyNode) Get(variables octosql.Variables) (RecordStream, error) {
+func (dn *DummyNode) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
 	if dn.data == nil {
 		return NewInMemoryStream([]*Record{}), nil
 	}
@@ -243,6 +238,6 @@ type DummyValue struct {
 	value octosql.Value
 }
 
-func (dv *DummyValue) ExpressionValue(variables octosql.Variables) (octosql.Value, error) {
+func (dv *DummyValue) ExpressionValue(ctx context.Context, variables octosql.Variables) (octosql.Value, error) {
 	return dv.value, nil
 }
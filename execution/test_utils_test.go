@@ -1,12 +1,14 @@
 package execution
 
 import (
+	"context"
 	"testing"
 
 	"github.com/cube2222/octosql"
 )
 
 func TestAreStreamsEqual(t *testing.T) {
+	ctx := context.Background()
 	type args struct {
 		first  RecordStream
 		second RecordStream
@@ -141,7 +143,7 @@ func TestAreStreamsEqual(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := AreStreamsEqual(tt.args.first, tt.args.second)
+			got, err := AreStreamsEqual(ctx, tt.args.first, tt.args.second)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("AreStreamsEqual() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -0,0 +1,178 @@
+package execution
+
+import (
+	"container/heap"
+	"context"
+	"sort"
+
+	"github.com/cube2222/octosql"
+	"github.com/pkg/errors"
+)
+
+// TopN returns the first limit records after skipping offset records of its sorted source,
+// while only keeping limit + offset records in memory. It's equivalent to a Limit over an Offset over an OrderBy.
+// The offset expression may be nil.
+type TopN struct {
+	expressions []Expression
+	directions  []OrderDirection
+	limit       Expression
+	offset      Expression
+	source      Node
+}
+
+func NewTopN(exprs []Expression, directions []OrderDirection, limit Expression, offset Expression, source Node) *TopN {
+	return &TopN{
+		expressions: exprs,
+		directions:  directions,
+		limit:       limit,
+		offset:      offset,
+		source:      source,
+	}
+}
+
+func (node *TopN) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	limit, err := evaluateNonNegativeInt(ctx, node.limit, variables, "limit")
+	if err != nil {
+		return nil, err
+	}
+	offset := 0
+	if node.offset != nil {
+		offset, err = evaluateNonNegativeInt(ctx, node.offset, variables, "offset")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if limit == 0 {
+		return NewInMemoryStream(nil), nil
+	}
+
+	sourceStream, err := node.source.Get(ctx, variables)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get underlying stream in top n")
+	}
+
+	top, err := node.selectTop(ctx, variables, sourceStream, limit+offset)
+	closeErr := sourceStream.Close()
+	if err != nil {
+		return nil, err
+	}
+	if closeErr != nil {
+		return nil, errors.Wrap(closeErr, "couldn't close underlying stream in top n")
+	}
+
+	var records []*Record
+	for i := offset; i < len(top.records); i++ {
+		records = append(records, top.records[i].record)
+	}
+
+	return NewInMemoryStream(records), nil
+}
+
+// selectTop reads the source records, keeping the first n of them in sort order.
+func (node *TopN) selectTop(ctx context.Context, variables octosql.Variables, sourceStream RecordStream, n int) (*topNHeap, error) {
+	top := &topNHeap{
+		directions: node.directions,
+	}
+	binding := newRecordBinding(variables, node.expressions, nil)
+	for seq := 0; ; seq++ {
+		rec, err := sourceStream.Next(ctx)
+		if err == ErrEndOfStream {
+			break
+		} else if err != nil {
+			return nil, errors.Wrap(err, "couldn't get source record")
+		}
+
+		key, err := evaluateSortKey(ctx, binding, rec)
+		if err != nil {
+			return nil, err
+		}
+		cur := topNRecord{sortedRecord: sortedRecord{key: key, record: rec}, seq: seq}
+
+		if len(top.records) < n {
+			heap.Push(top, cur)
+		} else if top.less(cur, top.records[0]) {
+			// The new record is better than the worst one kept.
+			top.records[0] = cur
+			heap.Fix(top, 0)
+		}
+		if top.err != nil {
+			return nil, errors.Wrap(top.err, "couldn't compare records")
+		}
+	}
+
+	sort.Slice(top.records, func(i, j int) bool {
+		return top.less(top.records[i], top.records[j])
+	})
+	if top.err != nil {
+		return nil, errors.Wrap(top.err, "couldn't compare records")
+	}
+
+	return top, nil
+}
+
+func evaluateNonNegativeInt(ctx context.Context, expr Expression, variables octosql.Variables, name string) (int, error) {
+	exprVal, err := expr.ExpressionValue(ctx, variables)
+	if err != nil {
+		return 0, errors.Wrapf(err, "couldn't extract value from %s subexpression", name)
+	}
+
+	val, ok := exprVal.(octosql.Int)
+	if !ok {
+		return 0, errors.Errorf("%s value not int", name)
+	}
+	if val < 0 {
+		return 0, errors.Errorf("negative %s value", name)
+	}
+
+	return val.AsInt(), nil
+}
+
+type topNRecord struct {
+	sortedRecord
+	seq int
+}
+
+// topNHeap is a heap with the worst of the kept records on top.
+type topNHeap struct {
+	records    []topNRecord
+	directions []OrderDirection
+	err        error
+}
+
+// less orders records by their sort keys and then by their position in the source stream.
+func (h *topNHeap) less(x, y topNRecord) bool {
+	cmp, err := compareSortKeys(x.key, y.key, h.directions)
+	if err != nil {
+		if h.err == nil {
+			h.err = err
+		}
+		return false
+	}
+	if cmp == 0 {
+		return x.seq < y.seq
+	}
+	return cmp < 0
+}
+
+func (h *topNHeap) Len() int {
+	return len(h.records)
+}
+
+func (h *topNHeap) Less(i, j int) bool {
+	return h.less(h.records[j], h.records[i])
+}
+
+func (h *topNHeap) Swap(i, j int) {
+	h.records[i], h.records[j] = h.records[j], h.records[i]
+}
+
+func (h *topNHeap) Push(x interface{}) {
+	h.records = append(h.records, x.(topNRecord))
+}
+
+func (h *topNHeap) Pop() interface{} {
+	last := h.records[len(h.records)-1]
+	h.records = h.records[:len(h.records)-1]
+	return last
+}
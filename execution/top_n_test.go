@@ -0,0 +1,108 @@
+package execution
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cube2222/octosql"
+)
+
+func TestTopN_Get(t *testing.T) {
+	ctx := context.Background()
+	fields := []octosql.VariableName{"name", "age"}
+	source := func() Node {
+		return NewDummyNode([]*Record{
+			NewRecordFromSliceWithNormalize(fields, []interface{}{"a", 7}),
+			NewRecordFromSliceWithNormalize(fields, []interface{}{"b", 10}),
+			NewRecordFromSliceWithNormalize(fields, []interface{}{"c", 2}),
+			NewRecordFromSliceWithNormalize(fields, []interface{}{"d", 10}),
+			NewRecordFromSliceWithNormalize(fields, []interface{}{"e", 5}),
+			NewRecordFromSliceWithNormalize(fields, []interface{}{"f", 7}),
+		})
+	}
+
+	type args struct {
+		directions []OrderDirection
+		limit      Expression
+		offset     Expression
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []*Record
+		wantErr bool
+	}{
+		{
+			name: "ascending limit",
+			args: args{
+				directions: []OrderDirection{Ascending},
+				limit:      NewDummyValue(octosql.MakeInt(3)),
+			},
+			want: []*Record{
+				NewRecordFromSliceWithNormalize(fields, []interface{}{"c", 2}),
+				NewRecordFromSliceWithNormalize(fields, []interface{}{"e", 5}),
+				NewRecordFromSliceWithNormalize(fields, []interface{}{"a", 7}),
+			},
+		},
+		{
+			name: "descending limit and offset keeps source order of ties",
+			args: args{
+				directions: []OrderDirection{Descending},
+				limit:      NewDummyValue(octosql.MakeInt(3)),
+				offset:     NewDummyValue(octosql.MakeInt(1)),
+			},
+			want: []*Record{
+				NewRecordFromSliceWithNormalize(fields, []interface{}{"d", 10}),
+				NewRecordFromSliceWithNormalize(fields, []interface{}{"a", 7}),
+				NewRecordFromSliceWithNormalize(fields, []interface{}{"f", 7}),
+			},
+		},
+		{
+			name: "offset past the end",
+			args: args{
+				directions: []OrderDirection{Ascending},
+				limit:      NewDummyValue(octosql.MakeInt(3)),
+				offset:     NewDummyValue(octosql.MakeInt(10)),
+			},
+			want: []*Record{},
+		},
+		{
+			name: "zero limit",
+			args: args{
+				directions: []OrderDirection{Ascending},
+				limit:      NewDummyValue(octosql.MakeInt(0)),
+			},
+			want: []*Record{},
+		},
+		{
+			name: "negative limit",
+			args: args{
+				directions: []OrderDirection{Ascending},
+				limit:      NewDummyValue(octosql.MakeInt(-1)),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := NewTopN([]Expression{NewVariable("age")}, tt.args.directions, tt.args.limit, tt.args.offset, source())
+
+			stream, err := node.Get(ctx, octosql.NoVariables())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TopN.Get() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+
+			equal, err := AreStreamsEqual(ctx, stream, NewInMemoryStream(tt.want))
+			if err != nil {
+				t.Errorf("AreStreamsEqual() error = %v", err)
+			}
+			if !equal {
+				t.Errorf("TopN.Get() streams not equal")
+			}
+		})
+	}
+}
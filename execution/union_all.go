@@ -1,28 +1,38 @@
 package execution
 
 import (
+	"context"
+
 	"github.com/cube2222/octosql"
 	"github.com/pkg/errors"
 )
 
+// UnionAll returns the records of both its inputs.
+// If parallel is set, both inputs are read concurrently through an exchange and their records get interleaved,
+// otherwise the records of the first input are followed by the records of the second.
 type UnionAll struct {
 	first, second Node
+	parallel      bool
 }
 
-func NewUnionAll(first, second Node) *UnionAll {
-	return &UnionAll{first: first, second: second}
+func NewUnionAll(first, second Node, parallel bool) *UnionAll {
+	return &UnionAll{first: first, second: second, parallel: parallel}
 }
 
-func (node *UnionAll) Get(variables octosql.Variables) (RecordStream, error) {
-	firstRecordStream, err := node.first.Get(variables)
+func (node *UnionAll) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	firstRecordStream, err := node.first.Get(ctx, variables)
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't get first record stream")
 	}
-	secondRecordStream, err := node.second.Get(variables)
+	secondRecordStream, err := node.second.Get(ctx, variables)
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't get second record stream")
 	}
 
+	if node.parallel {
+		return newExchangeStream(ctx, []RecordStream{firstRecordStream, secondRecordStream}, DefaultExchangeBufferSize), nil
+	}
+
 	return &UnifiedStream{
 		first:  firstRecordStream,
 		second: secondRecordStream,
@@ -47,9 +57,9 @@ func (node *UnifiedStream) Close() error {
 	return nil
 }
 
-func (node *UnifiedStream) Next() (*Record, error) {
+func (node *UnifiedStream) Next(ctx context.Context) (*Record, error) {
 	for {
-		firstRecord, err := node.first.Next()
+		firstRecord, err := node.first.Next(ctx)
 		if err != nil {
 			if err == ErrEndOfStream {
 				break
@@ -59,7 +69,7 @@ func (node *UnifiedStream) Next() (*Record, error) {
 		return firstRecord, nil
 	}
 	for {
-		secondRecord, err := node.second.Next()
+		secondRecord, err := node.second.Next(ctx)
 		if err != nil {
 			if err == ErrEndOfStream {
 				return nil, ErrEndOfStream
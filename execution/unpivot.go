@@ -0,0 +1,117 @@
+package execution
+
+import (
+	"context"
+
+	"github.com/cube2222/octosql"
+	"github.com/pkg/errors"
+)
+
+// Unpivot turns a set of columns into key/value records.
+// For each source record and each of the given columns with a non-null value
+// it returns a record with the remaining fields, the column name and its value.
+type Unpivot struct {
+	source  Node
+	columns []octosql.VariableName
+
+	keyName   octosql.VariableName
+	valueName octosql.VariableName
+}
+
+func NewUnpivot(source Node, columns []octosql.VariableName, keyName, valueName octosql.VariableName) *Unpivot {
+	return &Unpivot{source: source, columns: columns, keyName: keyName, valueName: valueName}
+}
+
+func (node *Unpivot) Get(ctx context.Context, variables octosql.Variables) (RecordStream, error) {
+	source, err := node.source.Get(ctx, variables)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get stream for source in unpivot")
+	}
+
+	return &UnpivotedStream{
+		source:    source,
+		columns:   node.columns,
+		keyName:   node.keyName,
+		valueName: node.valueName,
+	}, nil
+}
+
+type UnpivotedStream struct {
+	source  RecordStream
+	columns []octosql.VariableName
+
+	keyName   octosql.VariableName
+	valueName octosql.VariableName
+
+	curRecord   *Record
+	curRest     []octosql.VariableName
+	columnIndex int
+}
+
+func (stream *UnpivotedStream) Close() error {
+	err := stream.source.Close()
+	if err != nil {
+		return errors.Wrap(err, "Couldn't close underlying stream")
+	}
+
+	return nil
+}
+
+func (stream *UnpivotedStream) Next(ctx context.Context) (*Record, error) {
+	for {
+		if stream.curRecord == nil {
+			srcRecord, err := stream.source.Next(ctx)
+			if err != nil {
+				if err == ErrEndOfStream {
+					return nil, ErrEndOfStream
+				}
+				return nil, errors.Wrap(err, "couldn't get source record")
+			}
+
+			stream.curRecord = srcRecord
+			stream.curRest = stream.remainingFields(srcRecord)
+			stream.columnIndex = 0
+		}
+
+		if stream.columnIndex == len(stream.columns) {
+			stream.curRecord = nil
+			continue
+		}
+
+		column := stream.columns[stream.columnIndex]
+		stream.columnIndex++
+
+		value := stream.curRecord.Value(column)
+		if value == nil {
+			continue
+		}
+
+		fields := make([]octosql.VariableName, 0, len(stream.curRest)+2)
+		data := make([]octosql.Value, 0, len(stream.curRest)+2)
+		for _, name := range stream.curRest {
+			fields = append(fields, name)
+			data = append(data, stream.curRecord.Value(name))
+		}
+		fields = append(fields, stream.keyName, stream.valueName)
+		data = append(data, octosql.MakeString(column.Name()), value)
+
+		return NewRecordFromSlice(fields, data), nil
+	}
+}
+
+func (stream *UnpivotedStream) remainingFields(record *Record) []octosql.VariableName {
+	out := make([]octosql.VariableName, 0, len(record.schema.fields))
+	for _, name := range record.schema.fields {
+		unpivoted := false
+		for _, column := range stream.columns {
+			if name == column {
+				unpivoted = true
+				break
+			}
+		}
+		if !unpivoted {
+			out = append(out, name)
+		}
+	}
+	return out
+}
@@ -0,0 +1,86 @@
+package execution
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cube2222/octosql"
+)
+
+func TestUnpivot_Get(t *testing.T) {
+	ctx := context.Background()
+	type args struct {
+		source    Node
+		columns   []octosql.VariableName
+		keyName   octosql.VariableName
+		valueName octosql.VariableName
+	}
+	tests := []struct {
+		name string
+		args args
+		want RecordStream
+	}{
+		{
+			name: "simple unpivot",
+			args: args{
+				source: NewDummyNode([]*Record{
+					NewRecordFromSliceWithNormalize(
+						[]octosql.VariableName{"s.region", "s.jan", "s.feb"},
+						[]interface{}{"north", 1, 2}),
+					NewRecordFromSlice(
+						[]octosql.VariableName{"s.region", "s.jan", "s.feb"},
+						[]octosql.Value{octosql.MakeString("south"), nil, octosql.MakeInt(3)}),
+				}),
+				columns:   []octosql.VariableName{"s.jan", "s.feb"},
+				keyName:   "month",
+				valueName: "amount",
+			},
+			want: NewInMemoryStream([]*Record{
+				NewRecordFromSliceWithNormalize(
+					[]octosql.VariableName{"s.region", "month", "amount"},
+					[]interface{}{"north", "jan", 1}),
+				NewRecordFromSliceWithNormalize(
+					[]octosql.VariableName{"s.region", "month", "amount"},
+					[]interface{}{"north", "feb", 2}),
+				NewRecordFromSliceWithNormalize(
+					[]octosql.VariableName{"s.region", "month", "amount"},
+					[]interface{}{"south", "feb", 3}),
+			}),
+		},
+		{
+			name: "all values null",
+			args: args{
+				source: NewDummyNode([]*Record{
+					NewRecordFromSlice(
+						[]octosql.VariableName{"s.region", "s.jan"},
+						[]octosql.Value{octosql.MakeString("south"), nil}),
+				}),
+				columns:   []octosql.VariableName{"s.jan"},
+				keyName:   "month",
+				valueName: "amount",
+			},
+			want: NewInMemoryStream([]*Record{}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream, err := NewUnpivot(tt.args.source, tt.args.columns, tt.args.keyName, tt.args.valueName).Get(ctx, octosql.NoVariables())
+			if err != nil {
+				t.Errorf("Error in Get(): %v", err)
+				return
+			}
+
+			equal, err := AreStreamsEqual(ctx, tt.want, stream)
+			if err != nil {
+				t.Errorf("Error in AreStreamsEqual(): %v", err)
+				return
+			}
+
+			if !equal {
+				t.Errorf("Streams don't match")
+				return
+			}
+		})
+	}
+}
@@ -1,5 +1,7 @@
 module github.com/cube2222/octosql
 
+go 1.12
+
 require (
 	github.com/bradleyjkemp/cupaloy v2.3.0+incompatible // indirect
 	github.com/bradleyjkemp/memmap v0.2.2 // indirect
@@ -20,7 +22,6 @@ require (
 	github.com/shurcooL/markdownfmt v0.0.0-20190409060426-3438a10682f5
 	github.com/shurcooL/sanitized_anchor_name v1.0.0 // indirect
 	github.com/spf13/cobra v0.0.5
-	github.com/xwb1989/sqlparser v0.0.0-20180606152119-120387863bf2
 	golang.org/x/net v0.0.0-20190522155817-f3200d17e092
 	google.golang.org/appengine v1.5.0 // indirect
 	gopkg.in/check.v1 v1.0.0-20180628173108-788fd7840127 // indirect
@@ -0,0 +1,41 @@
+package logical
+
+import (
+	"context"
+
+	"github.com/cube2222/octosql"
+	"github.com/cube2222/octosql/physical"
+	"github.com/pkg/errors"
+)
+
+type DistinctOn struct {
+	key    []Expression
+	source Node
+}
+
+func NewDistinctOn(key []Expression, source Node) *DistinctOn {
+	return &DistinctOn{key: key, source: source}
+}
+
+func (node *DistinctOn) Physical(ctx context.Context, physicalCreator *PhysicalPlanCreator) (physical.Node, octosql.Variables, error) {
+	sourceNode, variables, err := node.source.Physical(ctx, physicalCreator)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "couldn't get physical plan of source node in distinct on")
+	}
+
+	key := make([]physical.Expression, len(node.key))
+	for i := range node.key {
+		expr, exprVariables, err := node.key[i].Physical(ctx, physicalCreator)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "couldn't get physical plan for distinct on key expression with index %d", i)
+		}
+		variables, err = variables.MergeWith(exprVariables)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "couldn't merge variables with those of distinct on key expression with index %d", i)
+		}
+
+		key[i] = expr
+	}
+
+	return physical.NewDistinctOn(key, sourceNode), variables, nil
+}
@@ -0,0 +1,48 @@
+package logical
+
+import (
+	"context"
+
+	"github.com/cube2222/octosql"
+	"github.com/cube2222/octosql/physical"
+	"github.com/pkg/errors"
+)
+
+type FullJoin struct {
+	source    Node
+	joined    Node
+	condition Formula
+}
+
+func NewFullJoin(source Node, joined Node, condition Formula) *FullJoin {
+	return &FullJoin{source: source, joined: joined, condition: condition}
+}
+
+func (node *FullJoin) Physical(ctx context.Context, physicalCreator *PhysicalPlanCreator) (physical.Node, octosql.Variables, error) {
+	source, sourceVariables, err := node.source.Physical(ctx, physicalCreator)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "couldn't get physical plan for full join source node")
+	}
+
+	joined, joinedVariables, err := node.joined.Physical(ctx, physicalCreator)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "couldn't get physical plan for full join joined node")
+	}
+
+	variables, err := sourceVariables.MergeWith(joinedVariables)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "couldn't merge variables for source and joined nodes")
+	}
+
+	condition, conditionVariables, err := node.condition.Physical(ctx, physicalCreator)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "couldn't get physical plan for full join condition")
+	}
+
+	variables, err = variables.MergeWith(conditionVariables)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "couldn't merge variables with those of the full join condition")
+	}
+
+	return physical.NewFullJoin(source, joined, condition), variables, nil
+}
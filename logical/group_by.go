@@ -63,8 +63,13 @@ func (node *GroupBy) Physical(ctx context.Context, physicalCreator *PhysicalPlan
 		return nil, nil, errors.Wrap(err, "couldn't merge variables with those of source")
 	}
 
-	key := make([]physical.Expression, len(node.key))
+	// Constant keys, like the one of an aggregation without a GROUP BY, don't split the groups, so they're left out.
+	// Records are then all in one group, unless there are none.
+	key := make([]physical.Expression, 0, len(node.key))
 	for i := range node.key {
+		if _, ok := node.key[i].(*Constant); ok {
+			continue
+		}
 		expr, exprVariables, err := node.key[i].Physical(ctx, physicalCreator)
 		if err != nil {
 			return nil, nil, errors.Wrapf(err, "couldn't get physical plan for group key expression with index %d", i)
@@ -74,7 +79,7 @@ func (node *GroupBy) Physical(ctx context.Context, physicalCreator *PhysicalPlan
 			return nil, nil, errors.Wrapf(err, "couldn't merge variables with those of group key expression with index %d", i)
 		}
 
-		key[i] = expr
+		key = append(key, expr)
 	}
 
 	aggregates := make([]physical.Aggregate, len(node.aggregates))
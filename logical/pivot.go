@@ -0,0 +1,53 @@
+package logical
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cube2222/octosql"
+	"github.com/cube2222/octosql/physical"
+	"github.com/pkg/errors"
+)
+
+type Pivot struct {
+	source Node
+
+	pivotField octosql.VariableName
+	valueField octosql.VariableName
+	aggregate  Aggregate
+
+	values []Expression
+	as     []octosql.VariableName
+}
+
+func NewPivot(source Node, pivotField, valueField octosql.VariableName, aggregate Aggregate, values []Expression, as []octosql.VariableName) *Pivot {
+	return &Pivot{source: source, pivotField: pivotField, valueField: valueField, aggregate: aggregate, values: values, as: as}
+}
+
+func (node *Pivot) Physical(ctx context.Context, physicalCreator *PhysicalPlanCreator) (physical.Node, octosql.Variables, error) {
+	source, variables, err := node.source.Physical(ctx, physicalCreator)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "couldn't get physical plan for pivot source")
+	}
+
+	values := make([]physical.Expression, len(node.values))
+	for i := range node.values {
+		expr, exprVariables, err := node.values[i].Physical(ctx, physicalCreator)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "couldn't get physical plan for pivot value expression with index %d", i)
+		}
+		variables, err = variables.MergeWith(exprVariables)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "couldn't merge variables with those of pivot value expression with index %d", i)
+		}
+
+		values[i] = expr
+	}
+
+	aggregate := Aggregate(strings.ToLower(string(node.aggregate)))
+	if _, ok := AggregateFunctions[aggregate]; !ok {
+		return nil, nil, errors.Errorf("invalid aggregate: %s", node.aggregate)
+	}
+
+	return physical.NewPivot(source, node.pivotField, node.valueField, physical.NewAggregate(string(aggregate)), values, node.as), variables, nil
+}
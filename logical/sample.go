@@ -0,0 +1,71 @@
+package logical
+
+import (
+	"context"
+
+	"github.com/cube2222/octosql"
+	"github.com/cube2222/octosql/physical"
+	"github.com/pkg/errors"
+)
+
+type SampleMethod string
+
+const (
+	Bernoulli SampleMethod = "bernoulli"
+	System    SampleMethod = "system"
+	Reservoir SampleMethod = "reservoir"
+)
+
+type TableSample struct {
+	method SampleMethod
+	amount Expression
+	seed   Expression
+	source Node
+}
+
+func NewTableSample(method SampleMethod, amount Expression, seed Expression, source Node) *TableSample {
+	return &TableSample{method: method, amount: amount, seed: seed, source: source}
+}
+
+func (node *TableSample) Physical(ctx context.Context, physicalCreator *PhysicalPlanCreator) (physical.Node, octosql.Variables, error) {
+	var method physical.SampleMethod
+	switch node.method {
+	case Bernoulli:
+		method = physical.Bernoulli
+	case System:
+		method = physical.System
+	case Reservoir:
+		method = physical.Reservoir
+	default:
+		return nil, nil, errors.Errorf("invalid sample method: %v", node.method)
+	}
+
+	source, variables, err := node.source.Physical(ctx, physicalCreator)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "couldn't get physical plan for table sample source")
+	}
+
+	amount, amountVariables, err := node.amount.Physical(ctx, physicalCreator)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "couldn't get physical plan for sample amount expression")
+	}
+	variables, err = variables.MergeWith(amountVariables)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "couldn't merge variables with those of sample amount expression")
+	}
+
+	var seed physical.Expression
+	if node.seed != nil {
+		var seedVariables octosql.Variables
+		seed, seedVariables, err = node.seed.Physical(ctx, physicalCreator)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "couldn't get physical plan for sample seed expression")
+		}
+		variables, err = variables.MergeWith(seedVariables)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "couldn't merge variables with those of sample seed expression")
+		}
+	}
+
+	return physical.NewTableSample(physical.NewSampling(method, amount, seed), source), variables, nil
+}
@@ -0,0 +1,30 @@
+package logical
+
+import (
+	"context"
+
+	"github.com/cube2222/octosql"
+	"github.com/cube2222/octosql/physical"
+	"github.com/pkg/errors"
+)
+
+type Unpivot struct {
+	source  Node
+	columns []octosql.VariableName
+
+	keyName   octosql.VariableName
+	valueName octosql.VariableName
+}
+
+func NewUnpivot(source Node, columns []octosql.VariableName, keyName, valueName octosql.VariableName) *Unpivot {
+	return &Unpivot{source: source, columns: columns, keyName: keyName, valueName: valueName}
+}
+
+func (node *Unpivot) Physical(ctx context.Context, physicalCreator *PhysicalPlanCreator) (physical.Node, octosql.Variables, error) {
+	source, variables, err := node.source.Physical(ctx, physicalCreator)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "couldn't get physical plan for unpivot source")
+	}
+
+	return physical.NewUnpivot(source, node.columns, node.keyName, node.valueName), variables, nil
+}
@@ -90,6 +90,123 @@ func EqualNodes(node1, node2 Node) error {
 			return nil
 		}
 
+	case *DistinctOn:
+		if node2, ok := node2.(*DistinctOn); ok {
+			if len(node1.key) != len(node2.key) {
+				return errors.Errorf("key count not equal: %v, %v", len(node1.key), len(node2.key))
+			}
+			for i := range node1.key {
+				if err := EqualExpressions(node1.key[i], node2.key[i]); err != nil {
+					return errors.Wrapf(err, "key expression with index %v not equal", i)
+				}
+			}
+			if err := EqualNodes(node1.source, node2.source); err != nil {
+				return errors.Wrap(err, "sources not equal")
+			}
+			return nil
+		}
+
+	case *OrderBy:
+		if node2, ok := node2.(*OrderBy); ok {
+			if len(node1.expressions) != len(node2.expressions) {
+				return errors.Errorf("expressions count not equal: %v, %v", len(node1.expressions), len(node2.expressions))
+			}
+			for i := range node1.expressions {
+				if err := EqualExpressions(node1.expressions[i], node2.expressions[i]); err != nil {
+					return errors.Wrapf(err, "expression %v not equal", i)
+				}
+			}
+			if len(node1.directions) != len(node2.directions) {
+				return errors.Errorf("directions count not equal: %v, %v", len(node1.directions), len(node2.directions))
+			}
+			for i := range node1.directions {
+				if node1.directions[i] != node2.directions[i] {
+					return errors.Errorf("direction with index %v not equal: %v and %v", i, node1.directions[i], node2.directions[i])
+				}
+			}
+			if err := EqualNodes(node1.source, node2.source); err != nil {
+				return errors.Wrap(err, "sources not equal")
+			}
+			return nil
+		}
+
+	case *Pivot:
+		if node2, ok := node2.(*Pivot); ok {
+			if node1.pivotField != node2.pivotField {
+				return errors.Errorf("pivot fields not equal: %v, %v", node1.pivotField, node2.pivotField)
+			}
+			if node1.valueField != node2.valueField {
+				return errors.Errorf("value fields not equal: %v, %v", node1.valueField, node2.valueField)
+			}
+			if node1.aggregate != node2.aggregate {
+				return errors.Errorf("aggregates not equal: %v, %v", node1.aggregate, node2.aggregate)
+			}
+			if len(node1.values) != len(node2.values) {
+				return errors.Errorf("value count not equal: %v, %v", len(node1.values), len(node2.values))
+			}
+			for i := range node1.values {
+				if err := EqualExpressions(node1.values[i], node2.values[i]); err != nil {
+					return errors.Wrapf(err, "value expression with index %v not equal", i)
+				}
+			}
+			if len(node1.as) != len(node2.as) {
+				return errors.Errorf("'as' count not equal: %v, %v", len(node1.as), len(node2.as))
+			}
+			for i := range node1.as {
+				if node1.as[i] != node2.as[i] {
+					return errors.Errorf("'as' with index %v not equal: %v and %v", i, node1.as[i], node2.as[i])
+				}
+			}
+			if err := EqualNodes(node1.source, node2.source); err != nil {
+				return errors.Wrap(err, "sources not equal")
+			}
+			return nil
+		}
+
+	case *Unpivot:
+		if node2, ok := node2.(*Unpivot); ok {
+			if len(node1.columns) != len(node2.columns) {
+				return errors.Errorf("column count not equal: %v, %v", len(node1.columns), len(node2.columns))
+			}
+			for i := range node1.columns {
+				if node1.columns[i] != node2.columns[i] {
+					return errors.Errorf("column with index %v not equal: %v and %v", i, node1.columns[i], node2.columns[i])
+				}
+			}
+			if node1.keyName != node2.keyName {
+				return errors.Errorf("key names not equal: %v, %v", node1.keyName, node2.keyName)
+			}
+			if node1.valueName != node2.valueName {
+				return errors.Errorf("value names not equal: %v, %v", node1.valueName, node2.valueName)
+			}
+			if err := EqualNodes(node1.source, node2.source); err != nil {
+				return errors.Wrap(err, "sources not equal")
+			}
+			return nil
+		}
+
+	case *TableSample:
+		if node2, ok := node2.(*TableSample); ok {
+			if node1.method != node2.method {
+				return errors.Errorf("sample methods not equal: %v, %v", node1.method, node2.method)
+			}
+			if err := EqualExpressions(node1.amount, node2.amount); err != nil {
+				return errors.Wrap(err, "sample amount expressions not equal")
+			}
+			if (node1.seed == nil) != (node2.seed == nil) {
+				return errors.Errorf("only one of the sample seeds is nil: %v, %v", node1.seed, node2.seed)
+			}
+			if node1.seed != nil {
+				if err := EqualExpressions(node1.seed, node2.seed); err != nil {
+					return errors.Wrap(err, "sample seed expressions not equal")
+				}
+			}
+			if err := EqualNodes(node1.source, node2.source); err != nil {
+				return errors.Wrap(err, "sources not equal")
+			}
+			return nil
+		}
+
 	case *Limit:
 		if node2, ok := node2.(*Limit); ok {
 			if err := EqualExpressions(node1.limitExpr, node2.limitExpr); err != nil {
@@ -112,6 +229,20 @@ func EqualNodes(node1, node2 Node) error {
 			return nil
 		}
 
+	case *FullJoin:
+		if node2, ok := node2.(*FullJoin); ok {
+			if err := EqualNodes(node1.source, node2.source); err != nil {
+				return errors.Wrap(err, "source nodes underneath not equal")
+			}
+			if err := EqualNodes(node1.joined, node2.joined); err != nil {
+				return errors.Wrap(err, "joined nodes underneath not equal")
+			}
+			if err := EqualFormula(node1.condition, node2.condition); err != nil {
+				return errors.Wrap(err, "join conditions not equal")
+			}
+			return nil
+		}
+
 	case *Offset:
 		if node2, ok := node2.(*Offset); ok {
 			if err := EqualExpressions(node1.offsetExpr, node2.offsetExpr); err != nil {
@@ -10,3 +10,10 @@ type Output interface {
 	WriteRecord(record *execution.Record) error
 	io.Closer
 }
+
+// BufferingOutput is an output which keeps the written records in memory until it's closed,
+// reserving them in the query memory budget.
+type BufferingOutput interface {
+	Output
+	SetMemoryBudget(budget *execution.MemoryBudget)
+}
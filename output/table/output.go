@@ -15,6 +15,7 @@ type Output struct {
 	w        io.Writer
 	rowLines bool
 	records  []*execution.Record
+	budget   *execution.MemoryBudget
 }
 
 func NewOutput(w io.Writer, rowLines bool) output.Output {
@@ -24,7 +25,14 @@ func NewOutput(w io.Writer, rowLines bool) output.Output {
 	}
 }
 
+func (o *Output) SetMemoryBudget(budget *execution.MemoryBudget) {
+	o.budget = budget
+}
+
 func (o *Output) WriteRecord(record *execution.Record) error {
+	if _, err := o.budget.ReserveRecord(record, "table output"); err != nil {
+		return err
+	}
 	o.records = append(o.records, record)
 	return nil
 }
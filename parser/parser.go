@@ -8,8 +8,8 @@ import (
 
 	"github.com/cube2222/octosql"
 	"github.com/cube2222/octosql/logical"
+	"github.com/cube2222/octosql/parser/sqlparser"
 	"github.com/pkg/errors"
-	"github.com/xwb1989/sqlparser"
 )
 
 // TODO: W sumie to jeszcze moze byc "boolean node expression" chociaz oczywiscie dziala przez (costam) = TRUE
@@ -172,6 +172,20 @@ func ParseSelect(statement *sqlparser.Select) (logical.Node, error) {
 		root = logical.NewOrderBy(orderByExpressions, orderByDirections, root)
 	}
 
+	// DISTINCT ON keeps the first record for each key, so it has to come after ORDER BY,
+	// but before the unselected variables get dropped.
+	if len(statement.DistinctOn) > 0 {
+		key := make([]logical.Expression, len(statement.DistinctOn))
+		for i := range statement.DistinctOn {
+			key[i], err = ParseExpression(statement.DistinctOn[i])
+			if err != nil {
+				return nil, errors.Wrapf(err, "couldn't parse distinct on expression with index %v", i)
+			}
+		}
+
+		root = logical.NewDistinctOn(key, root)
+	}
+
 	// Now we only keep the selected variables.
 	if len(statement.SelectExprs) >= 1 {
 		if _, ok := statement.SelectExprs[0].(*sqlparser.StarExpr); !ok {
@@ -196,7 +210,7 @@ func ParseSelect(statement *sqlparser.Select) (logical.Node, error) {
 		}
 	}
 
-	if len(statement.Distinct) > 0 {
+	if len(statement.Distinct) > 0 && len(statement.DistinctOn) == 0 {
 		root = logical.NewDistinct(root)
 	}
 
@@ -242,6 +256,10 @@ func ParseTableExpression(expr sqlparser.TableExpr) (logical.Node, error) {
 		return ParseJoinTableExpression(expr)
 	case *sqlparser.ParenTableExpr:
 		return ParseTableExpression(expr.Exprs[0])
+	case *sqlparser.PivotTableExpr:
+		return ParsePivotTableExpression(expr)
+	case *sqlparser.UnpivotTableExpr:
+		return ParseUnpivotTableExpression(expr)
 	default:
 		return nil, errors.Errorf("invalid table expression %+v of type %v", expr, reflect.TypeOf(expr))
 	}
@@ -253,7 +271,17 @@ func ParseAliasedTableExpression(expr *sqlparser.AliasedTableExpr) (logical.Node
 		if expr.As.IsEmpty() {
 			return nil, errors.Errorf("table \"%v\" must have unique alias", subExpr.Name)
 		}
-		return logical.NewDataSource(subExpr.Name.String(), expr.As.String()), nil
+		var out logical.Node = logical.NewDataSource(subExpr.Name.String(), expr.As.String())
+
+		if expr.Sample != nil {
+			var err error
+			out, err = ParseTableSample(expr.Sample, out)
+			if err != nil {
+				return nil, errors.Wrap(err, "couldn't parse table sample")
+			}
+		}
+
+		return out, nil
 
 	case *sqlparser.Subquery:
 		subQuery, err := ParseNode(subExpr.Select)
@@ -267,6 +295,33 @@ func ParseAliasedTableExpression(expr *sqlparser.AliasedTableExpr) (logical.Node
 	}
 }
 
+func ParseTableSample(sample *sqlparser.TableSample, source logical.Node) (logical.Node, error) {
+	var method logical.SampleMethod
+	switch name, unit := sample.Method.Lowered(), sample.Unit.Lowered(); {
+	case (name == "bernoulli" || name == "system") && unit == "":
+		method = logical.SampleMethod(name)
+	case (name == "" || name == "reservoir") && unit == "rows":
+		method = logical.Reservoir
+	default:
+		return nil, errors.Errorf("invalid table sample method %v with unit %v", sample.Method, sample.Unit)
+	}
+
+	amount, err := ParseExpression(sample.Amount)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't parse sample amount expression")
+	}
+
+	var seed logical.Expression
+	if sample.Seed != nil {
+		seed, err = ParseExpression(sample.Seed)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't parse sample seed expression")
+		}
+	}
+
+	return logical.NewTableSample(method, amount, seed, source), nil
+}
+
 func ParseJoinTableExpression(expr *sqlparser.JoinTableExpr) (logical.Node, error) {
 	leftTable, err := ParseTableExpression(expr.LeftExpr)
 	if err != nil {
@@ -289,6 +344,16 @@ func ParseJoinTableExpression(expr *sqlparser.JoinTableExpr) (logical.Node, erro
 		// TODO: Add cardinality based heuristics
 		source = leftTable
 		joined = rightTable
+	case sqlparser.FullOuterJoinStr:
+		if expr.Condition.On == nil {
+			return nil, errors.New("full join must have an ON condition")
+		}
+		condition, err := ParseLogic(expr.Condition.On)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't parse ON condition in join")
+		}
+
+		return logical.NewFullJoin(leftTable, rightTable, condition), nil
 	default:
 		return nil, errors.Errorf("invalid join expression: %v", expr.Join)
 	}
@@ -312,6 +377,98 @@ func ParseJoinTableExpression(expr *sqlparser.JoinTableExpr) (logical.Node, erro
 	}
 }
 
+func ParsePivotTableExpression(expr *sqlparser.PivotTableExpr) (logical.Node, error) {
+	source, err := ParseTableExpression(expr.Expr)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't parse pivot source table expression")
+	}
+
+	aggregate, arg, err := ParseAggregate(expr.Aggregate)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't parse pivot aggregate")
+	}
+
+	var valueField octosql.VariableName
+	switch arg := arg.(type) {
+	case nil:
+		valueField = "*star*"
+	case *logical.Variable:
+		valueField = arg.Name()
+	default:
+		return nil, errors.Errorf("pivot aggregate argument must be a column, got %+v", arg)
+	}
+
+	pivotField, err := parseColumnName(expr.For)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't parse pivot column")
+	}
+
+	values := make([]logical.Expression, len(expr.In))
+	as := make([]octosql.VariableName, len(expr.In))
+	for i := range expr.In {
+		values[i], err = ParseExpression(expr.In[i].Expr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't parse pivot value with index %v", i)
+		}
+
+		if !expr.In[i].As.IsEmpty() {
+			as[i] = octosql.NewVariableName(expr.In[i].As.String())
+		} else if val, ok := expr.In[i].Expr.(*sqlparser.SQLVal); ok {
+			as[i] = octosql.NewVariableName(string(val.Val))
+		} else {
+			return nil, errors.Errorf("pivot value with index %v must be a constant or be aliased", i)
+		}
+	}
+
+	var root logical.Node = logical.NewPivot(source, pivotField, valueField, aggregate, values, as)
+	if !expr.As.IsEmpty() {
+		root = logical.NewRequalifier(expr.As.String(), root)
+	}
+
+	return root, nil
+}
+
+func ParseUnpivotTableExpression(expr *sqlparser.UnpivotTableExpr) (logical.Node, error) {
+	source, err := ParseTableExpression(expr.Expr)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't parse unpivot source table expression")
+	}
+
+	columns := make([]octosql.VariableName, len(expr.In))
+	for i := range expr.In {
+		columns[i], err = parseColumnName(expr.In[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't parse unpivot column with index %v", i)
+		}
+	}
+
+	var root logical.Node = logical.NewUnpivot(
+		source,
+		columns,
+		octosql.NewVariableName(expr.For.String()),
+		octosql.NewVariableName(expr.Value.String()),
+	)
+	if !expr.As.IsEmpty() {
+		root = logical.NewRequalifier(expr.As.String(), root)
+	}
+
+	return root, nil
+}
+
+func parseColumnName(expr *sqlparser.ColName) (octosql.VariableName, error) {
+	parsed, err := ParseExpression(expr)
+	if err != nil {
+		return "", errors.Wrap(err, "couldn't parse column name")
+	}
+
+	variable, ok := parsed.(*logical.Variable)
+	if !ok {
+		return "", errors.Errorf("expected variable, got %+v", parsed)
+	}
+
+	return variable.Name(), nil
+}
+
 func ParseAggregate(expr sqlparser.Expr) (logical.Aggregate, logical.NamedExpression, error) {
 	switch expr := expr.(type) {
 	case *sqlparser.FuncExpr:
@@ -9,7 +9,7 @@ import (
 	"github.com/cube2222/octosql"
 
 	"github.com/cube2222/octosql/logical"
-	"github.com/xwb1989/sqlparser"
+	"github.com/cube2222/octosql/parser/sqlparser"
 )
 
 func TestParseNode(t *testing.T) {
@@ -341,6 +341,120 @@ func TestParseNode(t *testing.T) {
 			),
 			wantErr: false,
 		},
+		{
+			name: "distinct on",
+			args: args{
+				statement: "SELECT DISTINCT ON (e.user_id) e.user_id, e.ts FROM events e ORDER BY e.user_id ASC, e.ts DESC",
+			},
+			want: logical.NewMap(
+				[]logical.NamedExpression{
+					logical.NewVariable("e.user_id"),
+					logical.NewVariable("e.ts"),
+				},
+				logical.NewDistinctOn(
+					[]logical.Expression{
+						logical.NewVariable("e.user_id"),
+					},
+					logical.NewOrderBy(
+						[]logical.Expression{
+							logical.NewVariable("e.user_id"),
+							logical.NewVariable("e.ts"),
+						},
+						[]logical.OrderDirection{"asc", "desc"},
+						logical.NewMap(
+							[]logical.NamedExpression{
+								logical.NewVariable("e.user_id"),
+								logical.NewVariable("e.ts"),
+							},
+							logical.NewDataSource("events", "e"),
+							true,
+						),
+					),
+				),
+				false,
+			),
+			wantErr: false,
+		},
+		{
+			name: "pivot",
+			args: args{
+				statement: "SELECT * FROM sales s PIVOT (SUM(s.amount) FOR s.month IN ('jan', 'feb' AS february)) p",
+			},
+			want: logical.NewRequalifier(
+				"p",
+				logical.NewPivot(
+					logical.NewDataSource("sales", "s"),
+					"s.month",
+					"s.amount",
+					logical.Sum,
+					[]logical.Expression{
+						logical.NewConstant("jan"),
+						logical.NewConstant("feb"),
+					},
+					[]octosql.VariableName{"jan", "february"},
+				),
+			),
+			wantErr: false,
+		},
+		{
+			name: "unpivot",
+			args: args{
+				statement: "SELECT * FROM sales s UNPIVOT (amount FOR month IN (s.jan, s.feb))",
+			},
+			want: logical.NewUnpivot(
+				logical.NewDataSource("sales", "s"),
+				[]octosql.VariableName{"s.jan", "s.feb"},
+				"month",
+				"amount",
+			),
+			wantErr: false,
+		},
+		{
+			name: "tablesample bernoulli",
+			args: args{
+				statement: "SELECT * FROM events e TABLESAMPLE BERNOULLI (1) WHERE e.type = 'click'",
+			},
+			want: logical.NewFilter(
+				logical.NewPredicate(
+					logical.NewVariable("e.type"),
+					logical.NewRelation("="),
+					logical.NewConstant("click"),
+				),
+				logical.NewTableSample(
+					logical.Bernoulli,
+					logical.NewConstant(1),
+					nil,
+					logical.NewDataSource("events", "e"),
+				),
+			),
+			wantErr: false,
+		},
+		{
+			name: "tablesample system repeatable",
+			args: args{
+				statement: "SELECT * FROM events e TABLESAMPLE SYSTEM (2.5) REPEATABLE (42)",
+			},
+			want: logical.NewTableSample(
+				logical.System,
+				logical.NewConstant(2.5),
+				logical.NewConstant(42),
+				logical.NewDataSource("events", "e"),
+			),
+			wantErr: false,
+		},
+		{
+			name: "sample rows",
+			args: args{
+				statement: "SELECT * FROM events e SAMPLE 100 ROWS REPEATABLE (7)",
+			},
+			want: logical.NewTableSample(
+				logical.Reservoir,
+				logical.NewConstant(100),
+				logical.NewConstant(7),
+				logical.NewDataSource("events", "e"),
+			),
+			wantErr: false,
+		},
 		{
 			name: "all operators",
 			args: args{
@@ -510,6 +624,35 @@ WHERE (SELECT p2.age FROM people p2 WHERE p2.name = 'wojtek') > p3.age`,
 			),
 			wantErr: false,
 		},
+		{
+			name: "full join",
+			args: args{
+				statement: `
+SELECT p.name FROM people p FULL OUTER JOIN cities c ON p.city = c.name`,
+			},
+			want: logical.NewMap(
+				[]logical.NamedExpression{
+					logical.NewVariable("p.name"),
+				},
+				logical.NewMap(
+					[]logical.NamedExpression{
+						logical.NewVariable("p.name"),
+					},
+					logical.NewFullJoin(
+						logical.NewDataSource("people", "p"),
+						logical.NewDataSource("cities", "c"),
+						logical.NewPredicate(
+							logical.NewVariable("p.city"),
+							logical.Equal,
+							logical.NewVariable("c.name"),
+						),
+					),
+					true,
+				),
+				false,
+			),
+			wantErr: false,
+		},
 		{
 			name: "left join",
 			args: args{
@@ -0,0 +1,19 @@
+package sqlparser
+
+import querypb "github.com/cube2222/octosql/parser/sqlparser/dependency/querypb"
+
+// RedactSQLQuery returns a sql string with the params stripped out for display
+func RedactSQLQuery(sql string) (string, error) {
+	bv := map[string]*querypb.BindVariable{}
+	sqlStripped, comments := SplitMarginComments(sql)
+
+	stmt, err := Parse(sqlStripped)
+	if err != nil {
+		return "", err
+	}
+
+	prefix := "redacted"
+	Normalize(stmt, bv, prefix)
+
+	return comments.Leading + String(stmt) + comments.Trailing, nil
+}
@@ -0,0 +1,6339 @@
+// Code generated by goyacc -o sql.go sql.y. DO NOT EDIT.
+
+//line sql.y:18
+package sqlparser
+
+import __yyfmt__ "fmt"
+
+//line sql.y:18
+
+func setParseTree(yylex interface{}, stmt Statement) {
+	yylex.(*Tokenizer).ParseTree = stmt
+}
+
+func setAllowComments(yylex interface{}, allow bool) {
+	yylex.(*Tokenizer).AllowComments = allow
+}
+
+func setDDL(yylex interface{}, ddl *DDL) {
+	yylex.(*Tokenizer).partialDDL = ddl
+}
+
+func incNesting(yylex interface{}) bool {
+	yylex.(*Tokenizer).nesting++
+	if yylex.(*Tokenizer).nesting == 200 {
+		return true
+	}
+	return false
+}
+
+func decNesting(yylex interface{}) {
+	yylex.(*Tokenizer).nesting--
+}
+
+// forceEOF forces the lexer to end prematurely. Not all SQL statements
+// are supported by the Parser, thus calling forceEOF will make the lexer
+// return EOF early.
+func forceEOF(yylex interface{}) {
+	yylex.(*Tokenizer).ForceEOF = true
+}
+
+//line sql.y:53
+type yySymType struct {
+	yys               int
+	empty             struct{}
+	statement         Statement
+	selStmt           SelectStatement
+	ddl               *DDL
+	ins               *Insert
+	byt               byte
+	bytes             []byte
+	bytes2            [][]byte
+	str               string
+	strs              []string
+	selectExprs       SelectExprs
+	selectExpr        SelectExpr
+	columns           Columns
+	partitions        Partitions
+	colName           *ColName
+	tableExprs        TableExprs
+	tableExpr         TableExpr
+	joinCondition     JoinCondition
+	tableName         TableName
+	tableNames        TableNames
+	indexHints        *IndexHints
+	expr              Expr
+	exprs             Exprs
+	boolVal           BoolVal
+	colTuple          ColTuple
+	values            Values
+	valTuple          ValTuple
+	subquery          *Subquery
+	whens             []*When
+	when              *When
+	orderBy           OrderBy
+	order             *Order
+	limit             *Limit
+	updateExprs       UpdateExprs
+	setExprs          SetExprs
+	updateExpr        *UpdateExpr
+	setExpr           *SetExpr
+	colIdent          ColIdent
+	tableIdent        TableIdent
+	convertType       *ConvertType
+	aliasedTableName  *AliasedTableExpr
+	TableSpec         *TableSpec
+	columnType        ColumnType
+	colKeyOpt         ColumnKeyOption
+	optVal            *SQLVal
+	LengthScaleOption LengthScaleOption
+	columnDefinition  *ColumnDefinition
+	indexDefinition   *IndexDefinition
+	indexInfo         *IndexInfo
+	indexOption       *IndexOption
+	indexOptions      []*IndexOption
+	indexColumn       *IndexColumn
+	indexColumns      []*IndexColumn
+	partDefs          []*PartitionDefinition
+	partDef           *PartitionDefinition
+	partSpec          *PartitionSpec
+	vindexParam       VindexParam
+	vindexParams      []VindexParam
+	showFilter        *ShowFilter
+	pivotValue        *PivotValue
+	pivotValues       PivotValues
+	colNames          []*ColName
+	tableSample       *TableSample
+}
+
+const LEX_ERROR = 57346
+const UNION = 57347
+const SELECT = 57348
+const STREAM = 57349
+const INSERT = 57350
+const UPDATE = 57351
+const DELETE = 57352
+const FROM = 57353
+const WHERE = 57354
+const GROUP = 57355
+const HAVING = 57356
+const ORDER = 57357
+const BY = 57358
+const LIMIT = 57359
+const OFFSET = 57360
+const FOR = 57361
+const ALL = 57362
+const DISTINCT = 57363
+const AS = 57364
+const EXISTS = 57365
+const ASC = 57366
+const DESC = 57367
+const INTO = 57368
+const DUPLICATE = 57369
+const KEY = 57370
+const DEFAULT = 57371
+const SET = 57372
+const LOCK = 57373
+const KEYS = 57374
+const VALUES = 57375
+const LAST_INSERT_ID = 57376
+const NEXT = 57377
+const VALUE = 57378
+const SHARE = 57379
+const MODE = 57380
+const SQL_NO_CACHE = 57381
+const SQL_CACHE = 57382
+const JOIN = 57383
+const STRAIGHT_JOIN = 57384
+const LEFT = 57385
+const RIGHT = 57386
+const INNER = 57387
+const OUTER = 57388
+const CROSS = 57389
+const NATURAL = 57390
+const USE = 57391
+const FORCE = 57392
+const ON = 57393
+const USING = 57394
+const PIVOT = 57395
+const UNPIVOT = 57396
+const TABLESAMPLE = 57397
+const SAMPLE = 57398
+const ID = 57399
+const HEX = 57400
+const STRING = 57401
+const INTEGRAL = 57402
+const FLOAT = 57403
+const HEXNUM = 57404
+const VALUE_ARG = 57405
+const LIST_ARG = 57406
+const COMMENT = 57407
+const COMMENT_KEYWORD = 57408
+const BIT_LITERAL = 57409
+const NULL = 57410
+const TRUE = 57411
+const FALSE = 57412
+const OR = 57413
+const AND = 57414
+const NOT = 57415
+const BETWEEN = 57416
+const CASE = 57417
+const WHEN = 57418
+const THEN = 57419
+const ELSE = 57420
+const END = 57421
+const LE = 57422
+const GE = 57423
+const NE = 57424
+const NULL_SAFE_EQUAL = 57425
+const IS = 57426
+const LIKE = 57427
+const REGEXP = 57428
+const IN = 57429
+const SHIFT_LEFT = 57430
+const SHIFT_RIGHT = 57431
+const DIV = 57432
+const MOD = 57433
+const UNARY = 57434
+const COLLATE = 57435
+const BINARY = 57436
+const UNDERSCORE_BINARY = 57437
+const INTERVAL = 57438
+const JSON_EXTRACT_OP = 57439
+const JSON_UNQUOTE_EXTRACT_OP = 57440
+const CREATE = 57441
+const ALTER = 57442
+const DROP = 57443
+const RENAME = 57444
+const ANALYZE = 57445
+const ADD = 57446
+const SCHEMA = 57447
+const TABLE = 57448
+const INDEX = 57449
+const VIEW = 57450
+const TO = 57451
+const IGNORE = 57452
+const IF = 57453
+const UNIQUE = 57454
+const PRIMARY = 57455
+const COLUMN = 57456
+const CONSTRAINT = 57457
+const SPATIAL = 57458
+const FULLTEXT = 57459
+const FOREIGN = 57460
+const KEY_BLOCK_SIZE = 57461
+const SHOW = 57462
+const DESCRIBE = 57463
+const EXPLAIN = 57464
+const DATE = 57465
+const ESCAPE = 57466
+const REPAIR = 57467
+const OPTIMIZE = 57468
+const TRUNCATE = 57469
+const MAXVALUE = 57470
+const PARTITION = 57471
+const REORGANIZE = 57472
+const LESS = 57473
+const THAN = 57474
+const PROCEDURE = 57475
+const TRIGGER = 57476
+const VINDEX = 57477
+const VINDEXES = 57478
+const STATUS = 57479
+const VARIABLES = 57480
+const BEGIN = 57481
+const START = 57482
+const TRANSACTION = 57483
+const COMMIT = 57484
+const ROLLBACK = 57485
+const BIT = 57486
+const TINYINT = 57487
+const SMALLINT = 57488
+const MEDIUMINT = 57489
+const INT = 57490
+const INTEGER = 57491
+const BIGINT = 57492
+const INTNUM = 57493
+const REAL = 57494
+const DOUBLE = 57495
+const FLOAT_TYPE = 57496
+const DECIMAL = 57497
+const NUMERIC = 57498
+const TIME = 57499
+const TIMESTAMP = 57500
+const DATETIME = 57501
+const YEAR = 57502
+const CHAR = 57503
+const VARCHAR = 57504
+const BOOL = 57505
+const CHARACTER = 57506
+const VARBINARY = 57507
+const NCHAR = 57508
+const TEXT = 57509
+const TINYTEXT = 57510
+const MEDIUMTEXT = 57511
+const LONGTEXT = 57512
+const BLOB = 57513
+const TINYBLOB = 57514
+const MEDIUMBLOB = 57515
+const LONGBLOB = 57516
+const JSON = 57517
+const ENUM = 57518
+const GEOMETRY = 57519
+const POINT = 57520
+const LINESTRING = 57521
+const POLYGON = 57522
+const GEOMETRYCOLLECTION = 57523
+const MULTIPOINT = 57524
+const MULTILINESTRING = 57525
+const MULTIPOLYGON = 57526
+const NULLX = 57527
+const AUTO_INCREMENT = 57528
+const APPROXNUM = 57529
+const SIGNED = 57530
+const UNSIGNED = 57531
+const ZEROFILL = 57532
+const DATABASES = 57533
+const TABLES = 57534
+const VITESS_KEYSPACES = 57535
+const VITESS_SHARDS = 57536
+const VITESS_TABLETS = 57537
+const VSCHEMA_TABLES = 57538
+const EXTENDED = 57539
+const FULL = 57540
+const PROCESSLIST = 57541
+const NAMES = 57542
+const CHARSET = 57543
+const GLOBAL = 57544
+const SESSION = 57545
+const ISOLATION = 57546
+const LEVEL = 57547
+const READ = 57548
+const WRITE = 57549
+const ONLY = 57550
+const REPEATABLE = 57551
+const COMMITTED = 57552
+const UNCOMMITTED = 57553
+const SERIALIZABLE = 57554
+const CURRENT_TIMESTAMP = 57555
+const DATABASE = 57556
+const CURRENT_DATE = 57557
+const CURRENT_TIME = 57558
+const LOCALTIME = 57559
+const LOCALTIMESTAMP = 57560
+const UTC_DATE = 57561
+const UTC_TIME = 57562
+const UTC_TIMESTAMP = 57563
+const REPLACE = 57564
+const CONVERT = 57565
+const CAST = 57566
+const SUBSTR = 57567
+const SUBSTRING = 57568
+const GROUP_CONCAT = 57569
+const SEPARATOR = 57570
+const MATCH = 57571
+const AGAINST = 57572
+const BOOLEAN = 57573
+const LANGUAGE = 57574
+const WITH = 57575
+const QUERY = 57576
+const EXPANSION = 57577
+const UNUSED = 57578
+
+var yyToknames = [...]string{
+	"$end",
+	"error",
+	"$unk",
+	"LEX_ERROR",
+	"UNION",
+	"SELECT",
+	"STREAM",
+	"INSERT",
+	"UPDATE",
+	"DELETE",
+	"FROM",
+	"WHERE",
+	"GROUP",
+	"HAVING",
+	"ORDER",
+	"BY",
+	"LIMIT",
+	"OFFSET",
+	"FOR",
+	"ALL",
+	"DISTINCT",
+	"AS",
+	"EXISTS",
+	"ASC",
+	"DESC",
+	"INTO",
+	"DUPLICATE",
+	"KEY",
+	"DEFAULT",
+	"SET",
+	"LOCK",
+	"KEYS",
+	"VALUES",
+	"LAST_INSERT_ID",
+	"NEXT",
+	"VALUE",
+	"SHARE",
+	"MODE",
+	"SQL_NO_CACHE",
+	"SQL_CACHE",
+	"JOIN",
+	"STRAIGHT_JOIN",
+	"LEFT",
+	"RIGHT",
+	"INNER",
+	"OUTER",
+	"CROSS",
+	"NATURAL",
+	"USE",
+	"FORCE",
+	"ON",
+	"USING",
+	"PIVOT",
+	"UNPIVOT",
+	"TABLESAMPLE",
+	"SAMPLE",
+	"'('",
+	"','",
+	"')'",
+	"ID",
+	"HEX",
+	"STRING",
+	"INTEGRAL",
+	"FLOAT",
+	"HEXNUM",
+	"VALUE_ARG",
+	"LIST_ARG",
+	"COMMENT",
+	"COMMENT_KEYWORD",
+	"BIT_LITERAL",
+	"NULL",
+	"TRUE",
+	"FALSE",
+	"OR",
+	"AND",
+	"NOT",
+	"'!'",
+	"BETWEEN",
+	"CASE",
+	"WHEN",
+	"THEN",
+	"ELSE",
+	"END",
+	"'='",
+	"'<'",
+	"'>'",
+	"LE",
+	"GE",
+	"NE",
+	"NULL_SAFE_EQUAL",
+	"IS",
+	"LIKE",
+	"REGEXP",
+	"IN",
+	"'|'",
+	"'&'",
+	"SHIFT_LEFT",
+	"SHIFT_RIGHT",
+	"'+'",
+	"'-'",
+	"'*'",
+	"'/'",
+	"DIV",
+	"'%'",
+	"MOD",
+	"'^'",
+	"'~'",
+	"UNARY",
+	"COLLATE",
+	"BINARY",
+	"UNDERSCORE_BINARY",
+	"INTERVAL",
+	"'.'",
+	"JSON_EXTRACT_OP",
+	"JSON_UNQUOTE_EXTRACT_OP",
+	"CREATE",
+	"ALTER",
+	"DROP",
+	"RENAME",
+	"ANALYZE",
+	"ADD",
+	"SCHEMA",
+	"TABLE",
+	"INDEX",
+	"VIEW",
+	"TO",
+	"IGNORE",
+	"IF",
+	"UNIQUE",
+	"PRIMARY",
+	"COLUMN",
+	"CONSTRAINT",
+	"SPATIAL",
+	"FULLTEXT",
+	"FOREIGN",
+	"KEY_BLOCK_SIZE",
+	"SHOW",
+	"DESCRIBE",
+	"EXPLAIN",
+	"DATE",
+	"ESCAPE",
+	"REPAIR",
+	"OPTIMIZE",
+	"TRUNCATE",
+	"MAXVALUE",
+	"PARTITION",
+	"REORGANIZE",
+	"LESS",
+	"THAN",
+	"PROCEDURE",
+	"TRIGGER",
+	"VINDEX",
+	"VINDEXES",
+	"STATUS",
+	"VARIABLES",
+	"BEGIN",
+	"START",
+	"TRANSACTION",
+	"COMMIT",
+	"ROLLBACK",
+	"BIT",
+	"TINYINT",
+	"SMALLINT",
+	"MEDIUMINT",
+	"INT",
+	"INTEGER",
+	"BIGINT",
+	"INTNUM",
+	"REAL",
+	"DOUBLE",
+	"FLOAT_TYPE",
+	"DECIMAL",
+	"NUMERIC",
+	"TIME",
+	"TIMESTAMP",
+	"DATETIME",
+	"YEAR",
+	"CHAR",
+	"VARCHAR",
+	"BOOL",
+	"CHARACTER",
+	"VARBINARY",
+	"NCHAR",
+	"TEXT",
+	"TINYTEXT",
+	"MEDIUMTEXT",
+	"LONGTEXT",
+	"BLOB",
+	"TINYBLOB",
+	"MEDIUMBLOB",
+	"LONGBLOB",
+	"JSON",
+	"ENUM",
+	"GEOMETRY",
+	"POINT",
+	"LINESTRING",
+	"POLYGON",
+	"GEOMETRYCOLLECTION",
+	"MULTIPOINT",
+	"MULTILINESTRING",
+	"MULTIPOLYGON",
+	"NULLX",
+	"AUTO_INCREMENT",
+	"APPROXNUM",
+	"SIGNED",
+	"UNSIGNED",
+	"ZEROFILL",
+	"DATABASES",
+	"TABLES",
+	"VITESS_KEYSPACES",
+	"VITESS_SHARDS",
+	"VITESS_TABLETS",
+	"VSCHEMA_TABLES",
+	"EXTENDED",
+	"FULL",
+	"PROCESSLIST",
+	"NAMES",
+	"CHARSET",
+	"GLOBAL",
+	"SESSION",
+	"ISOLATION",
+	"LEVEL",
+	"READ",
+	"WRITE",
+	"ONLY",
+	"REPEATABLE",
+	"COMMITTED",
+	"UNCOMMITTED",
+	"SERIALIZABLE",
+	"CURRENT_TIMESTAMP",
+	"DATABASE",
+	"CURRENT_DATE",
+	"CURRENT_TIME",
+	"LOCALTIME",
+	"LOCALTIMESTAMP",
+	"UTC_DATE",
+	"UTC_TIME",
+	"UTC_TIMESTAMP",
+	"REPLACE",
+	"CONVERT",
+	"CAST",
+	"SUBSTR",
+	"SUBSTRING",
+	"GROUP_CONCAT",
+	"SEPARATOR",
+	"MATCH",
+	"AGAINST",
+	"BOOLEAN",
+	"LANGUAGE",
+	"WITH",
+	"QUERY",
+	"EXPANSION",
+	"UNUSED",
+	"';'",
+}
+
+var yyStatenames = [...]string{}
+
+const yyEofCode = 1
+const yyErrCode = 2
+const yyInitialStackSize = 16
+
+//line yacctab:1
+var yyExca = [...]int16{
+	-1, 1,
+	1, -1,
+	-2, 0,
+	-1, 3,
+	5, 28,
+	-2, 4,
+	-1, 36,
+	154, 264,
+	155, 264,
+	-2, 254,
+	-1, 238,
+	113, 603,
+	-2, 599,
+	-1, 239,
+	113, 604,
+	-2, 600,
+	-1, 308,
+	84, 762,
+	-2, 59,
+	-1, 309,
+	84, 723,
+	-2, 60,
+	-1, 314,
+	84, 707,
+	-2, 565,
+	-1, 316,
+	84, 744,
+	-2, 567,
+	-1, 578,
+	52, 42,
+	58, 42,
+	-2, 44,
+	-1, 712,
+	113, 606,
+	-2, 602,
+	-1, 922,
+	5, 29,
+	-2, 411,
+	-1, 947,
+	5, 28,
+	-2, 540,
+	-1, 1186,
+	5, 29,
+	-2, 541,
+	-1, 1236,
+	5, 28,
+	-2, 543,
+	-1, 1311,
+	5, 29,
+	-2, 544,
+}
+
+const yyPrivate = 57344
+
+const yyLast = 11636
+
+var yyAct = [...]int16{
+	239, 1268, 1314, 1221, 572, 863, 1301, 1245, 471, 950,
+	775, 522, 1050, 243, 968, 1252, 268, 649, 1088, 1192,
+	1121, 797, 697, 521, 3, 1089, 1016, 1085, 776, 819,
+	843, 76, 857, 245, 818, 186, 217, 570, 186, 211,
+	974, 53, 737, 1062, 1019, 747, 914, 1007, 746, 588,
+	253, 955, 313, 764, 744, 829, 815, 714, 410, 455,
+	461, 307, 186, 186, 76, 853, 587, 556, 186, 574,
+	76, 216, 772, 295, 467, 475, 226, 304, 294, 895,
+	241, 536, 302, 212, 213, 214, 215, 52, 1355, 1330,
+	1353, 440, 1309, 1349, 864, 298, 1329, 1080, 1308, 293,
+	1180, 414, 1261, 1116, 1117, 230, 1246, 880, 1277, 488,
+	487, 497, 498, 490, 491, 492, 493, 494, 495, 496,
+	489, 879, 57, 499, 1127, 1128, 1129, 181, 177, 178,
+	179, 1115, 1132, 1130, 589, 982, 590, 435, 981, 811,
+	812, 983, 810, 791, 450, 562, 563, 59, 60, 61,
+	62, 63, 884, 558, 561, 562, 563, 559, 837, 560,
+	565, 878, 998, 956, 957, 558, 561, 562, 563, 559,
+	1209, 560, 565, 678, 836, 186, 423, 186, 844, 1169,
+	679, 1167, 1225, 186, 210, 1063, 446, 447, 1352, 1347,
+	186, 1302, 1040, 773, 76, 76, 76, 76, 424, 76,
+	437, 417, 439, 1259, 657, 1253, 76, 175, 236, 875,
+	872, 873, 174, 871, 175, 1065, 648, 831, 1027, 973,
+	831, 972, 1255, 971, 412, 816, 420, 436, 438, 798,
+	800, 189, 176, 76, 511, 512, 180, 474, 882, 885,
+	1282, 1189, 1104, 1048, 930, 908, 464, 1067, 1037, 1071,
+	1025, 1066, 992, 1064, 1039, 685, 479, 430, 1069, 1136,
+	489, 499, 463, 499, 1278, 890, 1243, 1068, 1242, 1044,
+	682, 1293, 1146, 877, 509, 23, 24, 48, 26, 27,
+	1070, 1072, 953, 1082, 453, 927, 443, 444, 445, 1254,
+	448, 591, 1131, 186, 42, 876, 831, 452, 1307, 28,
+	186, 186, 186, 844, 1260, 1258, 76, 799, 434, 1137,
+	765, 652, 76, 830, 1026, 721, 830, 564, 37, 1031,
+	1028, 1021, 1022, 1029, 1024, 1023, 50, 564, 411, 719,
+	720, 718, 881, 298, 473, 472, 1030, 473, 472, 564,
+	1296, 1084, 1033, 996, 469, 883, 465, 1322, 891, 1043,
+	1038, 474, 1036, 1321, 474, 833, 426, 427, 428, 765,
+	834, 937, 1215, 538, 539, 540, 541, 542, 543, 544,
+	497, 498, 490, 491, 492, 493, 494, 495, 496, 489,
+	50, 416, 499, 585, 579, 30, 31, 33, 32, 35,
+	717, 1294, 830, 926, 472, 688, 689, 828, 826, 925,
+	1214, 827, 473, 472, 1291, 1011, 36, 43, 44, 684,
+	474, 45, 46, 34, 76, 473, 472, 1010, 999, 474,
+	186, 186, 76, 1232, 186, 38, 39, 186, 40, 41,
+	1212, 186, 474, 76, 76, 76, 76, 76, 76, 76,
+	76, 1154, 269, 47, 1008, 473, 472, 76, 76, 683,
+	173, 454, 186, 418, 419, 513, 514, 515, 516, 517,
+	518, 519, 474, 1335, 454, 473, 472, 76, 704, 706,
+	707, 186, 1124, 705, 905, 906, 907, 76, 738, 1123,
+	739, 993, 474, 666, 492, 493, 494, 495, 496, 489,
+	47, 984, 499, 1332, 454, 1325, 454, 1265, 222, 715,
+	690, 1240, 1299, 1264, 299, 647, 1240, 454, 49, 866,
+	664, 292, 740, 656, 1240, 1241, 716, 1206, 1205, 1133,
+	76, 1112, 454, 951, 667, 668, 669, 670, 671, 672,
+	673, 674, 712, 1188, 454, 749, 454, 749, 675, 676,
+	1143, 1142, 692, 756, 759, 1139, 1140, 1184, 751, 766,
+	663, 186, 708, 662, 186, 186, 186, 186, 186, 710,
+	1139, 1138, 920, 454, 553, 454, 777, 1027, 653, 186,
+	651, 646, 186, 432, 752, 753, 186, 598, 597, 1086,
+	760, 186, 186, 425, 411, 76, 951, 298, 298, 298,
+	298, 298, 741, 742, 768, 751, 770, 771, 76, 1025,
+	1051, 769, 804, 553, 1145, 298, 1141, 761, 581, 582,
+	985, 809, 54, 23, 298, 920, 23, 584, 805, 932,
+	490, 491, 492, 493, 494, 495, 496, 489, 779, 780,
+	499, 782, 778, 792, 929, 781, 441, 441, 441, 441,
+	1235, 441, 845, 846, 847, 802, 807, 920, 441, 186,
+	583, 803, 76, 808, 76, 920, 581, 823, 186, 686,
+	23, 186, 76, 1026, 50, 47, 931, 50, 1031, 1028,
+	1021, 1022, 1029, 1024, 1023, 952, 50, 1219, 859, 838,
+	508, 928, 858, 510, 945, 1030, 223, 946, 969, 970,
+	713, 1020, 1101, 722, 723, 724, 725, 726, 727, 728,
+	729, 730, 731, 732, 733, 734, 735, 736, 855, 856,
+	520, 50, 524, 525, 526, 527, 528, 529, 530, 531,
+	532, 553, 535, 537, 537, 537, 537, 537, 537, 537,
+	537, 545, 546, 547, 548, 715, 988, 50, 712, 952,
+	854, 552, 571, 867, 849, 869, 903, 896, 848, 691,
+	897, 65, 716, 888, 258, 257, 260, 261, 262, 263,
+	21, 566, 567, 259, 264, 839, 840, 841, 842, 553,
+	956, 957, 566, 567, 650, 959, 910, 566, 567, 1342,
+	861, 850, 851, 852, 1126, 951, 1086, 1012, 699, 660,
+	451, 698, 789, 787, 919, 76, 947, 790, 788, 785,
+	963, 962, 76, 961, 786, 186, 748, 750, 784, 783,
+	934, 227, 228, 976, 1328, 978, 221, 936, 1047, 76,
+	892, 468, 767, 1340, 902, 901, 1003, 456, 596, 433,
+	995, 1298, 1297, 960, 1233, 466, 1220, 989, 298, 457,
+	1182, 868, 977, 659, 964, 569, 224, 225, 986, 468,
+	762, 1105, 900, 1103, 218, 796, 441, 1269, 1270, 979,
+	899, 219, 76, 76, 441, 76, 54, 1222, 952, 470,
+	1000, 1001, 1279, 1210, 267, 441, 441, 441, 441, 441,
+	441, 441, 441, 990, 991, 681, 56, 58, 76, 441,
+	441, 186, 186, 580, 51, 1, 865, 1009, 1015, 186,
+	1002, 874, 1004, 1005, 1006, 74, 1300, 1251, 1120, 76,
+	1018, 825, 817, 409, 64, 1292, 1052, 1032, 824, 1257,
+	1208, 832, 997, 835, 1125, 1295, 994, 603, 911, 912,
+	913, 601, 602, 600, 605, 604, 599, 197, 312, 305,
+	568, 592, 860, 66, 415, 1035, 1034, 870, 1042, 76,
+	76, 677, 889, 47, 1014, 449, 1087, 199, 1056, 777,
+	507, 898, 980, 1090, 1055, 777, 1061, 524, 311, 1073,
+	76, 1074, 1092, 1093, 1316, 1313, 1081, 687, 460, 1041,
+	1097, 935, 533, 712, 763, 244, 76, 1114, 76, 76,
+	1095, 703, 1096, 256, 255, 254, 299, 299, 299, 299,
+	299, 693, 944, 481, 242, 234, 297, 549, 557, 793,
+	794, 555, 554, 186, 571, 958, 801, 1119, 1113, 954,
+	1118, 76, 442, 299, 296, 917, 1179, 1276, 25, 918,
+	55, 229, 19, 18, 76, 186, 922, 923, 924, 17,
+	20, 76, 16, 15, 14, 933, 29, 13, 12, 76,
+	11, 939, 186, 940, 941, 942, 943, 1134, 1135, 1147,
+	1156, 10, 9, 8, 7, 6, 5, 4, 312, 312,
+	312, 312, 1149, 312, 220, 1152, 22, 2, 0, 0,
+	312, 0, 0, 0, 0, 298, 310, 0, 0, 1157,
+	0, 0, 0, 0, 441, 0, 441, 0, 0, 0,
+	1165, 0, 76, 0, 441, 76, 76, 477, 76, 76,
+	76, 76, 186, 76, 0, 1183, 1202, 1194, 0, 76,
+	0, 0, 0, 0, 1058, 1059, 0, 0, 1199, 1200,
+	1201, 0, 0, 1191, 0, 0, 0, 1075, 1076, 0,
+	1078, 1079, 904, 0, 0, 76, 76, 76, 986, 0,
+	1204, 0, 0, 0, 909, 0, 488, 487, 497, 498,
+	490, 491, 492, 493, 494, 495, 496, 489, 0, 1211,
+	499, 1213, 1217, 0, 0, 0, 1218, 0, 0, 1107,
+	312, 1223, 0, 0, 0, 0, 593, 0, 0, 76,
+	76, 0, 0, 0, 1224, 0, 0, 0, 0, 0,
+	0, 1090, 915, 0, 1060, 0, 0, 76, 0, 0,
+	1234, 1236, 0, 0, 0, 948, 949, 0, 0, 0,
+	76, 0, 1162, 1163, 1250, 1164, 0, 1256, 1166, 0,
+	1168, 0, 0, 0, 0, 0, 1216, 0, 0, 1266,
+	0, 76, 0, 0, 0, 76, 0, 299, 0, 0,
+	0, 0, 1090, 0, 1280, 0, 0, 0, 0, 1111,
+	0, 1281, 0, 0, 0, 1289, 1160, 1290, 1262, 0,
+	1263, 0, 1271, 0, 0, 0, 0, 0, 0, 0,
+	0, 1207, 1304, 76, 1305, 0, 0, 0, 312, 0,
+	1310, 0, 0, 777, 1318, 0, 312, 0, 0, 1317,
+	0, 0, 0, 76, 0, 441, 0, 312, 312, 312,
+	312, 312, 312, 312, 312, 1327, 76, 1323, 0, 0,
+	232, 312, 312, 0, 1333, 0, 0, 1336, 310, 1338,
+	441, 1341, 1339, 0, 0, 1344, 0, 1158, 0, 0,
+	1159, 694, 0, 1348, 186, 0, 186, 1161, 1350, 1346,
+	1351, 477, 0, 0, 312, 0, 0, 0, 1170, 1171,
+	1172, 0, 0, 1175, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1185, 1186, 1187, 0,
+	1190, 0, 1226, 1227, 0, 1228, 1229, 1230, 0, 1091,
+	459, 47, 1176, 454, 743, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 757, 757, 1244, 0, 520, 0,
+	757, 0, 0, 0, 1108, 1109, 1110, 0, 0, 300,
+	0, 0, 0, 0, 0, 184, 0, 757, 209, 488,
+	487, 497, 498, 490, 491, 492, 493, 494, 495, 496,
+	489, 0, 0, 499, 0, 0, 0, 0, 0, 0,
+	233, 0, 184, 184, 183, 0, 0, 0, 184, 312,
+	0, 0, 0, 0, 1231, 0, 0, 0, 0, 0,
+	0, 0, 312, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 303, 0, 1247, 1248, 1249, 413, 0, 0,
+	0, 0, 0, 1315, 299, 0, 0, 1320, 0, 0,
+	0, 0, 711, 0, 0, 0, 0, 0, 0, 0,
+	0, 1272, 1273, 1274, 1275, 0, 0, 0, 0, 0,
+	0, 0, 195, 1178, 0, 0, 312, 0, 312, 1286,
+	0, 0, 0, 0, 0, 0, 312, 0, 0, 458,
+	462, 1315, 0, 0, 0, 0, 0, 0, 0, 1197,
+	205, 0, 0, 0, 0, 0, 480, 0, 0, 0,
+	312, 1306, 0, 1356, 0, 184, 1311, 184, 0, 0,
+	0, 0, 1319, 184, 0, 0, 0, 0, 0, 0,
+	184, 0, 0, 0, 0, 1324, 0, 441, 0, 0,
+	523, 0, 0, 0, 421, 0, 422, 0, 1331, 534,
+	190, 1334, 429, 0, 0, 1337, 192, 310, 0, 431,
+	1173, 454, 0, 198, 194, 0, 1343, 0, 0, 1345,
+	820, 0, 0, 0, 0, 0, 0, 1091, 0, 0,
+	1237, 0, 0, 0, 0, 0, 0, 0, 909, 0,
+	196, 1358, 1359, 200, 0, 0, 0, 488, 487, 497,
+	498, 490, 491, 492, 493, 494, 495, 496, 489, 0,
+	0, 499, 0, 1267, 0, 0, 0, 0, 965, 967,
+	0, 191, 0, 0, 0, 0, 975, 0, 1091, 0,
+	47, 0, 0, 184, 0, 1284, 1285, 0, 454, 1288,
+	184, 576, 184, 312, 0, 0, 0, 0, 193, 0,
+	201, 202, 203, 204, 208, 0, 0, 0, 711, 207,
+	206, 0, 551, 0, 0, 0, 0, 0, 0, 0,
+	0, 578, 0, 0, 488, 487, 497, 498, 490, 491,
+	492, 493, 494, 495, 496, 489, 1013, 312, 499, 312,
+	0, 0, 0, 0, 0, 0, 0, 483, 0, 486,
+	0, 0, 0, 0, 0, 500, 501, 502, 503, 504,
+	505, 506, 312, 484, 485, 482, 488, 487, 497, 498,
+	490, 491, 492, 493, 494, 495, 496, 489, 0, 0,
+	499, 0, 0, 312, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 701, 702, 1354, 1177, 0, 0, 0,
+	0, 0, 0, 0, 0, 312, 0, 0, 0, 0,
+	184, 184, 0, 0, 184, 0, 0, 184, 0, 0,
+	757, 665, 0, 1094, 975, 0, 757, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 654,
+	655, 820, 184, 658, 1106, 523, 661, 0, 754, 755,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	312, 184, 312, 1122, 0, 0, 0, 0, 0, 0,
+	665, 680, 488, 487, 497, 498, 490, 491, 492, 493,
+	494, 495, 496, 489, 0, 0, 499, 1017, 0, 0,
+	700, 0, 0, 0, 0, 1148, 488, 487, 497, 498,
+	490, 491, 492, 493, 494, 495, 496, 489, 1150, 0,
+	499, 233, 814, 0, 0, 1153, 233, 233, 0, 0,
+	758, 758, 233, 312, 0, 0, 758, 0, 0, 0,
+	0, 1054, 0, 0, 0, 0, 233, 233, 233, 233,
+	0, 184, 0, 758, 184, 184, 184, 184, 184, 0,
+	1174, 0, 0, 1077, 0, 0, 0, 0, 0, 795,
+	0, 0, 184, 0, 0, 0, 576, 0, 0, 0,
+	774, 184, 184, 0, 0, 0, 1193, 0, 757, 312,
+	1196, 0, 1198, 1193, 1193, 1193, 0, 1203, 0, 0,
+	0, 0, 0, 312, 0, 0, 0, 0, 0, 0,
+	0, 806, 0, 0, 893, 894, 0, 462, 820, 0,
+	820, 0, 0, 0, 0, 0, 0, 0, 0, 312,
+	312, 312, 0, 0, 0, 0, 488, 487, 497, 498,
+	490, 491, 492, 493, 494, 495, 496, 489, 0, 184,
+	499, 0, 0, 0, 0, 0, 0, 0, 184, 0,
+	0, 184, 487, 497, 498, 490, 491, 492, 493, 494,
+	495, 496, 489, 1238, 1239, 499, 0, 0, 862, 0,
+	921, 1054, 0, 0, 0, 1057, 665, 886, 0, 0,
+	887, 1122, 0, 0, 0, 0, 938, 0, 233, 0,
+	0, 0, 0, 0, 1193, 488, 487, 497, 498, 490,
+	491, 492, 493, 494, 495, 496, 489, 0, 0, 499,
+	0, 0, 0, 0, 0, 1283, 0, 0, 0, 1287,
+	0, 0, 0, 0, 0, 0, 0, 1195, 0, 0,
+	0, 0, 0, 0, 0, 0, 233, 0, 0, 0,
+	0, 820, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 233, 0, 757, 0, 0, 1312, 0, 0,
+	757, 0, 0, 0, 0, 0, 0, 0, 1017, 820,
+	0, 0, 0, 0, 0, 0, 0, 1326, 916, 0,
+	0, 0, 0, 0, 966, 0, 0, 0, 0, 0,
+	477, 0, 0, 0, 0, 184, 0, 0, 488, 487,
+	497, 498, 490, 491, 492, 493, 494, 495, 496, 489,
+	757, 0, 499, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 523, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1083, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1098, 1099, 0, 0, 1100, 0, 0,
+	1102, 1045, 1046, 0, 0, 0, 0, 0, 0, 184,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	233, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 0, 0, 0, 0, 0, 1049, 0,
+	0, 665, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 758, 0, 0, 0,
+	0, 0, 758, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1155, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 125, 0, 0, 0, 0,
+	0, 0, 0, 0, 93, 0, 0, 0, 0, 107,
+	0, 109, 0, 0, 142, 118, 0, 0, 0, 0,
+	0, 0, 0, 184, 0, 1181, 0, 0, 0, 0,
+	0, 0, 523, 0, 0, 0, 0, 75, 0, 0,
+	0, 0, 0, 0, 0, 184, 86, 0, 0, 0,
+	0, 68, 1144, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 184, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1151, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 71,
+	72, 0, 67, 0, 0, 620, 73, 131, 0, 0,
+	145, 98, 97, 106, 758, 0, 0, 89, 0, 137,
+	127, 157, 576, 128, 136, 110, 149, 132, 156, 69,
+	164, 147, 163, 78, 146, 155, 87, 139, 80, 153,
+	144, 116, 102, 103, 79, 0, 135, 92, 96, 91,
+	124, 150, 151, 90, 171, 83, 162, 82, 84, 161,
+	123, 148, 154, 117, 114, 81, 152, 115, 113, 105,
+	94, 99, 129, 112, 130, 100, 120, 119, 121, 0,
+	0, 0, 143, 159, 172, 608, 0, 165, 166, 167,
+	168, 0, 0, 0, 122, 85, 101, 140, 104, 111,
+	134, 170, 126, 138, 88, 158, 141, 0, 70, 0,
+	1303, 523, 0, 0, 0, 621, 0, 0, 0, 0,
+	0, 0, 0, 0, 77, 0, 108, 169, 133, 95,
+	160, 0, 0, 0, 233, 0, 634, 635, 636, 637,
+	638, 639, 640, 0, 641, 642, 643, 644, 645, 622,
+	623, 624, 625, 606, 607, 0, 0, 609, 0, 610,
+	611, 612, 613, 614, 615, 616, 617, 618, 619, 626,
+	627, 628, 629, 630, 631, 632, 633, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	758, 0, 0, 0, 398, 388, 758, 360, 400, 338,
+	352, 408, 353, 354, 381, 324, 368, 125, 350, 0,
+	341, 319, 347, 320, 339, 362, 93, 365, 337, 390,
+	371, 107, 406, 109, 376, 0, 142, 118, 0, 0,
+	364, 392, 366, 386, 359, 382, 329, 375, 401, 351,
+	379, 402, 0, 0, 0, 0, 758, 0, 0, 75,
+	0, 821, 822, 0, 576, 0, 576, 0, 86, 0,
+	378, 397, 349, 380, 318, 377, 0, 322, 325, 407,
+	395, 344, 345, 987, 0, 0, 0, 0, 0, 0,
+	363, 367, 383, 357, 0, 0, 0, 0, 0, 0,
+	0, 0, 342, 0, 374, 0, 0, 0, 326, 323,
+	0, 361, 0, 0, 0, 328, 0, 343, 384, 0,
+	317, 387, 393, 358, 187, 396, 356, 355, 399, 131,
+	0, 0, 145, 98, 97, 106, 391, 340, 348, 89,
+	346, 137, 127, 157, 373, 128, 136, 110, 149, 132,
+	156, 188, 164, 147, 163, 78, 146, 155, 87, 139,
+	80, 153, 144, 116, 102, 103, 79, 0, 135, 92,
+	96, 91, 124, 150, 151, 90, 171, 83, 162, 82,
+	84, 161, 123, 148, 154, 117, 114, 81, 152, 115,
+	113, 105, 94, 99, 129, 112, 130, 100, 120, 119,
+	121, 0, 321, 0, 143, 159, 172, 336, 394, 165,
+	166, 167, 168, 0, 0, 0, 122, 85, 101, 140,
+	104, 111, 134, 170, 126, 138, 88, 158, 141, 332,
+	335, 330, 331, 369, 370, 403, 404, 405, 385, 327,
+	0, 333, 334, 0, 389, 372, 77, 0, 108, 169,
+	133, 95, 160, 398, 388, 0, 360, 400, 338, 352,
+	408, 353, 354, 381, 324, 368, 125, 350, 0, 341,
+	319, 347, 320, 339, 362, 93, 365, 337, 390, 371,
+	107, 406, 109, 376, 0, 142, 118, 0, 0, 364,
+	392, 366, 386, 359, 382, 329, 375, 401, 351, 379,
+	402, 0, 0, 0, 0, 0, 0, 0, 75, 0,
+	821, 822, 0, 0, 0, 0, 0, 86, 0, 378,
+	397, 349, 380, 318, 377, 0, 322, 325, 407, 395,
+	344, 345, 0, 0, 0, 0, 0, 0, 0, 363,
+	367, 383, 357, 0, 0, 0, 0, 0, 0, 0,
+	0, 342, 0, 374, 0, 0, 0, 326, 323, 0,
+	361, 0, 0, 0, 328, 0, 343, 384, 0, 317,
+	387, 393, 358, 187, 396, 356, 355, 399, 131, 0,
+	0, 145, 98, 97, 106, 391, 340, 348, 89, 346,
+	137, 127, 157, 373, 128, 136, 110, 149, 132, 156,
+	188, 164, 147, 163, 78, 146, 155, 87, 139, 80,
+	153, 144, 116, 102, 103, 79, 0, 135, 92, 96,
+	91, 124, 150, 151, 90, 171, 83, 162, 82, 84,
+	161, 123, 148, 154, 117, 114, 81, 152, 115, 113,
+	105, 94, 99, 129, 112, 130, 100, 120, 119, 121,
+	0, 321, 0, 143, 159, 172, 336, 394, 165, 166,
+	167, 168, 0, 0, 0, 122, 85, 101, 140, 104,
+	111, 134, 170, 126, 138, 88, 158, 141, 332, 335,
+	330, 331, 369, 370, 403, 404, 405, 385, 327, 0,
+	333, 334, 0, 389, 372, 77, 0, 108, 169, 133,
+	95, 160, 398, 388, 0, 360, 400, 338, 352, 408,
+	353, 354, 381, 324, 368, 125, 350, 0, 341, 319,
+	347, 320, 339, 362, 93, 365, 337, 390, 371, 107,
+	406, 109, 376, 0, 142, 118, 0, 0, 364, 392,
+	366, 386, 359, 382, 329, 375, 401, 351, 379, 402,
+	0, 0, 0, 0, 50, 0, 0, 75, 0, 0,
+	0, 0, 0, 0, 0, 0, 86, 0, 378, 397,
+	349, 380, 318, 377, 0, 322, 325, 407, 395, 344,
+	345, 0, 0, 0, 0, 0, 0, 0, 363, 367,
+	383, 357, 0, 0, 0, 0, 0, 0, 0, 0,
+	342, 0, 374, 0, 0, 0, 326, 323, 0, 361,
+	0, 0, 0, 328, 0, 343, 384, 0, 317, 387,
+	393, 358, 187, 396, 356, 355, 399, 131, 0, 0,
+	145, 98, 97, 106, 391, 340, 348, 89, 346, 137,
+	127, 157, 373, 128, 136, 110, 149, 132, 156, 188,
+	164, 147, 163, 78, 146, 155, 87, 139, 80, 153,
+	144, 116, 102, 103, 79, 0, 135, 92, 96, 91,
+	124, 150, 151, 90, 171, 83, 162, 82, 84, 161,
+	123, 148, 154, 117, 114, 81, 152, 115, 113, 105,
+	94, 99, 129, 112, 130, 100, 120, 119, 121, 0,
+	321, 0, 143, 159, 172, 336, 394, 165, 166, 167,
+	168, 0, 0, 0, 122, 85, 101, 140, 104, 111,
+	134, 170, 126, 138, 88, 158, 141, 332, 335, 330,
+	331, 369, 370, 403, 404, 405, 385, 327, 0, 333,
+	334, 0, 389, 372, 77, 0, 108, 169, 133, 95,
+	160, 398, 388, 0, 360, 400, 338, 352, 408, 353,
+	354, 381, 324, 368, 125, 350, 0, 341, 319, 347,
+	320, 339, 362, 93, 365, 337, 390, 371, 107, 406,
+	109, 376, 0, 142, 118, 0, 0, 364, 392, 366,
+	386, 359, 382, 329, 375, 401, 351, 379, 402, 0,
+	0, 0, 0, 0, 0, 0, 75, 0, 0, 0,
+	0, 0, 0, 0, 0, 86, 0, 378, 397, 349,
+	380, 318, 377, 0, 322, 325, 407, 395, 344, 345,
+	0, 0, 0, 0, 0, 0, 0, 363, 367, 383,
+	357, 0, 0, 0, 0, 0, 0, 1053, 0, 342,
+	0, 374, 0, 0, 0, 326, 323, 0, 361, 0,
+	0, 0, 328, 0, 343, 384, 0, 317, 387, 393,
+	358, 187, 396, 356, 355, 399, 131, 0, 0, 145,
+	98, 97, 106, 391, 340, 348, 89, 346, 137, 127,
+	157, 373, 128, 136, 110, 149, 132, 156, 188, 164,
+	147, 163, 78, 146, 155, 87, 139, 80, 153, 144,
+	116, 102, 103, 79, 0, 135, 92, 96, 91, 124,
+	150, 151, 90, 171, 83, 162, 82, 84, 161, 123,
+	148, 154, 117, 114, 81, 152, 115, 113, 105, 94,
+	99, 129, 112, 130, 100, 120, 119, 121, 0, 321,
+	0, 143, 159, 172, 336, 394, 165, 166, 167, 168,
+	0, 0, 0, 122, 85, 101, 140, 104, 111, 134,
+	170, 126, 138, 88, 158, 141, 332, 335, 330, 331,
+	369, 370, 403, 404, 405, 385, 327, 0, 333, 334,
+	0, 389, 372, 77, 0, 108, 169, 133, 95, 160,
+	398, 388, 0, 360, 400, 338, 352, 408, 353, 354,
+	381, 324, 368, 125, 350, 0, 341, 319, 347, 320,
+	339, 362, 93, 365, 337, 390, 371, 107, 406, 109,
+	376, 0, 142, 118, 0, 0, 364, 392, 366, 386,
+	359, 382, 329, 375, 401, 351, 379, 402, 0, 0,
+	0, 0, 0, 0, 0, 238, 0, 0, 0, 0,
+	0, 0, 0, 0, 86, 0, 378, 397, 349, 380,
+	318, 377, 0, 322, 325, 407, 395, 344, 345, 0,
+	0, 0, 0, 0, 0, 0, 363, 367, 383, 357,
+	0, 0, 0, 0, 0, 0, 709, 0, 342, 0,
+	374, 0, 0, 0, 326, 323, 0, 361, 0, 0,
+	0, 328, 0, 343, 384, 0, 317, 387, 393, 358,
+	187, 396, 356, 355, 399, 131, 0, 0, 145, 98,
+	97, 106, 391, 340, 348, 89, 346, 137, 127, 157,
+	373, 128, 136, 110, 149, 132, 156, 188, 164, 147,
+	163, 78, 146, 155, 87, 139, 80, 153, 144, 116,
+	102, 103, 79, 0, 135, 92, 96, 91, 124, 150,
+	151, 90, 171, 83, 162, 82, 84, 161, 123, 148,
+	154, 117, 114, 81, 152, 115, 113, 105, 94, 99,
+	129, 112, 130, 100, 120, 119, 121, 0, 321, 0,
+	143, 159, 172, 336, 394, 165, 166, 167, 168, 0,
+	0, 0, 122, 85, 101, 140, 104, 111, 134, 170,
+	126, 138, 88, 158, 141, 332, 335, 330, 331, 369,
+	370, 403, 404, 405, 385, 327, 0, 333, 334, 0,
+	389, 372, 77, 0, 108, 169, 133, 95, 160, 398,
+	388, 0, 360, 400, 338, 352, 408, 353, 354, 381,
+	324, 368, 125, 350, 0, 341, 319, 347, 320, 339,
+	362, 93, 365, 337, 390, 371, 107, 406, 109, 376,
+	0, 142, 118, 0, 0, 364, 392, 366, 386, 359,
+	382, 329, 375, 401, 351, 379, 402, 0, 0, 0,
+	0, 0, 0, 0, 75, 0, 0, 0, 0, 0,
+	0, 0, 0, 86, 0, 378, 397, 349, 380, 318,
+	377, 0, 322, 325, 407, 395, 344, 345, 0, 0,
+	0, 0, 0, 0, 0, 363, 367, 383, 357, 0,
+	0, 0, 0, 0, 0, 0, 0, 342, 0, 374,
+	0, 0, 0, 326, 323, 0, 361, 0, 0, 0,
+	328, 0, 343, 384, 0, 317, 387, 393, 358, 187,
+	396, 356, 355, 399, 131, 0, 0, 145, 98, 97,
+	106, 391, 340, 348, 89, 346, 137, 127, 157, 373,
+	128, 136, 110, 149, 132, 156, 188, 164, 147, 163,
+	78, 146, 155, 87, 139, 80, 153, 144, 116, 102,
+	103, 79, 0, 135, 92, 96, 91, 124, 150, 151,
+	90, 171, 83, 162, 82, 84, 161, 123, 148, 154,
+	117, 114, 81, 152, 115, 113, 105, 94, 99, 129,
+	112, 130, 100, 120, 119, 121, 0, 321, 0, 143,
+	159, 172, 336, 394, 165, 166, 167, 168, 0, 0,
+	0, 122, 85, 101, 140, 104, 111, 134, 170, 126,
+	138, 88, 158, 141, 332, 335, 330, 331, 369, 370,
+	403, 404, 405, 385, 327, 0, 333, 334, 0, 389,
+	372, 77, 0, 108, 169, 133, 95, 160, 398, 388,
+	0, 360, 400, 338, 352, 408, 353, 354, 381, 324,
+	368, 125, 350, 0, 341, 319, 347, 320, 339, 362,
+	93, 365, 337, 390, 371, 107, 406, 109, 376, 0,
+	142, 118, 0, 0, 364, 392, 366, 386, 359, 382,
+	329, 375, 401, 351, 379, 402, 0, 0, 0, 0,
+	0, 0, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 86, 0, 378, 397, 349, 380, 318, 377,
+	0, 322, 325, 407, 395, 344, 345, 0, 0, 0,
+	0, 0, 0, 0, 363, 367, 383, 357, 0, 0,
+	0, 0, 0, 0, 0, 0, 342, 0, 374, 0,
+	0, 0, 326, 323, 0, 361, 0, 0, 0, 328,
+	0, 343, 384, 0, 317, 387, 393, 358, 187, 396,
+	356, 355, 399, 131, 0, 0, 145, 98, 97, 106,
+	391, 340, 348, 89, 346, 137, 127, 157, 373, 128,
+	136, 110, 149, 132, 156, 188, 164, 147, 163, 78,
+	146, 155, 87, 139, 80, 153, 144, 116, 102, 103,
+	79, 0, 135, 92, 96, 91, 124, 150, 151, 90,
+	171, 83, 162, 82, 84, 161, 123, 148, 154, 117,
+	114, 81, 152, 115, 113, 105, 94, 99, 129, 112,
+	130, 100, 120, 119, 121, 0, 321, 0, 143, 159,
+	172, 336, 394, 165, 166, 167, 168, 0, 0, 0,
+	122, 85, 101, 140, 104, 111, 134, 170, 126, 138,
+	88, 158, 141, 332, 335, 330, 331, 369, 370, 403,
+	404, 405, 385, 327, 0, 333, 334, 0, 389, 372,
+	77, 0, 108, 169, 133, 95, 160, 398, 388, 0,
+	360, 400, 338, 352, 408, 353, 354, 381, 324, 368,
+	125, 350, 0, 341, 319, 347, 320, 339, 362, 93,
+	365, 337, 390, 371, 107, 406, 109, 376, 0, 142,
+	118, 0, 0, 364, 392, 366, 386, 359, 382, 329,
+	375, 401, 351, 379, 402, 0, 0, 0, 0, 0,
+	0, 0, 75, 0, 0, 0, 0, 0, 0, 0,
+	0, 86, 0, 378, 397, 349, 380, 318, 377, 0,
+	322, 325, 407, 395, 344, 345, 0, 0, 0, 0,
+	0, 0, 0, 363, 367, 383, 357, 0, 0, 0,
+	0, 0, 0, 0, 0, 342, 0, 374, 0, 0,
+	0, 326, 323, 0, 361, 0, 0, 0, 328, 0,
+	343, 384, 0, 317, 387, 393, 358, 187, 396, 356,
+	355, 399, 131, 0, 0, 145, 98, 97, 106, 391,
+	340, 348, 89, 346, 137, 127, 157, 373, 128, 136,
+	110, 149, 132, 156, 188, 164, 147, 163, 78, 146,
+	155, 87, 139, 80, 153, 144, 116, 102, 103, 79,
+	0, 135, 92, 96, 91, 124, 150, 151, 90, 171,
+	83, 162, 82, 315, 161, 123, 148, 154, 117, 114,
+	81, 152, 115, 113, 105, 94, 99, 129, 112, 130,
+	100, 120, 119, 121, 0, 321, 0, 143, 159, 172,
+	336, 394, 165, 166, 167, 168, 0, 0, 0, 316,
+	314, 101, 140, 104, 111, 134, 170, 126, 138, 88,
+	158, 141, 332, 335, 330, 331, 369, 370, 403, 404,
+	405, 385, 327, 0, 333, 334, 0, 389, 372, 77,
+	0, 108, 169, 133, 95, 160, 398, 388, 0, 360,
+	400, 338, 352, 408, 353, 354, 381, 324, 368, 125,
+	350, 0, 341, 319, 347, 320, 339, 362, 93, 365,
+	337, 390, 371, 107, 406, 109, 376, 0, 142, 118,
+	0, 0, 364, 392, 366, 386, 359, 382, 329, 375,
+	401, 351, 379, 402, 0, 0, 0, 0, 0, 0,
+	0, 185, 0, 0, 0, 0, 0, 0, 0, 0,
+	86, 0, 378, 397, 349, 380, 318, 377, 0, 322,
+	325, 407, 395, 344, 345, 0, 0, 0, 0, 0,
+	0, 0, 363, 367, 383, 357, 0, 0, 0, 0,
+	0, 0, 0, 0, 342, 0, 374, 0, 0, 0,
+	326, 323, 0, 361, 0, 0, 0, 328, 0, 343,
+	384, 0, 317, 387, 393, 358, 187, 396, 356, 355,
+	399, 131, 0, 0, 145, 98, 97, 106, 391, 340,
+	348, 89, 346, 137, 127, 157, 373, 128, 136, 110,
+	149, 132, 156, 188, 164, 147, 163, 78, 146, 155,
+	87, 139, 80, 153, 144, 116, 102, 103, 79, 0,
+	135, 92, 96, 91, 124, 150, 151, 90, 171, 83,
+	162, 82, 84, 161, 123, 148, 154, 117, 114, 81,
+	152, 115, 113, 105, 94, 99, 129, 112, 130, 100,
+	120, 119, 121, 0, 321, 0, 143, 159, 172, 336,
+	394, 165, 166, 167, 168, 0, 0, 0, 122, 85,
+	101, 140, 104, 111, 134, 170, 126, 138, 88, 158,
+	141, 332, 335, 330, 331, 369, 370, 403, 404, 405,
+	385, 327, 0, 333, 334, 0, 389, 372, 77, 0,
+	108, 169, 133, 95, 160, 398, 388, 0, 360, 400,
+	338, 352, 408, 353, 354, 381, 324, 368, 125, 350,
+	0, 341, 319, 347, 320, 339, 362, 93, 365, 337,
+	390, 371, 107, 406, 109, 376, 0, 142, 118, 0,
+	0, 364, 392, 366, 386, 359, 382, 329, 375, 401,
+	351, 379, 402, 0, 0, 0, 0, 0, 0, 0,
+	75, 0, 0, 0, 0, 0, 0, 0, 0, 86,
+	0, 378, 397, 349, 380, 318, 377, 0, 322, 325,
+	407, 395, 344, 345, 0, 0, 0, 0, 0, 0,
+	0, 363, 367, 383, 357, 0, 0, 0, 0, 0,
+	0, 0, 0, 342, 0, 374, 0, 0, 0, 326,
+	323, 0, 361, 0, 0, 0, 328, 0, 343, 384,
+	0, 317, 387, 393, 358, 187, 396, 356, 355, 399,
+	131, 0, 0, 145, 98, 97, 106, 391, 340, 348,
+	89, 346, 137, 127, 157, 373, 128, 136, 110, 149,
+	132, 156, 188, 164, 147, 163, 78, 146, 586, 87,
+	139, 80, 153, 144, 116, 102, 103, 79, 0, 135,
+	92, 96, 91, 124, 150, 151, 90, 171, 83, 162,
+	82, 315, 161, 123, 148, 154, 117, 114, 81, 152,
+	115, 113, 105, 94, 99, 129, 112, 130, 100, 120,
+	119, 121, 0, 321, 0, 143, 159, 172, 336, 394,
+	165, 166, 167, 168, 0, 0, 0, 316, 314, 101,
+	140, 104, 111, 134, 170, 126, 138, 88, 158, 141,
+	332, 335, 330, 331, 369, 370, 403, 404, 405, 385,
+	327, 0, 333, 334, 0, 389, 372, 77, 0, 108,
+	169, 133, 95, 160, 398, 388, 0, 360, 400, 338,
+	352, 408, 353, 354, 381, 324, 368, 125, 350, 0,
+	341, 319, 347, 320, 339, 362, 93, 365, 337, 390,
+	371, 107, 406, 109, 376, 0, 142, 118, 0, 0,
+	364, 392, 366, 386, 359, 382, 329, 375, 401, 351,
+	379, 402, 0, 0, 0, 0, 0, 0, 0, 75,
+	0, 0, 0, 0, 0, 0, 0, 0, 86, 0,
+	378, 397, 349, 380, 318, 377, 0, 322, 325, 407,
+	395, 344, 345, 0, 0, 0, 0, 0, 0, 0,
+	363, 367, 383, 357, 0, 0, 0, 0, 0, 0,
+	0, 0, 342, 0, 374, 0, 0, 0, 326, 323,
+	0, 361, 0, 0, 0, 328, 0, 343, 384, 0,
+	317, 387, 393, 358, 187, 396, 356, 355, 399, 131,
+	0, 0, 145, 98, 97, 106, 391, 340, 348, 89,
+	346, 137, 127, 157, 373, 128, 136, 110, 149, 132,
+	156, 188, 164, 147, 163, 78, 146, 306, 87, 139,
+	80, 153, 144, 116, 102, 103, 79, 0, 135, 92,
+	96, 91, 124, 150, 151, 90, 171, 83, 162, 82,
+	315, 161, 123, 148, 154, 117, 114, 81, 152, 115,
+	113, 105, 94, 99, 129, 112, 130, 100, 120, 119,
+	121, 0, 321, 0, 143, 159, 172, 336, 394, 165,
+	166, 167, 168, 0, 0, 0, 316, 314, 309, 308,
+	104, 111, 134, 170, 126, 138, 88, 158, 141, 332,
+	335, 330, 331, 369, 370, 403, 404, 405, 385, 327,
+	0, 333, 334, 0, 389, 372, 77, 0, 108, 169,
+	133, 95, 160, 125, 0, 0, 745, 0, 240, 0,
+	0, 0, 93, 0, 237, 0, 0, 107, 279, 109,
+	0, 0, 142, 118, 0, 0, 0, 0, 270, 271,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 50, 0, 0, 238, 258, 257, 260, 261,
+	262, 263, 0, 0, 86, 259, 264, 265, 266, 0,
+	0, 235, 251, 0, 278, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 248, 249, 231, 0, 0, 0,
+	290, 0, 250, 0, 0, 246, 247, 252, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	187, 0, 0, 288, 0, 131, 0, 0, 145, 98,
+	97, 106, 0, 0, 0, 89, 0, 137, 127, 157,
+	0, 128, 136, 110, 149, 132, 156, 188, 164, 147,
+	163, 78, 146, 155, 87, 139, 80, 153, 144, 116,
+	102, 103, 79, 0, 135, 92, 96, 91, 124, 150,
+	151, 90, 171, 83, 162, 82, 84, 161, 123, 148,
+	154, 117, 114, 81, 152, 115, 113, 105, 94, 99,
+	129, 112, 130, 100, 120, 119, 121, 0, 0, 0,
+	143, 159, 172, 0, 0, 165, 166, 167, 168, 0,
+	0, 0, 122, 85, 101, 140, 104, 111, 134, 170,
+	126, 138, 88, 158, 141, 280, 289, 286, 287, 284,
+	285, 283, 282, 281, 291, 272, 273, 274, 275, 277,
+	0, 276, 77, 0, 108, 169, 133, 95, 160, 125,
+	0, 0, 0, 0, 240, 0, 0, 0, 93, 0,
+	237, 0, 0, 107, 279, 109, 0, 0, 142, 118,
+	0, 0, 0, 0, 270, 271, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 50, 0,
+	0, 238, 258, 257, 260, 261, 262, 263, 0, 0,
+	86, 259, 264, 265, 266, 0, 0, 235, 251, 0,
+	278, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	248, 249, 231, 0, 0, 0, 290, 0, 250, 0,
+	0, 246, 247, 252, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 187, 0, 0, 288,
+	0, 131, 0, 0, 145, 98, 97, 106, 0, 0,
+	0, 89, 0, 137, 127, 157, 0, 128, 136, 110,
+	149, 132, 156, 188, 164, 147, 163, 78, 146, 155,
+	87, 139, 80, 153, 144, 116, 102, 103, 79, 0,
+	135, 92, 96, 91, 124, 150, 151, 90, 171, 83,
+	162, 82, 84, 161, 123, 148, 154, 117, 114, 81,
+	152, 115, 113, 105, 94, 99, 129, 112, 130, 100,
+	120, 119, 121, 0, 0, 0, 143, 159, 172, 0,
+	0, 165, 166, 167, 168, 0, 0, 0, 122, 85,
+	101, 140, 104, 111, 134, 170, 126, 138, 88, 158,
+	141, 280, 289, 286, 287, 284, 285, 283, 282, 281,
+	291, 272, 273, 274, 275, 277, 0, 276, 77, 0,
+	108, 169, 133, 95, 160, 125, 0, 0, 0, 0,
+	240, 0, 0, 0, 93, 0, 237, 0, 0, 107,
+	279, 109, 0, 0, 142, 118, 0, 0, 0, 0,
+	270, 271, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 50, 0, 454, 238, 258, 257,
+	260, 261, 262, 263, 0, 0, 86, 259, 264, 265,
+	266, 0, 0, 235, 251, 0, 278, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 248, 249, 0, 0,
+	0, 0, 290, 0, 250, 0, 0, 246, 247, 252,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 187, 0, 0, 288, 0, 131, 0, 0,
+	145, 98, 97, 106, 0, 0, 0, 89, 0, 137,
+	127, 157, 0, 128, 136, 110, 149, 132, 156, 188,
+	164, 147, 163, 78, 146, 155, 87, 139, 80, 153,
+	144, 116, 102, 103, 79, 0, 135, 92, 96, 91,
+	124, 150, 151, 90, 171, 83, 162, 82, 84, 161,
+	123, 148, 154, 117, 114, 81, 152, 115, 113, 105,
+	94, 99, 129, 112, 130, 100, 120, 119, 121, 0,
+	0, 0, 143, 159, 172, 0, 0, 165, 166, 167,
+	168, 0, 0, 0, 122, 85, 101, 140, 104, 111,
+	134, 170, 126, 138, 88, 158, 141, 280, 289, 286,
+	287, 284, 285, 283, 282, 281, 291, 272, 273, 274,
+	275, 277, 0, 276, 77, 0, 108, 169, 133, 95,
+	160, 125, 0, 0, 0, 0, 240, 0, 0, 0,
+	93, 0, 237, 0, 0, 107, 279, 109, 0, 0,
+	142, 118, 0, 0, 0, 0, 270, 271, 0, 0,
+	0, 0, 0, 0, 813, 0, 0, 0, 0, 0,
+	50, 0, 0, 238, 258, 257, 260, 261, 262, 263,
+	0, 0, 86, 259, 264, 265, 266, 0, 0, 235,
+	251, 0, 278, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 248, 249, 0, 0, 0, 0, 290, 0,
+	250, 0, 0, 246, 247, 252, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 187, 0,
+	0, 288, 0, 131, 0, 0, 145, 98, 97, 106,
+	0, 0, 0, 89, 0, 137, 127, 157, 0, 128,
+	136, 110, 149, 132, 156, 188, 164, 147, 163, 78,
+	146, 155, 87, 139, 80, 153, 144, 116, 102, 103,
+	79, 0, 135, 92, 96, 91, 124, 150, 151, 90,
+	171, 83, 162, 82, 84, 161, 123, 148, 154, 117,
+	114, 81, 152, 115, 113, 105, 94, 99, 129, 112,
+	130, 100, 120, 119, 121, 0, 0, 0, 143, 159,
+	172, 0, 0, 165, 166, 167, 168, 0, 0, 0,
+	122, 85, 101, 140, 104, 111, 134, 170, 126, 138,
+	88, 158, 141, 280, 289, 286, 287, 284, 285, 283,
+	282, 281, 291, 272, 273, 274, 275, 277, 23, 276,
+	77, 0, 108, 169, 133, 95, 160, 0, 0, 0,
+	125, 0, 0, 0, 0, 240, 0, 0, 0, 93,
+	0, 237, 0, 0, 107, 279, 109, 0, 0, 142,
+	118, 0, 0, 0, 0, 270, 271, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 50,
+	0, 0, 238, 258, 257, 260, 261, 262, 263, 0,
+	0, 86, 259, 264, 265, 266, 0, 0, 235, 251,
+	0, 278, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 248, 249, 0, 0, 0, 0, 290, 0, 250,
+	0, 0, 246, 247, 252, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 187, 0, 0,
+	288, 0, 131, 0, 0, 145, 98, 97, 106, 0,
+	0, 0, 89, 0, 137, 127, 157, 0, 128, 136,
+	110, 149, 132, 156, 188, 164, 147, 163, 78, 146,
+	155, 87, 139, 80, 153, 144, 116, 102, 103, 79,
+	0, 135, 92, 96, 91, 124, 150, 151, 90, 171,
+	83, 162, 82, 84, 161, 123, 148, 154, 117, 114,
+	81, 152, 115, 113, 105, 94, 99, 129, 112, 130,
+	100, 120, 119, 121, 0, 0, 0, 143, 159, 172,
+	0, 0, 165, 166, 167, 168, 0, 0, 0, 122,
+	85, 101, 140, 104, 111, 134, 170, 126, 138, 88,
+	158, 141, 280, 289, 286, 287, 284, 285, 283, 282,
+	281, 291, 272, 273, 274, 275, 277, 0, 276, 77,
+	0, 108, 169, 133, 95, 160, 125, 0, 0, 0,
+	0, 240, 0, 0, 0, 93, 0, 237, 0, 0,
+	107, 279, 109, 0, 0, 142, 118, 0, 0, 0,
+	0, 270, 271, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 50, 0, 0, 238, 258,
+	257, 260, 261, 262, 263, 0, 0, 86, 259, 264,
+	265, 266, 0, 0, 235, 251, 0, 278, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 248, 249, 0,
+	0, 0, 0, 290, 0, 250, 0, 0, 246, 247,
+	252, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 187, 0, 0, 288, 0, 131, 0,
+	0, 145, 98, 97, 106, 0, 0, 0, 89, 0,
+	137, 127, 157, 0, 128, 136, 110, 149, 132, 156,
+	188, 164, 147, 163, 78, 146, 155, 87, 139, 80,
+	153, 144, 116, 102, 103, 79, 0, 135, 92, 96,
+	91, 124, 150, 151, 90, 171, 83, 162, 82, 84,
+	161, 123, 148, 154, 117, 114, 81, 152, 115, 113,
+	105, 94, 99, 129, 112, 130, 100, 120, 119, 121,
+	0, 0, 0, 143, 159, 172, 0, 0, 165, 166,
+	167, 168, 0, 0, 0, 122, 85, 101, 140, 104,
+	111, 134, 170, 126, 138, 88, 158, 141, 280, 289,
+	286, 287, 284, 285, 283, 282, 281, 291, 272, 273,
+	274, 275, 277, 125, 276, 77, 0, 108, 169, 133,
+	95, 160, 93, 0, 0, 0, 0, 107, 279, 109,
+	0, 0, 142, 118, 0, 0, 0, 0, 270, 271,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 50, 0, 0, 238, 258, 257, 260, 261,
+	262, 263, 0, 0, 86, 259, 264, 265, 266, 0,
+	0, 0, 251, 0, 278, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 248, 249, 0, 0, 0, 0,
+	290, 0, 250, 0, 0, 246, 247, 252, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	187, 0, 0, 288, 0, 131, 0, 0, 145, 98,
+	97, 106, 0, 0, 0, 89, 0, 137, 127, 157,
+	1357, 128, 136, 110, 149, 132, 156, 188, 164, 147,
+	163, 78, 146, 155, 87, 139, 80, 153, 144, 116,
+	102, 103, 79, 0, 135, 92, 96, 91, 124, 150,
+	151, 90, 171, 83, 162, 82, 84, 161, 123, 148,
+	154, 117, 114, 81, 152, 115, 113, 105, 94, 99,
+	129, 112, 130, 100, 120, 119, 121, 0, 0, 0,
+	143, 159, 172, 0, 0, 165, 166, 167, 168, 0,
+	0, 0, 122, 85, 101, 140, 104, 111, 134, 170,
+	126, 138, 88, 158, 141, 280, 289, 286, 287, 284,
+	285, 283, 282, 281, 291, 272, 273, 274, 275, 277,
+	125, 276, 77, 0, 108, 169, 133, 95, 160, 93,
+	0, 0, 0, 0, 107, 279, 109, 0, 0, 142,
+	118, 0, 0, 0, 0, 270, 271, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 50,
+	0, 0, 238, 258, 257, 260, 261, 262, 263, 0,
+	0, 86, 259, 264, 265, 266, 0, 0, 0, 251,
+	0, 278, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 248, 249, 0, 0, 0, 0, 290, 0, 250,
+	0, 0, 246, 247, 252, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 187, 0, 0,
+	288, 0, 131, 0, 0, 145, 98, 97, 106, 0,
+	0, 0, 89, 0, 137, 127, 157, 0, 128, 136,
+	110, 149, 132, 156, 188, 164, 147, 163, 78, 146,
+	155, 87, 139, 80, 153, 144, 116, 102, 103, 79,
+	0, 135, 92, 96, 91, 124, 150, 151, 90, 171,
+	83, 162, 82, 84, 161, 123, 148, 154, 117, 114,
+	81, 152, 115, 113, 105, 94, 99, 129, 112, 130,
+	100, 120, 119, 121, 0, 0, 0, 143, 159, 172,
+	0, 0, 165, 166, 167, 168, 0, 0, 0, 122,
+	85, 101, 140, 104, 111, 134, 170, 126, 138, 88,
+	158, 141, 280, 289, 286, 287, 284, 285, 283, 282,
+	281, 291, 272, 273, 274, 275, 277, 0, 276, 77,
+	0, 108, 169, 133, 95, 160, 125, 0, 0, 0,
+	476, 0, 0, 0, 0, 93, 0, 0, 0, 0,
+	107, 0, 109, 0, 0, 142, 118, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 75, 0,
+	478, 0, 0, 0, 0, 0, 0, 86, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 488, 487, 497, 498, 490, 491, 492,
+	493, 494, 495, 496, 489, 0, 0, 499, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 187, 0, 0, 0, 0, 131, 0,
+	0, 145, 98, 97, 106, 0, 0, 0, 89, 0,
+	137, 127, 157, 0, 128, 136, 110, 149, 132, 156,
+	188, 164, 147, 163, 78, 146, 155, 87, 139, 80,
+	153, 144, 116, 102, 103, 79, 0, 135, 92, 96,
+	91, 124, 150, 151, 90, 171, 83, 162, 82, 84,
+	161, 123, 148, 154, 117, 114, 81, 152, 115, 113,
+	105, 94, 99, 129, 112, 130, 100, 120, 119, 121,
+	0, 0, 0, 143, 159, 172, 0, 0, 165, 166,
+	167, 168, 0, 0, 0, 122, 85, 101, 140, 104,
+	111, 134, 170, 126, 138, 88, 158, 141, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 125, 0, 77, 0, 108, 169, 133,
+	95, 160, 93, 0, 0, 0, 0, 107, 0, 109,
+	0, 0, 142, 118, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 454, 75, 0, 0, 0, 0,
+	0, 0, 0, 0, 86, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	488, 487, 497, 498, 490, 491, 492, 493, 494, 495,
+	496, 489, 0, 0, 499, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	187, 0, 0, 0, 0, 131, 0, 0, 145, 98,
+	97, 106, 0, 0, 0, 89, 0, 137, 127, 157,
+	0, 128, 136, 110, 149, 132, 156, 188, 164, 147,
+	163, 78, 146, 155, 87, 139, 80, 153, 144, 116,
+	102, 103, 79, 0, 135, 92, 96, 91, 124, 150,
+	151, 90, 171, 83, 162, 82, 84, 161, 123, 148,
+	154, 117, 114, 81, 152, 115, 113, 105, 94, 99,
+	129, 112, 130, 100, 120, 119, 121, 0, 0, 0,
+	143, 159, 172, 0, 0, 165, 166, 167, 168, 0,
+	0, 0, 122, 85, 101, 140, 104, 111, 134, 170,
+	126, 138, 88, 158, 141, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	125, 0, 77, 0, 108, 169, 133, 95, 160, 93,
+	0, 0, 0, 0, 107, 0, 109, 0, 0, 142,
+	118, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 75, 0, 0, 0, 0, 0, 0, 0,
+	0, 86, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 488, 487, 497,
+	498, 490, 491, 492, 493, 494, 495, 496, 489, 0,
+	0, 499, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 187, 0, 0,
+	0, 0, 131, 0, 0, 145, 98, 97, 106, 0,
+	0, 0, 89, 0, 137, 127, 157, 0, 128, 136,
+	110, 149, 132, 156, 188, 164, 147, 163, 78, 146,
+	155, 87, 139, 80, 153, 144, 116, 102, 103, 79,
+	0, 135, 92, 96, 91, 124, 150, 151, 90, 171,
+	83, 162, 82, 84, 161, 123, 148, 154, 117, 114,
+	81, 152, 115, 113, 105, 94, 99, 129, 112, 130,
+	100, 120, 119, 121, 0, 0, 0, 143, 159, 172,
+	0, 0, 165, 166, 167, 168, 0, 0, 0, 122,
+	85, 101, 140, 104, 111, 134, 170, 126, 138, 88,
+	158, 141, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 77,
+	0, 108, 169, 133, 95, 160, 125, 0, 0, 0,
+	476, 0, 0, 0, 0, 93, 0, 0, 0, 0,
+	107, 0, 109, 0, 0, 142, 118, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 75, 0,
+	478, 0, 0, 0, 0, 0, 0, 86, 0, 0,
+	0, 0, 473, 472, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 474,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 187, 0, 0, 0, 0, 131, 0,
+	0, 145, 98, 97, 106, 0, 0, 0, 89, 0,
+	137, 127, 157, 0, 128, 136, 110, 149, 132, 156,
+	188, 164, 147, 163, 78, 146, 155, 87, 139, 80,
+	153, 144, 116, 102, 103, 79, 0, 135, 92, 96,
+	91, 124, 150, 151, 90, 171, 83, 162, 82, 84,
+	161, 123, 148, 154, 117, 114, 81, 152, 115, 113,
+	105, 94, 99, 129, 112, 130, 100, 120, 119, 121,
+	0, 0, 0, 143, 159, 172, 0, 0, 165, 166,
+	167, 168, 0, 0, 0, 122, 85, 101, 140, 104,
+	111, 134, 170, 126, 138, 88, 158, 141, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 77, 0, 108, 169, 133,
+	95, 160, 125, 0, 0, 0, 575, 0, 0, 0,
+	0, 93, 0, 0, 0, 0, 107, 0, 109, 0,
+	0, 142, 118, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 185, 0, 577, 0, 0, 0,
+	0, 0, 0, 86, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
+	0, 0, 0, 0, 131, 0, 0, 145, 98, 97,
+	106, 0, 0, 0, 89, 0, 137, 127, 157, 0,
+	128, 136, 110, 149, 132, 156, 188, 164, 147, 163,
+	78, 146, 155, 87, 139, 80, 153, 144, 116, 102,
+	103, 79, 0, 135, 92, 96, 91, 124, 150, 151,
+	90, 171, 83, 162, 82, 84, 161, 123, 148, 154,
+	117, 114, 81, 152, 115, 113, 105, 94, 99, 129,
+	112, 130, 100, 120, 119, 121, 0, 0, 0, 143,
+	159, 172, 0, 0, 165, 166, 167, 168, 0, 0,
+	0, 122, 85, 101, 140, 104, 111, 134, 170, 126,
+	138, 88, 158, 141, 0, 0, 0, 23, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 125,
+	0, 77, 0, 108, 169, 133, 95, 160, 93, 0,
+	0, 0, 0, 107, 0, 109, 0, 0, 142, 118,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 50, 0,
+	0, 75, 0, 0, 0, 0, 0, 0, 0, 0,
+	86, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 187, 0, 0, 0,
+	0, 131, 0, 0, 145, 98, 97, 106, 0, 0,
+	0, 89, 0, 137, 127, 157, 0, 128, 136, 110,
+	149, 132, 156, 188, 164, 147, 163, 78, 146, 155,
+	87, 139, 80, 153, 144, 116, 102, 103, 79, 0,
+	135, 92, 96, 91, 124, 150, 151, 90, 171, 83,
+	162, 82, 84, 161, 123, 148, 154, 117, 114, 81,
+	152, 115, 113, 105, 94, 99, 129, 112, 130, 100,
+	120, 119, 121, 0, 0, 0, 143, 159, 172, 0,
+	0, 165, 166, 167, 168, 0, 0, 0, 122, 85,
+	101, 140, 104, 111, 134, 170, 126, 138, 88, 158,
+	141, 0, 0, 0, 23, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 125, 0, 77, 0,
+	108, 169, 133, 95, 160, 93, 0, 0, 0, 0,
+	107, 0, 109, 0, 0, 142, 118, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 50, 0, 0, 185, 0,
+	0, 0, 0, 0, 0, 0, 0, 86, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 187, 0, 0, 0, 0, 131, 0,
+	0, 145, 98, 97, 106, 0, 0, 0, 89, 0,
+	137, 127, 157, 0, 128, 136, 110, 149, 132, 156,
+	188, 164, 147, 163, 78, 146, 155, 87, 139, 80,
+	153, 144, 116, 102, 103, 79, 0, 135, 92, 96,
+	91, 124, 150, 151, 90, 171, 83, 162, 82, 84,
+	161, 123, 148, 154, 117, 114, 81, 152, 115, 113,
+	105, 94, 99, 129, 112, 130, 100, 120, 119, 121,
+	0, 0, 0, 143, 159, 172, 0, 0, 165, 166,
+	167, 168, 0, 0, 0, 122, 85, 101, 140, 104,
+	111, 134, 170, 126, 138, 88, 158, 141, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 125, 0, 77, 0, 108, 169, 133,
+	95, 160, 93, 0, 0, 0, 0, 107, 0, 109,
+	0, 0, 142, 118, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 75, 0, 0, 695, 0,
+	0, 696, 0, 0, 86, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	187, 0, 0, 0, 0, 131, 0, 0, 145, 98,
+	97, 106, 0, 0, 0, 89, 0, 137, 127, 157,
+	0, 128, 136, 110, 149, 132, 156, 188, 164, 147,
+	163, 78, 146, 155, 87, 139, 80, 153, 144, 116,
+	102, 103, 79, 0, 135, 92, 96, 91, 124, 150,
+	151, 90, 171, 83, 162, 82, 84, 161, 123, 148,
+	154, 117, 114, 81, 152, 115, 113, 105, 94, 99,
+	129, 112, 130, 100, 120, 119, 121, 0, 0, 0,
+	143, 159, 172, 0, 0, 165, 166, 167, 168, 0,
+	0, 0, 122, 85, 101, 140, 104, 111, 134, 170,
+	126, 138, 88, 158, 141, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	125, 0, 77, 0, 108, 169, 133, 95, 160, 93,
+	0, 595, 0, 0, 107, 0, 109, 0, 0, 142,
+	118, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 75, 0, 594, 0, 0, 0, 0, 0,
+	0, 86, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 187, 0, 0,
+	0, 0, 131, 0, 0, 145, 98, 97, 106, 0,
+	0, 0, 89, 0, 137, 127, 157, 0, 128, 136,
+	110, 149, 132, 156, 188, 164, 147, 163, 78, 146,
+	155, 87, 139, 80, 153, 144, 116, 102, 103, 79,
+	0, 135, 92, 96, 91, 124, 150, 151, 90, 171,
+	83, 162, 82, 84, 161, 123, 148, 154, 117, 114,
+	81, 152, 115, 113, 105, 94, 99, 129, 112, 130,
+	100, 120, 119, 121, 0, 0, 0, 143, 159, 172,
+	0, 0, 165, 166, 167, 168, 0, 0, 0, 122,
+	85, 101, 140, 104, 111, 134, 170, 126, 138, 88,
+	158, 141, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 77,
+	0, 108, 169, 133, 95, 160, 125, 0, 0, 0,
+	575, 0, 0, 0, 0, 93, 0, 0, 0, 0,
+	107, 0, 109, 0, 0, 142, 118, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 185, 0,
+	577, 0, 0, 0, 0, 0, 0, 86, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 187, 0, 0, 0, 0, 131, 0,
+	0, 145, 98, 97, 106, 0, 0, 0, 89, 0,
+	137, 127, 157, 0, 573, 136, 110, 149, 132, 156,
+	188, 164, 147, 163, 78, 146, 155, 87, 139, 80,
+	153, 144, 116, 102, 103, 79, 0, 135, 92, 96,
+	91, 124, 150, 151, 90, 171, 83, 162, 82, 84,
+	161, 123, 148, 154, 117, 114, 81, 152, 115, 113,
+	105, 94, 99, 129, 112, 130, 100, 120, 119, 121,
+	0, 0, 0, 143, 159, 172, 0, 0, 165, 166,
+	167, 168, 0, 0, 0, 122, 85, 101, 140, 104,
+	111, 134, 170, 126, 138, 88, 158, 141, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 125, 0, 77, 0, 108, 169, 133,
+	95, 160, 93, 0, 0, 0, 0, 107, 0, 109,
+	0, 0, 142, 118, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 50, 0, 0, 185, 0, 0, 0, 0,
+	0, 0, 0, 0, 86, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	187, 0, 0, 0, 0, 131, 0, 0, 145, 98,
+	97, 106, 0, 0, 0, 89, 0, 137, 127, 157,
+	0, 128, 136, 110, 149, 132, 156, 188, 164, 147,
+	163, 78, 146, 155, 87, 139, 80, 153, 144, 116,
+	102, 103, 79, 0, 135, 92, 96, 91, 124, 150,
+	151, 90, 171, 83, 162, 82, 84, 161, 123, 148,
+	154, 117, 114, 81, 152, 115, 113, 105, 94, 99,
+	129, 112, 130, 100, 120, 119, 121, 0, 0, 0,
+	143, 159, 172, 0, 0, 165, 166, 167, 168, 0,
+	0, 0, 122, 85, 101, 140, 104, 111, 134, 170,
+	126, 138, 88, 158, 141, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	125, 0, 77, 0, 108, 169, 133, 95, 160, 93,
+	0, 0, 0, 0, 107, 0, 109, 0, 0, 142,
+	118, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 185, 0, 577, 0, 0, 0, 0, 0,
+	0, 86, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 187, 0, 0,
+	0, 0, 131, 0, 0, 145, 98, 97, 106, 0,
+	0, 0, 89, 0, 137, 127, 157, 0, 128, 136,
+	110, 149, 132, 156, 188, 164, 147, 163, 78, 146,
+	155, 87, 139, 80, 153, 144, 116, 102, 103, 79,
+	0, 135, 92, 96, 91, 124, 150, 151, 90, 171,
+	83, 162, 82, 84, 161, 123, 148, 154, 117, 114,
+	81, 152, 115, 113, 105, 94, 99, 129, 112, 130,
+	100, 120, 119, 121, 0, 0, 0, 143, 159, 172,
+	0, 0, 165, 166, 167, 168, 0, 0, 0, 122,
+	85, 101, 140, 104, 111, 134, 170, 126, 138, 88,
+	158, 141, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 125, 0, 77,
+	0, 108, 169, 133, 95, 160, 93, 0, 0, 0,
+	0, 107, 0, 109, 0, 0, 142, 118, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 75,
+	0, 478, 0, 0, 0, 0, 0, 0, 86, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 187, 0, 0, 0, 0, 131,
+	0, 0, 145, 98, 97, 106, 0, 0, 0, 89,
+	0, 137, 127, 157, 0, 128, 136, 110, 149, 132,
+	156, 188, 164, 147, 163, 78, 146, 155, 87, 139,
+	80, 153, 144, 116, 102, 103, 79, 0, 135, 92,
+	96, 91, 124, 150, 151, 90, 171, 83, 162, 82,
+	84, 161, 123, 148, 154, 117, 114, 81, 152, 115,
+	113, 105, 94, 99, 129, 112, 130, 100, 120, 119,
+	121, 0, 0, 0, 143, 159, 172, 0, 0, 165,
+	166, 167, 168, 0, 0, 0, 122, 85, 101, 140,
+	104, 111, 134, 170, 126, 138, 88, 158, 141, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 125, 77, 0, 108, 169,
+	133, 95, 160, 550, 93, 0, 0, 0, 0, 107,
+	0, 109, 0, 0, 142, 118, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 185, 0, 0,
+	0, 0, 0, 0, 0, 0, 86, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 187, 0, 0, 0, 0, 131, 0, 0,
+	145, 98, 97, 106, 0, 0, 0, 89, 0, 137,
+	127, 157, 0, 128, 136, 110, 149, 132, 156, 188,
+	164, 147, 163, 78, 146, 155, 87, 139, 80, 153,
+	144, 116, 102, 103, 79, 0, 135, 92, 96, 91,
+	124, 150, 151, 90, 171, 83, 162, 82, 84, 161,
+	123, 148, 154, 117, 114, 81, 152, 115, 113, 105,
+	94, 99, 129, 112, 130, 100, 120, 119, 121, 0,
+	0, 0, 143, 159, 172, 0, 0, 165, 166, 167,
+	168, 0, 0, 0, 122, 85, 101, 140, 104, 111,
+	134, 170, 126, 138, 88, 158, 141, 0, 0, 0,
+	0, 0, 0, 0, 0, 301, 0, 0, 0, 0,
+	0, 0, 125, 0, 77, 0, 108, 169, 133, 95,
+	160, 93, 0, 0, 0, 0, 107, 0, 109, 0,
+	0, 142, 118, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 185, 0, 0, 0, 0, 0,
+	0, 0, 0, 86, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
+	0, 0, 0, 0, 131, 0, 0, 145, 98, 97,
+	106, 0, 0, 0, 89, 0, 137, 127, 157, 0,
+	128, 136, 110, 149, 132, 156, 188, 164, 147, 163,
+	78, 146, 155, 87, 139, 80, 153, 144, 116, 102,
+	103, 79, 0, 135, 92, 96, 91, 124, 150, 151,
+	90, 171, 83, 162, 82, 84, 161, 123, 148, 154,
+	117, 114, 81, 152, 115, 113, 105, 94, 99, 129,
+	112, 130, 100, 120, 119, 121, 0, 0, 0, 143,
+	159, 172, 0, 0, 165, 166, 167, 168, 0, 0,
+	0, 122, 85, 101, 140, 104, 111, 134, 170, 126,
+	138, 88, 158, 141, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 125,
+	0, 77, 0, 108, 169, 133, 95, 160, 93, 0,
+	0, 0, 0, 107, 0, 109, 0, 0, 142, 118,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 185, 0, 0, 0, 0, 0, 0, 0, 0,
+	86, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 182, 0, 187, 0, 0, 0,
+	0, 131, 0, 0, 145, 98, 97, 106, 0, 0,
+	0, 89, 0, 137, 127, 157, 0, 128, 136, 110,
+	149, 132, 156, 188, 164, 147, 163, 78, 146, 155,
+	87, 139, 80, 153, 144, 116, 102, 103, 79, 0,
+	135, 92, 96, 91, 124, 150, 151, 90, 171, 83,
+	162, 82, 84, 161, 123, 148, 154, 117, 114, 81,
+	152, 115, 113, 105, 94, 99, 129, 112, 130, 100,
+	120, 119, 121, 0, 0, 0, 143, 159, 172, 0,
+	0, 165, 166, 167, 168, 0, 0, 0, 122, 85,
+	101, 140, 104, 111, 134, 170, 126, 138, 88, 158,
+	141, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 125, 0, 77, 0,
+	108, 169, 133, 95, 160, 93, 0, 0, 0, 0,
+	107, 0, 109, 0, 0, 142, 118, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 238, 0,
+	0, 0, 0, 0, 0, 0, 0, 86, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 187, 0, 0, 0, 0, 131, 0,
+	0, 145, 98, 97, 106, 0, 0, 0, 89, 0,
+	137, 127, 157, 0, 128, 136, 110, 149, 132, 156,
+	188, 164, 147, 163, 78, 146, 155, 87, 139, 80,
+	153, 144, 116, 102, 103, 79, 0, 135, 92, 96,
+	91, 124, 150, 151, 90, 171, 83, 162, 82, 84,
+	161, 123, 148, 154, 117, 114, 81, 152, 115, 113,
+	105, 94, 99, 129, 112, 130, 100, 120, 119, 121,
+	0, 0, 0, 143, 159, 172, 0, 0, 165, 166,
+	167, 168, 0, 0, 0, 122, 85, 101, 140, 104,
+	111, 134, 170, 126, 138, 88, 158, 141, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 125, 0, 77, 0, 108, 169, 133,
+	95, 160, 93, 0, 0, 0, 0, 107, 0, 109,
+	0, 0, 142, 118, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 75, 0, 0, 0, 0,
+	0, 0, 0, 0, 86, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	187, 0, 0, 0, 0, 131, 0, 0, 145, 98,
+	97, 106, 0, 0, 0, 89, 0, 137, 127, 157,
+	0, 128, 136, 110, 149, 132, 156, 188, 164, 147,
+	163, 78, 146, 155, 87, 139, 80, 153, 144, 116,
+	102, 103, 79, 0, 135, 92, 96, 91, 124, 150,
+	151, 90, 171, 83, 162, 82, 84, 161, 123, 148,
+	154, 117, 114, 81, 152, 115, 113, 105, 94, 99,
+	129, 112, 130, 100, 120, 119, 121, 0, 0, 0,
+	143, 159, 172, 0, 0, 165, 166, 167, 168, 0,
+	0, 0, 122, 85, 101, 140, 104, 111, 134, 170,
+	126, 138, 88, 158, 141, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	125, 0, 77, 0, 108, 169, 133, 95, 160, 93,
+	0, 0, 0, 0, 107, 0, 109, 0, 0, 142,
+	118, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 185, 0, 0, 0, 0, 0, 0, 0,
+	0, 86, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 187, 0, 0,
+	0, 0, 131, 0, 0, 145, 98, 97, 106, 0,
+	0, 0, 89, 0, 137, 127, 157, 0, 128, 136,
+	110, 149, 132, 156, 188, 164, 147, 163, 78, 146,
+	155, 87, 139, 80, 153, 144, 116, 102, 103, 79,
+	0, 135, 92, 96, 91, 124, 150, 151, 90, 171,
+	83, 162, 82, 84, 161, 123, 148, 154, 117, 114,
+	81, 152, 115, 113, 105, 94, 99, 129, 112, 130,
+	100, 120, 119, 121, 0, 0, 0, 143, 159, 172,
+	0, 0, 165, 166, 167, 168, 0, 0, 0, 122,
+	85, 101, 140, 104, 111, 134, 170, 126, 138, 88,
+	158, 141, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 77,
+	0, 108, 169, 133, 95, 160,
+}
+
+var yyPact = [...]int16{
+	269, -1000, -167, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 851, 881, -1000, -1000, -1000, -1000, -1000, -1000, 694,
+	2357, 87, 109, 5, 10701, 108, 1490, 11382, -1000, 26,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 610, -1000, -1000,
+	-1000, -1000, -1000, 837, 845, 680, 826, 772, -1000, 5631,
+	80, 9565, 10474, 5159, -1000, 524, 100, 11382, -138, 11155,
+	73, 73, 73, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 103, 11382, -1000, 11382, 70, 523, 70,
+	70, 70, 11382, -1000, 144, -1000, -1000, -1000, -1000, 11382,
+	513, 799, 77, 3167, 3167, 3167, 3167, 32, 3167, -71,
+	739, -1000, -1000, -1000, -1000, 3167, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 392, 808, 6578, 6578,
+	851, -1000, 610, -1000, -1000, -1000, 800, -1000, -1000, 276,
+	858, -1000, 7958, 143, -1000, 6578, 1671, 619, -1000, -1000,
+	619, -1000, -1000, 120, -1000, -1000, 7032, 7032, 7032, 7032,
+	7032, 7032, 7032, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 619, -1000, 6342,
+	619, 619, 619, 619, 619, 619, 619, 619, 6578, 619,
+	619, 619, 619, 619, 619, 619, 619, 619, 619, 619,
+	619, 619, 10247, 711, 124, 708, -1000, -1000, 823, 8648,
+	9338, 11382, 598, -1000, 559, 4910, -87, -1000, -1000, -1000,
+	207, 9102, -1000, -1000, -1000, 798, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 519,
+	-1000, 2455, 511, 3167, 91, 722, 510, 235, 508, 11382,
+	11382, 3167, 78, 11382, 820, 738, 11382, 493, 490, -1000,
+	4661, -1000, 3167, 3167, 3167, 3167, 3167, 3167, 3167, 3167,
+	-1000, -1000, -1000, -1000, -1000, -1000, 3167, 3167, -1000, -36,
+	-1000, 11382, -1000, -1000, -1000, -1000, 876, 176, 391, 142,
+	601, -1000, 371, 837, 392, 772, 8875, 749, 737, -1000,
+	11382, -1000, 6578, 6578, 397, -1000, 10019, -1000, -1000, 3665,
+	146, 7032, 323, 237, 7032, 7032, 7032, 7032, 7032, 7032,
+	7032, 7032, 7032, 7032, 7032, 7032, 7032, 7032, 7032, 418,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 452, -1000,
+	610, 693, 693, 152, 152, 152, 152, 152, 152, 7722,
+	5395, 392, 477, 328, 6342, 5631, 5631, 6578, 6578, 10928,
+	10928, 5631, 829, 230, 328, 10928, -1000, 392, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 5631, 5631, 5631, 5631, 47,
+	11382, -1000, 10928, 9565, 9565, 9565, 9565, 9565, -1000, 768,
+	767, -1000, 758, 752, 751, 102, 619, 619, 11382, -1000,
+	506, 8648, 180, 619, -1000, 9792, -1000, -1000, 47, 550,
+	9565, 11382, -1000, -1000, 4412, 559, -87, 553, -1000, -80,
+	-85, 6103, 116, -1000, -1000, -1000, -1000, 2918, 268, 284,
+	-32, -1000, -1000, -1000, 622, -1000, 622, 622, 622, 622,
+	-3, -3, -3, -3, -1000, -1000, -1000, -1000, -1000, 691,
+	687, -1000, 622, 622, 622, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 683, 683, 683, 625, 625, 728, -1000, 11382, -156,
+	449, 3167, 818, 3167, -1000, 92, -1000, 11382, -1000, -1000,
+	11382, 3167, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 254, -1000, -1000,
+	-1000, -1000, 783, 6578, 6578, 4163, 6578, -1000, -1000, -1000,
+	808, -1000, 828, 841, -1000, 792, 791, 5631, -1000, 619,
+	-1000, 146, 319, -1000, -1000, 403, -1000, -1000, -1000, -1000,
+	132, 619, -1000, 1801, -1000, -1000, -1000, -1000, 323, 7032,
+	7032, 7032, 1061, 1801, 2103, 273, 1956, 152, 383, 383,
+	154, 154, 154, 154, 154, 521, 521, -1000, -1000, -1000,
+	392, -1000, -1000, -1000, 392, 5631, 557, -1000, -1000, 6578,
+	-1000, 392, 504, 504, 341, 263, 623, -1000, 131, 608,
+	504, 5631, -1000, 279, -1000, 6578, 392, -1000, 504, 392,
+	504, 504, 654, 619, -1000, 727, -1000, 198, 124, 719,
+	724, 112, 708, -1000, -1000, -1000, 762, -1000, 760, -1000,
+	759, -1000, -1000, 10928, 11155, -1000, -1000, 633, 99, 97,
+	95, 11155, -1000, 856, 9565, 663, -1000, -1000, 553, -87,
+	-88, -1000, -1000, -1000, 328, -1000, 431, 552, 2669, -1000,
+	-1000, -1000, -1000, -1000, -1000, 679, 809, 189, 192, 421,
+	-1000, -1000, 801, -1000, 272, -45, -1000, -1000, 355, -3,
+	-3, -1000, -1000, 116, 796, 116, 116, 116, 382, 382,
+	-1000, -1000, -1000, -1000, 354, -1000, -1000, -1000, 342, -1000,
+	736, 11155, 3167, -1000, 3914, -1000, -1000, -1000, -1000, -1000,
+	-1000, 539, 190, 226, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 46, -1000, 3167, -1000, 257,
+	11382, 11382, 780, 328, 328, 130, -1000, -1000, 11382, -1000,
+	-1000, -1000, -1000, 589, 6578, -1000, -1000, -1000, 3416, 5631,
+	-1000, 1061, 1801, 2000, -1000, 7032, 7032, -1000, -1000, 504,
+	5631, 328, -1000, -1000, -1000, 75, 418, 75, 7032, 7032,
+	4163, 7032, 7032, -150, 597, 200, -1000, 6578, 260, -1000,
+	-1000, -1000, -1000, -1000, 735, 10928, 619, -1000, 8421, 11155,
+	851, 10928, 6578, 6578, -1000, -1000, 6578, 635, -1000, 6578,
+	-1000, -1000, -1000, -1000, 834, 619, 129, 832, -1000, 11155,
+	7032, 619, 619, 619, 463, -1000, 851, 663, -1000, -1000,
+	-1000, -92, -124, -1000, -1000, 2918, -1000, 2918, 11155, -1000,
+	419, 412, -1000, -1000, 733, 62, -1000, -1000, -1000, 460,
+	116, 116, -1000, 199, -1000, -1000, -1000, 502, -1000, 487,
+	548, 482, 11382, -1000, -1000, 546, -1000, 188, -1000, -1000,
+	11155, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 11155, 11382, -1000, -1000, -1000, -1000, -1000,
+	11155, -1000, -1000, 379, 6578, -1000, -1000, -1000, 3914, -1000,
+	856, 9565, 477, -1000, -1000, 392, -1000, 7032, 1801, 1801,
+	-1000, -1000, 392, 622, 622, -1000, 622, 625, -1000, 622,
+	15, 622, 13, 392, 392, 1552, 1931, -1000, 1334, 1777,
+	619, -145, -1000, 328, 6578, -1000, 813, 528, 489, -1000,
+	-1000, 5867, 392, 475, 128, 463, 837, -1000, 328, 328,
+	328, 11155, 328, 10928, 3914, 11155, 619, 7722, 11155, 11155,
+	11155, 8194, 11155, 837, -1000, -1000, -1000, -1000, 2669, -1000,
+	459, -1000, 622, -1000, -1000, -33, 864, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -3, 368,
+	-3, 337, -1000, 299, 3167, 3914, 2918, -1000, 620, -1000,
+	-1000, -1000, -1000, 810, -1000, 328, 854, 545, 749, -1000,
+	1801, -1000, -1000, 122, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 7032, 7032, -1000, 7032, 7032, 7032, 392,
+	361, 328, 806, -1000, 619, -1000, -1000, 607, 11155, 11155,
+	-1000, -1000, 456, -1000, 174, 619, 172, 7032, -120, 448,
+	448, 448, 180, -1000, -1000, 153, 11155, -1000, 175, -1000,
+	-128, 116, -1000, 116, 444, 438, -1000, -1000, -1000, 11155,
+	619, 843, 842, 5631, -1000, -1000, 1629, 1629, 1629, 1629,
+	14, -1000, -1000, 863, -1000, 619, -1000, 610, 127, -1000,
+	11155, -1000, 619, 619, 7495, -1000, 619, -1000, -1000, -1000,
+	633, 153, -1000, 344, 187, 329, -1000, 271, 804, -1000,
+	803, -1000, -1000, -1000, -1000, -1000, 443, 45, -1000, 6578,
+	6578, 589, -1000, -1000, -1000, -1000, 392, 50, -159, 10928,
+	489, 392, 11155, -1000, 7032, 10928, -120, 392, 7032, -1000,
+	-1000, -1000, 290, -1000, -1000, -1000, 285, -1000, -1000, 722,
+	437, -1000, 11155, 328, 479, 856, -1000, 776, -153, -163,
+	465, -1000, -1000, 435, -1000, 7268, 405, -1000, -1000, -120,
+	1629, -1000, -1000, -156, -1000, 45, 790, 854, -1000, 741,
+	-1000, 392, 7032, -1000, 392, 10928, -1000, -1000, -1000, -1000,
+	41, 843, -157, 8194, -1000, 8194, -1000, 39, -1000, -161,
+	-1000, -1000, 619, -164, 6805, -1000, 1629, 392, -1000, -1000,
+}
+
+var yyPgo = [...]int16{
+	0, 1077, 23, 760, 1076, 1074, 1067, 1066, 1065, 1064,
+	1063, 1062, 1061, 1050, 1048, 1047, 1046, 1044, 1043, 1042,
+	1040, 1039, 1033, 1032, 122, 1031, 1030, 1028, 74, 22,
+	76, 1027, 1026, 46, 48, 54, 45, 1320, 12, 37,
+	78, 73, 1024, 51, 1019, 1015, 82, 1012, 67, 1011,
+	1008, 1419, 1007, 1006, 21, 9, 1005, 1004, 1003, 1002,
+	80, 208, 1001, 995, 994, 50, 993, 991, 57, 11,
+	18, 16, 25, 985, 33, 13, 984, 53, 982, 981,
+	3, 1, 41, 978, 60, 977, 36, 59, 2, 975,
+	974, 14, 7, 973, 19, 72, 40, 27, 10, 77,
+	66, 968, 28, 61, 49, 962, 961, 450, 960, 957,
+	955, 952, 951, 948, 176, 381, 947, 946, 945, 943,
+	52, 0, 874, 1022, 75, 8, 942, 941, 1390, 79,
+	69, 4, 940, 39, 91, 42, 939, 937, 43, 936,
+	935, 934, 933, 932, 931, 927, 158, 926, 925, 924,
+	30, 56, 923, 922, 65, 32, 921, 920, 919, 47,
+	58, 918, 55, 915, 914, 913, 912, 34, 29, 911,
+	20, 908, 15, 907, 906, 6, 901, 26, 898, 5,
+	896, 17, 44, 895, 894, 442, 284, 893, 887, 81,
+}
+
+var yyR1 = [...]uint8{
+	0, 183, 184, 184, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 2, 2, 2, 6, 3, 3, 4, 4,
+	5, 5, 7, 7, 27, 27, 8, 9, 9, 9,
+	187, 187, 46, 46, 95, 95, 10, 10, 10, 10,
+	100, 100, 104, 104, 104, 105, 105, 105, 105, 136,
+	136, 11, 11, 11, 11, 11, 11, 11, 181, 181,
+	180, 179, 179, 178, 178, 177, 16, 164, 165, 165,
+	165, 160, 139, 139, 139, 139, 142, 142, 140, 140,
+	140, 140, 140, 140, 140, 141, 141, 141, 141, 141,
+	143, 143, 143, 143, 143, 144, 144, 144, 144, 144,
+	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
+	145, 145, 145, 145, 145, 145, 145, 145, 159, 159,
+	146, 146, 154, 154, 155, 155, 155, 152, 152, 153,
+	153, 156, 156, 156, 147, 147, 147, 147, 147, 147,
+	147, 149, 149, 157, 157, 150, 150, 150, 151, 151,
+	158, 158, 158, 158, 158, 148, 148, 161, 161, 173,
+	173, 172, 172, 172, 163, 163, 169, 169, 169, 169,
+	169, 162, 162, 171, 171, 170, 166, 166, 166, 167,
+	167, 167, 168, 168, 168, 12, 12, 12, 12, 12,
+	12, 12, 12, 12, 182, 182, 182, 182, 182, 182,
+	182, 182, 182, 182, 182, 176, 174, 174, 175, 175,
+	13, 14, 14, 14, 14, 14, 15, 15, 17, 18,
+	18, 18, 18, 18, 18, 18, 18, 18, 18, 18,
+	18, 18, 18, 18, 18, 18, 18, 18, 18, 18,
+	18, 18, 112, 112, 109, 109, 110, 110, 111, 111,
+	111, 113, 113, 113, 137, 137, 137, 19, 19, 21,
+	21, 22, 23, 20, 20, 20, 20, 20, 188, 24,
+	25, 25, 26, 26, 26, 30, 30, 30, 28, 28,
+	29, 29, 35, 35, 34, 34, 36, 36, 36, 36,
+	125, 125, 125, 124, 124, 38, 38, 39, 39, 40,
+	40, 41, 41, 41, 41, 41, 89, 89, 88, 90,
+	90, 53, 53, 91, 91, 91, 91, 92, 92, 94,
+	94, 96, 96, 42, 42, 42, 42, 43, 43, 44,
+	44, 45, 45, 132, 132, 131, 131, 131, 130, 130,
+	47, 47, 47, 49, 48, 48, 48, 48, 48, 48,
+	50, 50, 52, 52, 51, 51, 54, 54, 54, 54,
+	55, 55, 37, 37, 37, 37, 37, 37, 37, 108,
+	108, 57, 57, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 67, 67, 67, 67, 67, 67, 58,
+	58, 58, 58, 58, 58, 58, 33, 33, 68, 68,
+	68, 74, 69, 69, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 65, 65, 65, 63, 63,
+	63, 63, 63, 63, 63, 63, 63, 63, 63, 63,
+	63, 63, 63, 64, 64, 64, 64, 64, 64, 64,
+	64, 189, 189, 66, 66, 66, 66, 31, 31, 31,
+	31, 31, 135, 135, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 78, 78, 32,
+	32, 76, 76, 77, 79, 79, 75, 75, 75, 60,
+	60, 60, 60, 60, 60, 60, 60, 62, 62, 62,
+	80, 80, 81, 81, 82, 82, 83, 83, 84, 85,
+	85, 85, 86, 86, 86, 86, 87, 87, 87, 59,
+	59, 59, 59, 59, 59, 93, 93, 93, 93, 97,
+	97, 70, 70, 72, 72, 71, 73, 98, 98, 102,
+	99, 99, 103, 103, 103, 101, 101, 101, 127, 127,
+	127, 106, 106, 114, 114, 115, 115, 107, 107, 116,
+	116, 116, 116, 116, 116, 116, 116, 116, 116, 117,
+	117, 117, 118, 118, 119, 119, 119, 126, 126, 122,
+	122, 123, 123, 128, 128, 129, 129, 120, 120, 120,
+	120, 120, 120, 120, 120, 120, 120, 120, 120, 120,
+	120, 120, 120, 120, 120, 120, 120, 120, 120, 120,
+	120, 120, 120, 120, 120, 120, 120, 120, 120, 120,
+	120, 120, 120, 120, 120, 120, 120, 120, 120, 120,
+	120, 120, 120, 120, 120, 120, 120, 120, 120, 120,
+	120, 120, 120, 120, 120, 120, 120, 120, 120, 120,
+	120, 120, 120, 120, 120, 120, 120, 120, 120, 120,
+	120, 120, 120, 120, 120, 120, 120, 120, 120, 120,
+	120, 120, 120, 120, 120, 120, 120, 120, 120, 121,
+	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
+	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
+	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
+	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
+	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
+	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
+	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
+	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
+	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
+	121, 121, 121, 121, 121, 121, 121, 185, 186, 133,
+	134, 134, 134,
+}
+
+var yyR2 = [...]int8{
+	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 4, 6, 7, 5, 10, 14, 1, 3,
+	1, 3, 7, 8, 1, 1, 8, 8, 7, 6,
+	1, 1, 1, 3, 0, 4, 3, 4, 5, 4,
+	1, 3, 3, 2, 2, 2, 2, 2, 1, 1,
+	1, 2, 8, 4, 6, 5, 5, 5, 0, 2,
+	1, 0, 2, 1, 3, 3, 4, 4, 1, 3,
+	3, 8, 3, 1, 1, 1, 2, 1, 1, 1,
+	1, 1, 1, 1, 1, 2, 2, 2, 2, 2,
+	1, 2, 2, 2, 1, 4, 4, 2, 2, 3,
+	3, 3, 3, 1, 1, 1, 1, 1, 6, 6,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	0, 3, 0, 5, 0, 3, 5, 0, 1, 0,
+	1, 0, 1, 2, 0, 2, 2, 2, 2, 2,
+	2, 0, 3, 0, 1, 0, 3, 3, 0, 2,
+	0, 2, 1, 2, 1, 0, 2, 5, 4, 1,
+	2, 2, 3, 2, 0, 1, 2, 3, 3, 2,
+	2, 1, 1, 1, 3, 2, 0, 1, 3, 1,
+	2, 3, 1, 1, 1, 6, 7, 7, 12, 7,
+	7, 7, 4, 5, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 7, 1, 3, 8, 8,
+	5, 4, 6, 5, 4, 4, 3, 2, 3, 4,
+	4, 4, 4, 4, 4, 4, 4, 3, 3, 3,
+	3, 4, 3, 6, 4, 2, 4, 2, 2, 2,
+	2, 3, 1, 1, 0, 1, 0, 1, 0, 2,
+	2, 0, 2, 2, 0, 1, 1, 2, 1, 1,
+	2, 1, 1, 2, 2, 2, 2, 2, 0, 2,
+	0, 2, 1, 2, 2, 0, 1, 1, 0, 1,
+	0, 1, 0, 1, 1, 3, 1, 2, 3, 5,
+	0, 1, 2, 1, 1, 0, 2, 1, 3, 1,
+	1, 1, 3, 3, 12, 12, 1, 3, 2, 1,
+	3, 4, 8, 0, 6, 7, 4, 0, 4, 1,
+	3, 1, 3, 4, 4, 4, 3, 2, 4, 0,
+	1, 0, 2, 0, 1, 0, 1, 2, 1, 1,
+	1, 2, 2, 1, 2, 3, 2, 3, 2, 3,
+	2, 2, 2, 1, 1, 3, 0, 5, 5, 5,
+	0, 2, 1, 3, 3, 2, 3, 1, 2, 0,
+	3, 1, 1, 3, 3, 4, 4, 5, 3, 4,
+	5, 6, 2, 1, 2, 1, 2, 1, 2, 1,
+	1, 1, 1, 1, 1, 1, 0, 2, 1, 1,
+	1, 3, 1, 3, 1, 1, 1, 1, 1, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 2, 2, 2, 2, 2, 2,
+	3, 1, 1, 1, 1, 4, 5, 6, 4, 4,
+	6, 6, 6, 6, 8, 8, 6, 8, 8, 9,
+	7, 5, 4, 2, 2, 2, 2, 2, 2, 2,
+	2, 0, 2, 4, 4, 4, 4, 0, 3, 4,
+	7, 3, 1, 1, 2, 3, 3, 1, 2, 2,
+	1, 2, 1, 2, 2, 1, 2, 0, 1, 0,
+	2, 1, 2, 4, 0, 2, 1, 3, 5, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 2, 2,
+	0, 3, 0, 2, 0, 3, 1, 3, 2, 0,
+	1, 1, 0, 2, 4, 4, 0, 2, 4, 2,
+	1, 3, 5, 4, 6, 1, 3, 3, 5, 0,
+	5, 1, 3, 1, 2, 3, 1, 1, 3, 3,
+	1, 3, 3, 3, 3, 1, 2, 1, 1, 1,
+	1, 1, 1, 0, 2, 0, 3, 0, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 0,
+	1, 1, 1, 1, 0, 1, 1, 0, 2, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 0,
+	0, 1, 1,
+}
+
+var yyChk = [...]int16{
+	-1000, -183, -1, -2, -6, -7, -8, -9, -10, -11,
+	-12, -13, -14, -15, -17, -18, -19, -21, -22, -23,
+	-20, -3, -4, 6, 7, -27, 9, 10, 30, -16,
+	116, 117, 119, 118, 144, 120, 137, 49, 156, 157,
+	159, 160, 25, 138, 139, 142, 143, -185, 8, 239,
+	57, -184, 254, -82, 15, -26, 5, -24, -188, -24,
+	-24, -24, -24, -24, -164, 57, -119, 125, 74, 152,
+	231, 122, 123, 129, -122, 60, -121, 247, 156, 167,
+	161, 188, 180, 178, 181, 218, 69, 159, 227, 140,
+	176, 172, 170, 27, 193, 252, 171, 135, 134, 194,
+	198, 219, 165, 166, 221, 192, 136, 32, 249, 34,
+	148, 222, 196, 191, 187, 190, 164, 186, 38, 200,
+	199, 201, 217, 183, 173, 18, 225, 143, 146, 195,
+	197, 130, 150, 251, 223, 169, 147, 142, 226, 160,
+	220, 229, 37, 205, 163, 133, 157, 154, 184, 149,
+	174, 175, 189, 162, 185, 158, 151, 144, 228, 206,
+	253, 182, 179, 155, 153, 210, 211, 212, 213, 250,
+	224, 177, 207, -107, 125, 127, 123, 123, 124, 125,
+	231, 122, 123, -51, -128, 60, -121, 125, 152, 123,
+	110, 181, 116, 208, 124, 32, 150, -137, 123, -109,
+	153, 210, 211, 212, 213, 60, 220, 219, 214, -128,
+	158, -133, -133, -133, -133, -133, -2, -86, 17, 16,
+	-5, -3, -185, 6, 20, 21, -30, 39, 40, -25,
+	-36, 101, -37, -128, -56, 76, -61, 29, 60, -121,
+	23, -60, -57, -75, -73, -74, 110, 111, 99, 100,
+	107, 77, 112, -65, -63, -64, -66, 62, 61, 70,
+	63, 64, 65, 66, 71, 72, 73, -122, -71, -185,
+	43, 44, 240, 241, 242, 243, 246, 244, 79, 33,
+	230, 238, 237, 236, 234, 235, 232, 233, 128, 231,
+	105, 239, -107, -39, -40, -41, -42, -53, -74, -185,
+	-51, 11, -46, -51, -99, -136, 158, -103, 220, 219,
+	-123, -101, -122, -120, 218, 181, 217, 121, 75, 22,
+	24, 203, 78, 110, 16, 79, 109, 240, 116, 47,
+	232, 233, 230, 242, 243, 231, 208, 29, 10, 25,
+	138, 21, 103, 118, 82, 83, 141, 23, 139, 73,
+	19, 50, 11, 13, 14, 128, 127, 94, 124, 45,
+	8, 112, 26, 91, 41, 28, 43, 92, 17, 234,
+	235, 31, 246, 145, 105, 48, 35, 76, 71, 51,
+	74, 15, 46, 93, 119, 239, 44, 122, 6, 245,
+	30, 137, 42, 123, 209, 81, 126, 72, 5, 129,
+	9, 49, 52, 236, 237, 238, 33, 80, 12, -165,
+	-160, 60, 124, -51, 239, -122, -115, 128, -115, -115,
+	123, -51, -51, -114, 128, 60, -114, -114, -114, -51,
+	113, -51, 60, 30, 231, 60, 150, 123, 151, 125,
+	-134, -185, -123, -134, -134, -134, 154, 155, -134, -110,
+	215, 51, -134, -186, 59, -87, 19, 31, -37, -128,
+	-83, -84, -37, -82, -2, -24, 35, -28, 21, 68,
+	11, -125, 75, 74, 91, -124, 22, -122, 62, 113,
+	-37, -58, 94, 76, 92, 93, 78, 96, 95, 106,
+	99, 100, 101, 102, 103, 104, 105, 97, 98, 109,
+	84, 85, 86, 87, 88, 89, 90, -108, -185, -74,
+	-185, 114, 115, -61, -61, -61, -61, -61, -61, -61,
+	-185, -2, -69, -37, -185, -185, -185, -185, -185, -185,
+	-185, -185, -185, -78, -37, -185, -189, -185, -189, -189,
+	-189, -189, -189, -189, -189, -185, -185, -185, -185, -52,
+	26, -51, 30, 58, -47, -49, -48, -50, 41, 45,
+	47, 42, 43, 44, 215, 48, 53, 54, -132, 22,
+	-39, -185, -131, 146, -130, 22, -128, 62, -51, -46,
+	-187, 58, 11, 52, 58, -99, 158, -100, -104, 221,
+	223, 84, -127, -122, 62, 29, 30, 59, 58, -139,
+	-142, -144, -143, -145, -140, -141, 178, 179, 110, 182,
+	184, 185, 186, 187, 188, 189, 190, 191, 192, 193,
+	30, 140, 174, 175, 176, 177, 194, 195, 196, 197,
+	198, 199, 200, 201, 161, 162, 163, 164, 165, 166,
+	167, 169, 170, 171, 172, 173, 60, -134, 125, -181,
+	52, 60, 76, 60, -51, -51, -134, 126, -51, 23,
+	51, -51, 60, 60, -129, -128, -120, -134, -134, -134,
+	-134, -134, -134, -134, -134, -134, -134, -112, 209, 216,
+	-51, 9, 94, 58, 18, 113, 58, -85, 24, 25,
+	-86, -186, -30, -62, -122, 63, 66, -29, 42, 51,
+	-51, -37, -37, -67, 71, 76, 72, 73, -124, 101,
+	-129, -123, -120, -61, -68, -71, -74, 67, 94, 92,
+	93, 78, -61, -61, -61, -61, -61, -61, -61, -61,
+	-61, -61, -61, -61, -61, -61, -61, -135, 60, 62,
+	60, -60, -60, -122, -35, 21, -34, -36, -186, 58,
+	-186, -2, -34, -34, -37, -37, -75, -122, -128, -75,
+	-34, -28, 21, -76, -77, 80, -75, -186, -34, -35,
+	-34, -34, -95, 146, -51, -98, -102, -75, -40, -41,
+	-41, -40, -41, 41, 41, 41, 46, 41, 46, 41,
+	46, 41, -48, -185, -185, -128, -186, -54, 49, 127,
+	50, -185, -130, -95, 52, -39, -51, -103, -100, 58,
+	222, 224, 225, 51, -37, -151, 109, -166, -167, -168,
+	-123, 62, 63, -160, -161, -169, 130, 133, 129, -162,
+	124, 28, -156, 71, 76, -152, 206, -146, 57, -146,
+	-146, -146, -146, -150, 181, -150, -150, -150, 57, 57,
+	-146, -146, -146, -154, 57, -154, -154, -155, 57, -155,
+	-126, 52, -51, -179, 250, -180, 60, -134, 23, -134,
+	-116, 121, 118, 119, -176, 117, 203, 181, 69, 29,
+	15, 240, 146, 253, 60, 147, -51, -51, -134, -111,
+	11, 94, 37, -37, -37, -129, -84, -87, -106, 19,
+	11, 33, 33, -34, -185, 71, 72, 73, 113, -185,
+	-68, -61, -61, -61, -33, 141, 75, -186, -186, -34,
+	58, -37, -186, -186, -186, 58, 52, 22, 58, 11,
+	113, 58, 11, -186, -34, -79, -77, 82, -37, -186,
+	-186, -186, -186, -186, -59, 30, 33, -2, -185, -185,
+	-55, 58, 12, 84, -44, -43, 51, 52, -45, 51,
+	-43, 41, 41, 41, -65, -122, -128, -122, -91, 55,
+	56, 124, 124, 124, -96, -122, -55, -39, -55, -104,
+	-105, 226, 223, 229, 60, 58, -168, 84, 57, 28,
+	-162, -162, 60, 60, -147, 29, 71, -153, 207, 63,
+	-150, -150, -151, 30, -151, -151, -151, -159, 62, -159,
+	63, 63, 51, -122, -134, -178, -177, -123, -133, -182,
+	152, 131, 132, 135, 134, 60, 124, 28, 130, 133,
+	146, 129, -182, 152, -117, -118, 126, 22, 124, 28,
+	146, -134, -113, 92, 12, -128, -128, 38, 113, -51,
+	-38, 11, -69, 101, -123, -35, -33, 75, -61, -61,
+	-186, -36, -138, 110, 178, 140, 176, 172, 192, 183,
+	205, 174, 206, -135, -138, -61, -61, -123, -61, -61,
+	247, -82, 83, -37, 81, -97, 51, -98, -70, -72,
+	-71, -185, -2, -93, -122, -96, -82, -102, -37, -37,
+	-37, 57, -37, 19, 113, 19, -122, -61, -185, -185,
+	-185, -186, 58, -82, -55, 223, 227, 228, -167, -168,
+	-171, -170, -122, 60, 60, -149, 51, 62, 63, 64,
+	71, 230, 70, 59, -151, -151, 60, 110, 59, 58,
+	59, 58, 59, 58, -51, 58, 84, -133, -122, -133,
+	-122, -51, -133, -122, 62, -37, -55, -39, -186, -186,
+	-61, -186, -146, -146, -146, -155, -146, 166, -146, 166,
+	-186, -186, -186, 58, 19, -186, 58, 19, -185, -32,
+	245, -37, 27, -97, 58, -186, -186, -186, 58, 113,
+	-186, -86, -94, -122, -75, -123, -122, -185, -122, -94,
+	-94, -94, -131, -122, -86, 59, 58, -146, -157, 203,
+	9, -150, 62, -150, 63, 63, -134, -177, -168, 57,
+	26, -80, 13, -29, -150, 60, -61, -61, -61, -61,
+	-61, -186, 62, 28, -72, 33, -2, -185, -122, -122,
+	58, 59, 94, 94, -61, -92, 226, -186, -186, -186,
+	-54, -173, -172, 52, 136, 69, -170, -158, 130, 28,
+	129, 230, -151, -151, 59, 59, -94, -185, -81, 14,
+	16, -34, -186, -186, -186, -186, -31, 94, 250, 9,
+	-70, -2, 113, -122, -185, -185, -186, -122, -185, -91,
+	-172, 60, -163, 84, 62, -148, 69, 28, 28, 59,
+	-174, -175, 146, -37, -69, -38, -186, 248, 48, 251,
+	-98, -186, -122, -89, -88, -61, -90, -75, -92, -186,
+	-61, 63, 62, -181, -186, 58, -122, -55, 38, 249,
+	252, -186, 58, -125, -186, 58, -92, -186, -179, -175,
+	33, -80, 38, -186, -88, -186, -75, 148, -81, 250,
+	-131, -131, 149, 251, -185, 252, -61, 145, -186, -186,
+}
+
+var yyDef = [...]int16{
+	0, -2, 2, -2, 5, 6, 7, 8, 9, 10,
+	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
+	21, 524, 0, 278, 278, 278, 278, 278, 278, 0,
+	594, 577, 0, 0, 0, 0, -2, 268, 269, 0,
+	271, 272, 799, 799, 799, 799, 799, 0, 34, 35,
+	797, 1, 3, 532, 0, 0, 282, 285, 280, 0,
+	577, 0, 0, 0, 61, 0, 0, 786, 0, 787,
+	575, 575, 575, 595, 596, 599, 600, 699, 700, 701,
+	702, 703, 704, 705, 706, 707, 708, 709, 710, 711,
+	712, 713, 714, 715, 716, 717, 718, 719, 720, 721,
+	722, 723, 724, 725, 726, 727, 728, 729, 730, 731,
+	732, 733, 734, 735, 736, 737, 738, 739, 740, 741,
+	742, 743, 744, 745, 746, 747, 748, 749, 750, 751,
+	752, 753, 754, 755, 756, 757, 758, 759, 760, 761,
+	762, 763, 764, 765, 766, 767, 768, 769, 770, 771,
+	772, 773, 774, 775, 776, 777, 778, 779, 780, 781,
+	782, 783, 784, 785, 788, 789, 790, 791, 792, 793,
+	794, 795, 796, 0, 0, 578, 0, 573, 0, 573,
+	573, 573, 0, 227, 364, 603, 604, 786, 787, 0,
+	0, 0, 0, 800, 800, 800, 800, 0, 800, 256,
+	245, 247, 248, 249, 250, 800, 265, 266, 255, 267,
+	270, 273, 274, 275, 276, 277, 28, 536, 0, 0,
+	524, 30, 0, 278, 283, 284, 288, 286, 287, 279,
+	0, 296, 300, 0, 372, 0, 377, 379, -2, -2,
+	0, 414, 415, 416, 417, 418, 0, 0, 0, 0,
+	0, 0, 0, 441, 442, 443, 444, 509, 510, 511,
+	512, 513, 514, 515, 516, 381, 382, 506, 556, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 497, 0,
+	471, 471, 471, 471, 471, 471, 471, 471, 0, 0,
+	0, 0, 0, 0, 307, 309, 310, 311, 343, 0,
+	345, 0, 0, 42, 46, 0, 777, 560, -2, -2,
+	0, 0, 601, 602, -2, 706, -2, 607, 608, 609,
+	610, 611, 612, 613, 614, 615, 616, 617, 618, 619,
+	620, 621, 622, 623, 624, 625, 626, 627, 628, 629,
+	630, 631, 632, 633, 634, 635, 636, 637, 638, 639,
+	640, 641, 642, 643, 644, 645, 646, 647, 648, 649,
+	650, 651, 652, 653, 654, 655, 656, 657, 658, 659,
+	660, 661, 662, 663, 664, 665, 666, 667, 668, 669,
+	670, 671, 672, 673, 674, 675, 676, 677, 678, 679,
+	680, 681, 682, 683, 684, 685, 686, 687, 688, 689,
+	690, 691, 692, 693, 694, 695, 696, 697, 698, 0,
+	78, 0, 0, 800, 0, 68, 0, 0, 0, 0,
+	0, 800, 0, 0, 0, 0, 0, 0, 0, 226,
+	0, 228, 800, 800, 800, 800, 800, 800, 800, 800,
+	237, 801, 802, 238, 239, 240, 800, 800, 242, 0,
+	257, 0, 251, 29, 798, 22, 0, 0, 533, 0,
+	525, 526, 529, 532, 28, 285, 0, 290, 289, 281,
+	0, 297, 0, 0, 0, 301, 0, 303, 304, 0,
+	375, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	399, 400, 401, 402, 403, 404, 405, 378, 0, 392,
+	0, 0, 0, 434, 435, 436, 437, 438, 439, 0,
+	292, 28, 0, 412, 0, 0, 0, 0, 0, 0,
+	0, 0, 288, 0, 498, 0, 463, 0, 464, 465,
+	466, 467, 468, 469, 470, 0, 292, 0, 0, 44,
+	0, 363, 0, 0, 0, 0, 0, 0, 350, 0,
+	0, 353, 0, 0, 0, 0, 0, 0, 0, 344,
+	0, 0, 366, 750, 346, 0, 348, 349, -2, 0,
+	0, 0, 40, 41, 0, 47, 777, 49, 50, 0,
+	0, 0, 158, 568, 569, 570, 566, 186, 0, 141,
+	137, 83, 84, 85, 130, 87, 130, 130, 130, 130,
+	155, 155, 155, 155, 113, 114, 115, 116, 117, 0,
+	0, 100, 130, 130, 130, 104, 120, 121, 122, 123,
+	124, 125, 126, 127, 88, 89, 90, 91, 92, 93,
+	94, 132, 132, 132, 134, 134, 597, 63, 0, 71,
+	0, 800, 0, 800, 76, 0, 202, 0, 221, 574,
+	0, 800, 224, 225, 365, 605, 606, 229, 230, 231,
+	232, 233, 234, 235, 236, 241, 244, 258, 252, 253,
+	246, 537, 0, 0, 0, 0, 0, 528, 530, 531,
+	536, 31, 288, 0, 517, 0, 0, 0, 291, 0,
+	25, 373, 374, 376, 393, 0, 395, 397, 302, 298,
+	0, 507, -2, 383, 384, 408, 409, 410, 0, 0,
+	0, 0, 406, 388, 0, 419, 420, 421, 422, 423,
+	424, 425, 426, 427, 428, 429, 430, 433, 482, 483,
+	0, 431, 432, 440, 0, 0, 293, 294, 411, 0,
+	555, 28, 0, 0, 0, 0, 0, 506, 0, 0,
+	0, 0, 289, 504, 501, 0, 0, 472, 0, 0,
+	0, 0, 0, 0, 362, 370, 557, 0, 308, 339,
+	341, 0, 336, 351, 352, 354, 0, 356, 0, 358,
+	0, 360, 361, 0, 0, 312, 313, 323, 0, 0,
+	0, 0, 347, 370, 0, 370, 43, 561, 48, 0,
+	0, 53, 54, 562, 563, 564, 0, 77, 187, 189,
+	192, 193, 194, 79, 80, 0, 0, 0, 0, 0,
+	181, 182, 144, 142, 0, 139, 138, 86, 0, 155,
+	155, 107, 108, 158, 0, 158, 158, 158, 0, 0,
+	101, 102, 103, 95, 0, 96, 97, 98, 0, 99,
+	0, 0, 800, 65, 0, 69, 70, 66, 576, 67,
+	799, 0, 0, 589, 203, 579, 580, 581, 582, 583,
+	584, 585, 586, 587, 588, 0, 220, 800, 223, 261,
+	0, 0, 0, 534, 535, 0, 527, 23, 0, 571,
+	572, 518, 519, 305, 0, 394, 396, 398, 0, 292,
+	385, 406, 389, 0, 386, 0, 0, 380, 445, 0,
+	0, 413, -2, 448, 449, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 524, 0, 502, 0, 0, 462,
+	473, 474, 475, 476, 549, 0, 0, -2, 0, 0,
+	524, 0, 0, 0, 333, 340, 0, 0, 334, 0,
+	335, 355, 357, 359, 0, 0, 0, 0, 321, 0,
+	0, 0, 0, 0, 0, 331, 524, 370, 39, 51,
+	52, 0, 0, 58, 159, 0, 190, 0, 0, 176,
+	0, 0, 179, 180, 151, 0, 143, 82, 140, 0,
+	158, 158, 109, 0, 110, 111, 112, 0, 128, 0,
+	0, 0, 0, 598, 64, 72, 73, 0, 195, 799,
+	0, 204, 205, 206, 207, 208, 209, 210, 211, 212,
+	213, 214, 799, 0, 0, 799, 590, 591, 592, 593,
+	0, 222, 243, 0, 0, 259, 260, 538, 0, 24,
+	370, 0, 0, 299, 508, 0, 387, 0, 407, 390,
+	446, 295, 0, 130, 130, 487, 130, 134, 490, 130,
+	492, 130, 495, 0, 0, 0, 0, 507, 0, 0,
+	0, 499, 461, 505, 0, 32, 0, 549, 539, 551,
+	553, 0, 28, 0, 545, 0, 532, 558, 371, 559,
+	337, 0, 342, 0, 0, 0, 0, 0, 0, 0,
+	0, 345, 0, 532, 38, 55, 56, 57, 188, 191,
+	0, 183, 130, 177, 178, 153, 0, 145, 146, 147,
+	148, 149, 150, 131, 105, 106, 156, 157, 155, 0,
+	155, 0, 135, 0, 800, 0, 0, 196, 0, 197,
+	199, 200, 201, 0, 262, 263, 520, 306, 290, 447,
+	391, 450, 484, 155, 488, 489, 491, 493, 494, 496,
+	452, 451, 453, 0, 0, 456, 0, 0, 0, 0,
+	0, 503, 0, 33, 0, 554, -2, 0, 0, 0,
+	45, 36, 0, 329, 0, 0, 0, 0, 327, 0,
+	0, 0, 366, 332, 37, 168, 0, 185, 160, 154,
+	0, 158, 129, 158, 0, 0, 62, 74, 75, 0,
+	0, 522, 0, 0, 485, 486, 0, 0, 0, 0,
+	477, 460, 500, 0, 552, 0, -2, 0, 547, 546,
+	0, 338, 0, 0, 0, 326, 0, 367, 368, 369,
+	323, 167, 169, 0, 174, 0, 184, 165, 0, 162,
+	164, 152, 118, 119, 133, 136, 0, 0, 26, 0,
+	0, 305, 454, 455, 457, 458, 0, 0, 0, 0,
+	542, 28, 0, 330, 0, 0, 327, 0, 0, 322,
+	170, 171, 0, 175, 173, 81, 0, 161, 163, 68,
+	0, 216, 0, 523, 521, 370, 459, 0, 0, 0,
+	550, -2, 548, 0, 316, 300, 0, 319, 324, 327,
+	0, 172, 166, 71, 215, 0, 0, 520, 478, 0,
+	481, 0, 0, 318, 0, 0, 325, 328, 198, 217,
+	0, 522, 479, 345, 317, 345, 320, 0, 27, 0,
+	314, 315, 0, 0, 0, 480, 0, 0, 218, 219,
+}
+
+var yyTok1 = [...]uint8{
+	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 77, 3, 3, 3, 104, 96, 3,
+	57, 59, 101, 99, 58, 100, 113, 102, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 254,
+	85, 84, 86, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 106, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 95, 3, 107,
+}
+
+var yyTok2 = [...]uint8{
+	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
+	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
+	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
+	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
+	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
+	52, 53, 54, 55, 56, 60, 61, 62, 63, 64,
+	65, 66, 67, 68, 69, 70, 71, 72, 73, 74,
+	75, 76, 78, 79, 80, 81, 82, 83, 87, 88,
+	89, 90, 91, 92, 93, 94, 97, 98, 103, 105,
+	108, 109, 110, 111, 112, 114, 115, 116, 117, 118,
+	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
+	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
+	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
+	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
+	169, 170, 171, 172, 173, 174, 175, 176, 177, 178,
+	179, 180, 181, 182, 183, 184, 185, 186, 187, 188,
+	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
+	219, 220, 221, 222, 223, 224, 225, 226, 227, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 237, 238,
+	239, 240, 241, 242, 243, 244, 245, 246, 247, 248,
+	249, 250, 251, 252, 253,
+}
+
+var yyTok3 = [...]int8{
+	0,
+}
+
+var yyErrorMessages = [...]struct {
+	state int
+	token int
+	msg   string
+}{}
+
+//line yaccpar:1
+
+/*	parser for yacc output	*/
+
+var (
+	yyDebug        = 0
+	yyErrorVerbose = false
+)
+
+type yyLexer interface {
+	Lex(lval *yySymType) int
+	Error(s string)
+}
+
+type yyParser interface {
+	Parse(yyLexer) int
+	Lookahead() int
+}
+
+type yyParserImpl struct {
+	lval  yySymType
+	stack [yyInitialStackSize]yySymType
+	char  int
+}
+
+func (p *yyParserImpl) Lookahead() int {
+	return p.char
+}
+
+func yyNewParser() yyParser {
+	return &yyParserImpl{}
+}
+
+const yyFlag = -1000
+
+func yyTokname(c int) string {
+	if c >= 1 && c-1 < len(yyToknames) {
+		if yyToknames[c-1] != "" {
+			return yyToknames[c-1]
+		}
+	}
+	return __yyfmt__.Sprintf("tok-%v", c)
+}
+
+func yyStatname(s int) string {
+	if s >= 0 && s < len(yyStatenames) {
+		if yyStatenames[s] != "" {
+			return yyStatenames[s]
+		}
+	}
+	return __yyfmt__.Sprintf("state-%v", s)
+}
+
+func yyErrorMessage(state, lookAhead int) string {
+	const TOKSTART = 4
+
+	if !yyErrorVerbose {
+		return "syntax error"
+	}
+
+	for _, e := range yyErrorMessages {
+		if e.state == state && e.token == lookAhead {
+			return "syntax error: " + e.msg
+		}
+	}
+
+	res := "syntax error: unexpected " + yyTokname(lookAhead)
+
+	// To match Bison, suggest at most four expected tokens.
+	expected := make([]int, 0, 4)
+
+	// Look for shiftable tokens.
+	base := int(yyPact[state])
+	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
+		if n := base + tok; n >= 0 && n < yyLast && int(yyChk[int(yyAct[n])]) == tok {
+			if len(expected) == cap(expected) {
+				return res
+			}
+			expected = append(expected, tok)
+		}
+	}
+
+	if yyDef[state] == -2 {
+		i := 0
+		for yyExca[i] != -1 || int(yyExca[i+1]) != state {
+			i += 2
+		}
+
+		// Look for tokens that we accept or reduce.
+		for i += 2; yyExca[i] >= 0; i += 2 {
+			tok := int(yyExca[i])
+			if tok < TOKSTART || yyExca[i+1] == 0 {
+				continue
+			}
+			if len(expected) == cap(expected) {
+				return res
+			}
+			expected = append(expected, tok)
+		}
+
+		// If the default action is to accept or reduce, give up.
+		if yyExca[i+1] != 0 {
+			return res
+		}
+	}
+
+	for i, tok := range expected {
+		if i == 0 {
+			res += ", expecting "
+		} else {
+			res += " or "
+		}
+		res += yyTokname(tok)
+	}
+	return res
+}
+
+func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
+	token = 0
+	char = lex.Lex(lval)
+	if char <= 0 {
+		token = int(yyTok1[0])
+		goto out
+	}
+	if char < len(yyTok1) {
+		token = int(yyTok1[char])
+		goto out
+	}
+	if char >= yyPrivate {
+		if char < yyPrivate+len(yyTok2) {
+			token = int(yyTok2[char-yyPrivate])
+			goto out
+		}
+	}
+	for i := 0; i < len(yyTok3); i += 2 {
+		token = int(yyTok3[i+0])
+		if token == char {
+			token = int(yyTok3[i+1])
+			goto out
+		}
+	}
+
+out:
+	if token == 0 {
+		token = int(yyTok2[1]) /* unknown char */
+	}
+	if yyDebug >= 3 {
+		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
+	}
+	return char, token
+}
+
+func yyParse(yylex yyLexer) int {
+	return yyNewParser().Parse(yylex)
+}
+
+func (yyrcvr *yyParserImpl) Parse(yylex yyLexer) int {
+	var yyn int
+	var yyVAL yySymType
+	var yyDollar []yySymType
+	_ = yyDollar // silence set and not used
+	yyS := yyrcvr.stack[:]
+
+	Nerrs := 0   /* number of errors */
+	Errflag := 0 /* error recovery flag */
+	yystate := 0
+	yyrcvr.char = -1
+	yytoken := -1 // yyrcvr.char translated into internal numbering
+	defer func() {
+		// Make sure we report no lookahead when not parsing.
+		yystate = -1
+		yyrcvr.char = -1
+		yytoken = -1
+	}()
+	yyp := -1
+	goto yystack
+
+ret0:
+	return 0
+
+ret1:
+	return 1
+
+yystack:
+	/* put a state and value onto the stack */
+	if yyDebug >= 4 {
+		__yyfmt__.Printf("char %v in %v\n", yyTokname(yytoken), yyStatname(yystate))
+	}
+
+	yyp++
+	if yyp >= len(yyS) {
+		nyys := make([]yySymType, len(yyS)*2)
+		copy(nyys, yyS)
+		yyS = nyys
+	}
+	yyS[yyp] = yyVAL
+	yyS[yyp].yys = yystate
+
+yynewstate:
+	yyn = int(yyPact[yystate])
+	if yyn <= yyFlag {
+		goto yydefault /* simple state */
+	}
+	if yyrcvr.char < 0 {
+		yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
+	}
+	yyn += yytoken
+	if yyn < 0 || yyn >= yyLast {
+		goto yydefault
+	}
+	yyn = int(yyAct[yyn])
+	if int(yyChk[yyn]) == yytoken { /* valid shift */
+		yyrcvr.char = -1
+		yytoken = -1
+		yyVAL = yyrcvr.lval
+		yystate = yyn
+		if Errflag > 0 {
+			Errflag--
+		}
+		goto yystack
+	}
+
+yydefault:
+	/* default state action */
+	yyn = int(yyDef[yystate])
+	if yyn == -2 {
+		if yyrcvr.char < 0 {
+			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
+		}
+
+		/* look through exception table */
+		xi := 0
+		for {
+			if yyExca[xi+0] == -1 && int(yyExca[xi+1]) == yystate {
+				break
+			}
+			xi += 2
+		}
+		for xi += 2; ; xi += 2 {
+			yyn = int(yyExca[xi+0])
+			if yyn < 0 || yyn == yytoken {
+				break
+			}
+		}
+		yyn = int(yyExca[xi+1])
+		if yyn < 0 {
+			goto ret0
+		}
+	}
+	if yyn == 0 {
+		/* error ... attempt to resume parsing */
+		switch Errflag {
+		case 0: /* brand new error */
+			yylex.Error(yyErrorMessage(yystate, yytoken))
+			Nerrs++
+			if yyDebug >= 1 {
+				__yyfmt__.Printf("%s", yyStatname(yystate))
+				__yyfmt__.Printf(" saw %s\n", yyTokname(yytoken))
+			}
+			fallthrough
+
+		case 1, 2: /* incompletely recovered error ... try again */
+			Errflag = 3
+
+			/* find a state where "error" is a legal shift action */
+			for yyp >= 0 {
+				yyn = int(yyPact[yyS[yyp].yys]) + yyErrCode
+				if yyn >= 0 && yyn < yyLast {
+					yystate = int(yyAct[yyn]) /* simulate a shift of "error" */
+					if int(yyChk[yystate]) == yyErrCode {
+						goto yystack
+					}
+				}
+
+				/* the current p has no shift on "error", pop stack */
+				if yyDebug >= 2 {
+					__yyfmt__.Printf("error recovery pops state %d\n", yyS[yyp].yys)
+				}
+				yyp--
+			}
+			/* there is no state on the stack with an error shift ... abort */
+			goto ret1
+
+		case 3: /* no shift yet; clobber input char */
+			if yyDebug >= 2 {
+				__yyfmt__.Printf("error recovery discards %s\n", yyTokname(yytoken))
+			}
+			if yytoken == yyEofCode {
+				goto ret1
+			}
+			yyrcvr.char = -1
+			yytoken = -1
+			goto yynewstate /* try again in the same state */
+		}
+	}
+
+	/* reduction by production yyn */
+	if yyDebug >= 2 {
+		__yyfmt__.Printf("reduce %v in:\n\t%v\n", yyn, yyStatname(yystate))
+	}
+
+	yynt := yyn
+	yypt := yyp
+	_ = yypt // guard against "declared and not used"
+
+	yyp -= int(yyR2[yyn])
+	// yyp is now the index of $0. Perform the default action. Iff the
+	// reduced production is ε, $1 is possibly out of range.
+	if yyp+1 >= len(yyS) {
+		nyys := make([]yySymType, len(yyS)*2)
+		copy(nyys, yyS)
+		yyS = nyys
+	}
+	yyVAL = yyS[yyp+1]
+
+	/* consult goto table to find next state */
+	yyn = int(yyR1[yyn])
+	yyg := int(yyPgo[yyn])
+	yyj := yyg + yyS[yyp].yys + 1
+
+	if yyj >= yyLast {
+		yystate = int(yyAct[yyg])
+	} else {
+		yystate = int(yyAct[yyj])
+		if int(yyChk[yystate]) != -yyn {
+			yystate = int(yyAct[yyg])
+		}
+	}
+	// dummy call; replaced with literal code
+	switch yynt {
+
+	case 1:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:313
+		{
+			setParseTree(yylex, yyDollar[1].statement)
+		}
+	case 2:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:318
+		{
+		}
+	case 3:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:319
+		{
+		}
+	case 4:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:323
+		{
+			yyVAL.statement = yyDollar[1].selStmt
+		}
+	case 22:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:346
+		{
+			sel := yyDollar[1].selStmt.(*Select)
+			sel.OrderBy = yyDollar[2].orderBy
+			sel.Limit = yyDollar[3].limit
+			sel.Lock = yyDollar[4].str
+			yyVAL.selStmt = sel
+		}
+	case 23:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:354
+		{
+			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].str}
+		}
+	case 24:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:358
+		{
+			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, SelectExprs: SelectExprs{Nextval{Expr: yyDollar[5].expr}}, From: TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}}
+		}
+	case 25:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:364
+		{
+			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
+		}
+	case 26:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:371
+		{
+			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SelectExprs: yyDollar[6].selectExprs, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr)}
+		}
+	case 27:
+		yyDollar = yyS[yypt-14 : yypt+1]
+//line sql.y:375
+		{
+			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: DistinctStr, DistinctOn: yyDollar[7].exprs, Hints: yyDollar[9].str, SelectExprs: yyDollar[10].selectExprs, From: yyDollar[11].tableExprs, Where: NewWhere(WhereStr, yyDollar[12].expr), GroupBy: GroupBy(yyDollar[13].exprs), Having: NewWhere(HavingStr, yyDollar[14].expr)}
+		}
+	case 28:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:381
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 29:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:385
+		{
+			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
+		}
+	case 30:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:391
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 31:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:395
+		{
+			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
+		}
+	case 32:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:402
+		{
+			// insert_data returns a *Insert pre-filled with Columns & Values
+			ins := yyDollar[6].ins
+			ins.Action = yyDollar[1].str
+			ins.Comments = yyDollar[2].bytes2
+			ins.Ignore = yyDollar[3].str
+			ins.Table = yyDollar[4].tableName
+			ins.Partitions = yyDollar[5].partitions
+			ins.OnDup = OnDup(yyDollar[7].updateExprs)
+			yyVAL.statement = ins
+		}
+	case 33:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:414
+		{
+			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
+			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
+			for _, updateList := range yyDollar[7].updateExprs {
+				cols = append(cols, updateList.Name.Name)
+				vals = append(vals, updateList.Expr)
+			}
+			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
+		}
+	case 34:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:426
+		{
+			yyVAL.str = InsertStr
+		}
+	case 35:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:430
+		{
+			yyVAL.str = ReplaceStr
+		}
+	case 36:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:436
+		{
+			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), TableExprs: yyDollar[3].tableExprs, Exprs: yyDollar[5].updateExprs, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
+		}
+	case 37:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:442
+		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
+		}
+	case 38:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:446
+		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr)}
+		}
+	case 39:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:450
+		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
+		}
+	case 40:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:455
+		{
+		}
+	case 41:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:456
+		{
+		}
+	case 42:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:460
+		{
+			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
+		}
+	case 43:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:464
+		{
+			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
+		}
+	case 44:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:469
+		{
+			yyVAL.partitions = nil
+		}
+	case 45:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:473
+		{
+			yyVAL.partitions = yyDollar[3].partitions
+		}
+	case 46:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:479
+		{
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
+		}
+	case 47:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:483
+		{
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[4].setExprs}
+		}
+	case 48:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:487
+		{
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[5].setExprs}
+		}
+	case 49:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:491
+		{
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[4].setExprs}
+		}
+	case 50:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:497
+		{
+			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
+		}
+	case 51:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:501
+		{
+			yyVAL.setExprs = append(yyVAL.setExprs, yyDollar[3].setExpr)
+		}
+	case 52:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:507
+		{
+			yyVAL.setExpr = yyDollar[3].setExpr
+		}
+	case 53:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:511
+		{
+			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_read_only"), Expr: NewIntVal([]byte("0"))}
+		}
+	case 54:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:515
+		{
+			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_read_only"), Expr: NewIntVal([]byte("1"))}
+		}
+	case 55:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:521
+		{
+			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("repeatable read"))}
+		}
+	case 56:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:525
+		{
+			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("read committed"))}
+		}
+	case 57:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:529
+		{
+			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("read uncommitted"))}
+		}
+	case 58:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:533
+		{
+			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("serializable"))}
+		}
+	case 59:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:539
+		{
+			yyVAL.str = SessionStr
+		}
+	case 60:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:543
+		{
+			yyVAL.str = GlobalStr
+		}
+	case 61:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:549
+		{
+			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 62:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:554
+		{
+			// Change this to an alter statement
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[7].tableName, NewName: yyDollar[7].tableName}
+		}
+	case 63:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:559
+		{
+			yyVAL.statement = &DDL{Action: CreateStr, NewName: yyDollar[3].tableName.ToViewName()}
+		}
+	case 64:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:563
+		{
+			yyVAL.statement = &DDL{Action: CreateStr, NewName: yyDollar[5].tableName.ToViewName()}
+		}
+	case 65:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:567
+		{
+			yyVAL.statement = &DDL{Action: CreateVindexStr, VindexSpec: &VindexSpec{
+				Name:   yyDollar[3].colIdent,
+				Type:   yyDollar[4].colIdent,
+				Params: yyDollar[5].vindexParams,
+			}}
+		}
+	case 66:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:575
+		{
+			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
+		}
+	case 67:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:579
+		{
+			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
+		}
+	case 68:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:584
+		{
+			yyVAL.colIdent = NewColIdent("")
+		}
+	case 69:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:588
+		{
+			yyVAL.colIdent = yyDollar[2].colIdent
+		}
+	case 70:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:594
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 71:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:599
+		{
+			var v []VindexParam
+			yyVAL.vindexParams = v
+		}
+	case 72:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:604
+		{
+			yyVAL.vindexParams = yyDollar[2].vindexParams
+		}
+	case 73:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:610
+		{
+			yyVAL.vindexParams = make([]VindexParam, 0, 4)
+			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
+		}
+	case 74:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:615
+		{
+			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[3].vindexParam)
+		}
+	case 75:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:621
+		{
+			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
+		}
+	case 76:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:627
+		{
+			yyVAL.ddl = &DDL{Action: CreateStr, NewName: yyDollar[4].tableName}
+			setDDL(yylex, yyVAL.ddl)
+		}
+	case 77:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:634
+		{
+			yyVAL.TableSpec = yyDollar[2].TableSpec
+			yyVAL.TableSpec.Options = yyDollar[4].str
+		}
+	case 78:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:641
+		{
+			yyVAL.TableSpec = &TableSpec{}
+			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
+		}
+	case 79:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:646
+		{
+			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
+		}
+	case 80:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:650
+		{
+			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
+		}
+	case 81:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:656
+		{
+			yyDollar[2].columnType.NotNull = yyDollar[3].boolVal
+			yyDollar[2].columnType.Default = yyDollar[4].optVal
+			yyDollar[2].columnType.OnUpdate = yyDollar[5].optVal
+			yyDollar[2].columnType.Autoincrement = yyDollar[6].boolVal
+			yyDollar[2].columnType.KeyOpt = yyDollar[7].colKeyOpt
+			yyDollar[2].columnType.Comment = yyDollar[8].optVal
+			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
+		}
+	case 82:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:667
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
+			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
+		}
+	case 86:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:678
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.columnType.Length = yyDollar[2].optVal
+		}
+	case 87:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:683
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 88:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:689
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 89:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:693
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 90:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:697
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 91:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:701
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 92:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:705
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 93:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:709
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 94:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:713
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 95:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:719
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 96:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:725
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 97:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:731
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 98:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:737
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 99:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:743
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 100:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:751
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 101:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:755
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+		}
+	case 102:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:759
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+		}
+	case 103:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:763
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+		}
+	case 104:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:767
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 105:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:773
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
+		}
+	case 106:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:777
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
+		}
+	case 107:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:781
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+		}
+	case 108:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:785
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+		}
+	case 109:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:789
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+		}
+	case 110:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:793
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+		}
+	case 111:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:797
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+		}
+	case 112:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:801
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+		}
+	case 113:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:805
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 114:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:809
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 115:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:813
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 116:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:817
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 117:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:821
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 118:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:825
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
+		}
+	case 119:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:830
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
+		}
+	case 120:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:836
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 121:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:840
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 122:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:844
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 123:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:848
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 124:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:852
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 125:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:856
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 126:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:860
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 127:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:864
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 128:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:870
+		{
+			yyVAL.strs = make([]string, 0, 4)
+			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
+		}
+	case 129:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:875
+		{
+			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
+		}
+	case 130:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:880
+		{
+			yyVAL.optVal = nil
+		}
+	case 131:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:884
+		{
+			yyVAL.optVal = NewIntVal(yyDollar[2].bytes)
+		}
+	case 132:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:889
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{}
+		}
+	case 133:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:893
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{
+				Length: NewIntVal(yyDollar[2].bytes),
+				Scale:  NewIntVal(yyDollar[4].bytes),
+			}
+		}
+	case 134:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:901
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{}
+		}
+	case 135:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:905
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{
+				Length: NewIntVal(yyDollar[2].bytes),
+			}
+		}
+	case 136:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:911
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{
+				Length: NewIntVal(yyDollar[2].bytes),
+				Scale:  NewIntVal(yyDollar[4].bytes),
+			}
+		}
+	case 137:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:919
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 138:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:923
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 139:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:928
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 140:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:932
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 141:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:938
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 142:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:942
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 143:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:946
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 144:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:951
+		{
+			yyVAL.optVal = nil
+		}
+	case 145:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:955
+		{
+			yyVAL.optVal = NewStrVal(yyDollar[2].bytes)
+		}
+	case 146:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:959
+		{
+			yyVAL.optVal = NewIntVal(yyDollar[2].bytes)
+		}
+	case 147:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:963
+		{
+			yyVAL.optVal = NewFloatVal(yyDollar[2].bytes)
+		}
+	case 148:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:967
+		{
+			yyVAL.optVal = NewValArg(yyDollar[2].bytes)
+		}
+	case 149:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:971
+		{
+			yyVAL.optVal = NewValArg(yyDollar[2].bytes)
+		}
+	case 150:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:975
+		{
+			yyVAL.optVal = NewBitVal(yyDollar[2].bytes)
+		}
+	case 151:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:980
+		{
+			yyVAL.optVal = nil
+		}
+	case 152:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:984
+		{
+			yyVAL.optVal = NewValArg(yyDollar[3].bytes)
+		}
+	case 153:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:989
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 154:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:993
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 155:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:998
+		{
+			yyVAL.str = ""
+		}
+	case 156:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1002
+		{
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 157:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1006
+		{
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 158:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1011
+		{
+			yyVAL.str = ""
+		}
+	case 159:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1015
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 160:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1020
+		{
+			yyVAL.colKeyOpt = colKeyNone
+		}
+	case 161:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1024
+		{
+			yyVAL.colKeyOpt = colKeyPrimary
+		}
+	case 162:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1028
+		{
+			yyVAL.colKeyOpt = colKey
+		}
+	case 163:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1032
+		{
+			yyVAL.colKeyOpt = colKeyUniqueKey
+		}
+	case 164:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1036
+		{
+			yyVAL.colKeyOpt = colKeyUnique
+		}
+	case 165:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1041
+		{
+			yyVAL.optVal = nil
+		}
+	case 166:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1045
+		{
+			yyVAL.optVal = NewStrVal(yyDollar[2].bytes)
+		}
+	case 167:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1051
+		{
+			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
+		}
+	case 168:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1055
+		{
+			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns}
+		}
+	case 169:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1061
+		{
+			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
+		}
+	case 170:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1065
+		{
+			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
+		}
+	case 171:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1071
+		{
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].bytes)}
+		}
+	case 172:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1075
+		{
+			// should not be string
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
+		}
+	case 173:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1080
+		{
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
+		}
+	case 174:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1086
+		{
+			yyVAL.str = ""
+		}
+	case 175:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1090
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 176:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1096
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
+		}
+	case 177:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1100
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Spatial: true, Unique: false}
+		}
+	case 178:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1104
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Unique: true}
+		}
+	case 179:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1108
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(string(yyDollar[2].bytes)), Unique: true}
+		}
+	case 180:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1112
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(string(yyDollar[2].bytes)), Unique: false}
+		}
+	case 181:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1118
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 182:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1122
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 183:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1128
+		{
+			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
+		}
+	case 184:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1132
+		{
+			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
+		}
+	case 185:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1138
+		{
+			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].optVal}
+		}
+	case 186:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1143
+		{
+			yyVAL.str = ""
+		}
+	case 187:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1147
+		{
+			yyVAL.str = " " + string(yyDollar[1].str)
+		}
+	case 188:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1151
+		{
+			yyVAL.str = string(yyDollar[1].str) + ", " + string(yyDollar[3].str)
+		}
+	case 189:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1159
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 190:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1163
+		{
+			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
+		}
+	case 191:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1167
+		{
+			yyVAL.str = yyDollar[1].str + "=" + yyDollar[3].str
+		}
+	case 192:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1173
+		{
+			yyVAL.str = yyDollar[1].colIdent.String()
+		}
+	case 193:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1177
+		{
+			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
+		}
+	case 194:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1181
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 195:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1187
+		{
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
+		}
+	case 196:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1191
+		{
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
+		}
+	case 197:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1195
+		{
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
+		}
+	case 198:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:1199
+		{
+			yyVAL.statement = &DDL{
+				Action: AddColVindexStr,
+				Table:  yyDollar[4].tableName,
+				VindexSpec: &VindexSpec{
+					Name:   yyDollar[7].colIdent,
+					Type:   yyDollar[11].colIdent,
+					Params: yyDollar[12].vindexParams,
+				},
+				VindexCols: yyDollar[9].columns,
+			}
+		}
+	case 199:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1212
+		{
+			yyVAL.statement = &DDL{
+				Action: DropColVindexStr,
+				Table:  yyDollar[4].tableName,
+				VindexSpec: &VindexSpec{
+					Name: yyDollar[7].colIdent,
+				},
+			}
+		}
+	case 200:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1222
+		{
+			// Change this to a rename statement
+			yyVAL.statement = &DDL{Action: RenameStr, Table: yyDollar[4].tableName, NewName: yyDollar[7].tableName}
+		}
+	case 201:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1227
+		{
+			// Rename an index can just be an alter
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
+		}
+	case 202:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1232
+		{
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName(), NewName: yyDollar[3].tableName.ToViewName()}
+		}
+	case 203:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1236
+		{
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, PartitionSpec: yyDollar[5].partSpec}
+		}
+	case 215:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1255
+		{
+			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
+		}
+	case 216:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1261
+		{
+			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
+		}
+	case 217:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1265
+		{
+			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
+		}
+	case 218:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:1271
+		{
+			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
+		}
+	case 219:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:1275
+		{
+			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
+		}
+	case 220:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1281
+		{
+			yyVAL.statement = &DDL{Action: RenameStr, Table: yyDollar[3].tableName, NewName: yyDollar[5].tableName}
+		}
+	case 221:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1287
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, Table: yyDollar[4].tableName, IfExists: exists}
+		}
+	case 222:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1295
+		{
+			// Change this to an alter statement
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableName, NewName: yyDollar[5].tableName}
+		}
+	case 223:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1300
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, Table: yyDollar[4].tableName.ToViewName(), IfExists: exists}
+		}
+	case 224:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1308
+		{
+			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
+		}
+	case 225:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1312
+		{
+			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
+		}
+	case 226:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1318
+		{
+			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
+		}
+	case 227:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1322
+		{
+			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
+		}
+	case 228:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1327
+		{
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName, NewName: yyDollar[3].tableName}
+		}
+	case 229:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1333
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 230:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1337
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 231:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1341
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 232:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1346
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 233:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1350
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 234:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1354
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 235:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1358
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 236:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1362
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 237:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1366
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 238:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1370
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 239:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1374
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 240:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1378
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 241:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1382
+		{
+			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
+		}
+	case 242:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1386
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 243:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1390
+		{
+			// this is ugly, but I couldn't find a better way for now
+			if yyDollar[4].str == "processlist" {
+				yyVAL.statement = &Show{Type: yyDollar[4].str}
+			} else {
+				showTablesOpt := &ShowTablesOpt{Extended: yyDollar[2].str, Full: yyDollar[3].str, DbName: yyDollar[5].str, Filter: yyDollar[6].showFilter}
+				yyVAL.statement = &Show{Type: yyDollar[4].str, ShowTablesOpt: showTablesOpt}
+			}
+		}
+	case 244:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1400
+		{
+			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
+		}
+	case 245:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1404
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 246:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1408
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), OnTable: yyDollar[4].tableName}
+		}
+	case 247:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1412
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 248:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1416
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 249:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1420
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 250:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1424
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 251:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1434
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 252:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1440
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 253:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1444
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 254:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1450
+		{
+			yyVAL.str = ""
+		}
+	case 255:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1454
+		{
+			yyVAL.str = "extended "
+		}
+	case 256:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1460
+		{
+			yyVAL.str = ""
+		}
+	case 257:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1464
+		{
+			yyVAL.str = "full "
+		}
+	case 258:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1470
+		{
+			yyVAL.str = ""
+		}
+	case 259:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1474
+		{
+			yyVAL.str = yyDollar[2].tableIdent.v
+		}
+	case 260:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1478
+		{
+			yyVAL.str = yyDollar[2].tableIdent.v
+		}
+	case 261:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1484
+		{
+			yyVAL.showFilter = nil
+		}
+	case 262:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1488
+		{
+			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
+		}
+	case 263:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1492
+		{
+			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
+		}
+	case 264:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1498
+		{
+			yyVAL.str = ""
+		}
+	case 265:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1502
+		{
+			yyVAL.str = SessionStr
+		}
+	case 266:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1506
+		{
+			yyVAL.str = GlobalStr
+		}
+	case 267:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1512
+		{
+			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
+		}
+	case 268:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1516
+		{
+			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
+		}
+	case 269:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1522
+		{
+			yyVAL.statement = &Begin{}
+		}
+	case 270:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1526
+		{
+			yyVAL.statement = &Begin{}
+		}
+	case 271:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1532
+		{
+			yyVAL.statement = &Commit{}
+		}
+	case 272:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1538
+		{
+			yyVAL.statement = &Rollback{}
+		}
+	case 273:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1544
+		{
+			yyVAL.statement = &OtherRead{}
+		}
+	case 274:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1548
+		{
+			yyVAL.statement = &OtherRead{}
+		}
+	case 275:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1552
+		{
+			yyVAL.statement = &OtherRead{}
+		}
+	case 276:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1556
+		{
+			yyVAL.statement = &OtherAdmin{}
+		}
+	case 277:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1560
+		{
+			yyVAL.statement = &OtherAdmin{}
+		}
+	case 278:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1565
+		{
+			setAllowComments(yylex, true)
+		}
+	case 279:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1569
+		{
+			yyVAL.bytes2 = yyDollar[2].bytes2
+			setAllowComments(yylex, false)
+		}
+	case 280:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1575
+		{
+			yyVAL.bytes2 = nil
+		}
+	case 281:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1579
+		{
+			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
+		}
+	case 282:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1585
+		{
+			yyVAL.str = UnionStr
+		}
+	case 283:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1589
+		{
+			yyVAL.str = UnionAllStr
+		}
+	case 284:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1593
+		{
+			yyVAL.str = UnionDistinctStr
+		}
+	case 285:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1598
+		{
+			yyVAL.str = ""
+		}
+	case 286:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1602
+		{
+			yyVAL.str = SQLNoCacheStr
+		}
+	case 287:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1606
+		{
+			yyVAL.str = SQLCacheStr
+		}
+	case 288:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1611
+		{
+			yyVAL.str = ""
+		}
+	case 289:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1615
+		{
+			yyVAL.str = DistinctStr
+		}
+	case 290:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1620
+		{
+			yyVAL.str = ""
+		}
+	case 291:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1624
+		{
+			yyVAL.str = StraightJoinHint
+		}
+	case 292:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1629
+		{
+			yyVAL.selectExprs = nil
+		}
+	case 293:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1633
+		{
+			yyVAL.selectExprs = yyDollar[1].selectExprs
+		}
+	case 294:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1639
+		{
+			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
+		}
+	case 295:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1643
+		{
+			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
+		}
+	case 296:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1649
+		{
+			yyVAL.selectExpr = &StarExpr{}
+		}
+	case 297:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1653
+		{
+			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
+		}
+	case 298:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1657
+		{
+			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
+		}
+	case 299:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1661
+		{
+			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
+		}
+	case 300:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1666
+		{
+			yyVAL.colIdent = ColIdent{}
+		}
+	case 301:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1670
+		{
+			yyVAL.colIdent = yyDollar[1].colIdent
+		}
+	case 302:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1674
+		{
+			yyVAL.colIdent = yyDollar[2].colIdent
+		}
+	case 304:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1681
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 305:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1686
+		{
+			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
+		}
+	case 306:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1690
+		{
+			yyVAL.tableExprs = yyDollar[2].tableExprs
+		}
+	case 307:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1696
+		{
+			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
+		}
+	case 308:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1700
+		{
+			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
+		}
+	case 311:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1710
+		{
+			yyVAL.tableExpr = yyDollar[1].aliasedTableName
+		}
+	case 312:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1714
+		{
+			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery, As: yyDollar[3].tableIdent}
+		}
+	case 313:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1718
+		{
+			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
+		}
+	case 314:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:1722
+		{
+			yyVAL.tableExpr = &PivotTableExpr{Expr: yyDollar[1].tableExpr, Aggregate: yyDollar[4].expr.(*FuncExpr), For: yyDollar[6].colName, In: yyDollar[9].pivotValues, As: yyDollar[12].tableIdent}
+		}
+	case 315:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:1726
+		{
+			yyVAL.tableExpr = &UnpivotTableExpr{Expr: yyDollar[1].tableExpr, Value: yyDollar[4].colIdent, For: yyDollar[6].colIdent, In: yyDollar[9].colNames, As: yyDollar[12].tableIdent}
+		}
+	case 316:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1732
+		{
+			yyVAL.pivotValues = PivotValues{yyDollar[1].pivotValue}
+		}
+	case 317:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1736
+		{
+			yyVAL.pivotValues = append(yyVAL.pivotValues, yyDollar[3].pivotValue)
+		}
+	case 318:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1742
+		{
+			yyVAL.pivotValue = &PivotValue{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
+		}
+	case 319:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1748
+		{
+			yyVAL.colNames = []*ColName{yyDollar[1].colName}
+		}
+	case 320:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1752
+		{
+			yyVAL.colNames = append(yyVAL.colNames, yyDollar[3].colName)
+		}
+	case 321:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1758
+		{
+			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints, Sample: yyDollar[4].tableSample}
+		}
+	case 322:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:1762
+		{
+			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints, Sample: yyDollar[8].tableSample}
+		}
+	case 323:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1767
+		{
+			yyVAL.tableSample = nil
+		}
+	case 324:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1771
+		{
+			yyVAL.tableSample = &TableSample{Method: yyDollar[2].colIdent, Amount: yyDollar[4].expr, Seed: yyDollar[6].expr}
+		}
+	case 325:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1775
+		{
+			yyVAL.tableSample = &TableSample{Method: yyDollar[2].colIdent, Amount: yyDollar[4].expr, Unit: yyDollar[5].colIdent, Seed: yyDollar[7].expr}
+		}
+	case 326:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1779
+		{
+			yyVAL.tableSample = &TableSample{Amount: yyDollar[2].expr, Unit: yyDollar[3].colIdent, Seed: yyDollar[4].expr}
+		}
+	case 327:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1784
+		{
+			yyVAL.expr = nil
+		}
+	case 328:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1788
+		{
+			yyVAL.expr = yyDollar[3].expr
+		}
+	case 329:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1794
+		{
+			yyVAL.columns = Columns{yyDollar[1].colIdent}
+		}
+	case 330:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1798
+		{
+			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
+		}
+	case 331:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1804
+		{
+			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
+		}
+	case 332:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1808
+		{
+			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
+		}
+	case 333:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1821
+		{
+			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
+		}
+	case 334:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1825
+		{
+			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
+		}
+	case 335:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1829
+		{
+			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
+		}
+	case 336:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1833
+		{
+			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
+		}
+	case 337:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1839
+		{
+			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
+		}
+	case 338:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1841
+		{
+			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
+		}
+	case 339:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1845
+		{
+			yyVAL.joinCondition = JoinCondition{}
+		}
+	case 340:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1847
+		{
+			yyVAL.joinCondition = yyDollar[1].joinCondition
+		}
+	case 341:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1851
+		{
+			yyVAL.joinCondition = JoinCondition{}
+		}
+	case 342:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1853
+		{
+			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
+		}
+	case 343:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1856
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 344:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1858
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 345:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1861
+		{
+			yyVAL.tableIdent = NewTableIdent("")
+		}
+	case 346:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1865
+		{
+			yyVAL.tableIdent = yyDollar[1].tableIdent
+		}
+	case 347:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1869
+		{
+			yyVAL.tableIdent = yyDollar[2].tableIdent
+		}
+	case 349:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1876
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 350:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1882
+		{
+			yyVAL.str = JoinStr
+		}
+	case 351:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1886
+		{
+			yyVAL.str = JoinStr
+		}
+	case 352:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1890
+		{
+			yyVAL.str = JoinStr
+		}
+	case 353:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1896
+		{
+			yyVAL.str = StraightJoinStr
+		}
+	case 354:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1902
+		{
+			yyVAL.str = LeftJoinStr
+		}
+	case 355:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1906
+		{
+			yyVAL.str = LeftJoinStr
+		}
+	case 356:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1910
+		{
+			yyVAL.str = RightJoinStr
+		}
+	case 357:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1914
+		{
+			yyVAL.str = RightJoinStr
+		}
+	case 358:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1918
+		{
+			yyVAL.str = FullOuterJoinStr
+		}
+	case 359:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1922
+		{
+			yyVAL.str = FullOuterJoinStr
+		}
+	case 360:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1928
+		{
+			yyVAL.str = NaturalJoinStr
+		}
+	case 361:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1932
+		{
+			if yyDollar[2].str == LeftJoinStr {
+				yyVAL.str = NaturalLeftJoinStr
+			} else {
+				yyVAL.str = NaturalRightJoinStr
+			}
+		}
+	case 362:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1942
+		{
+			yyVAL.tableName = yyDollar[2].tableName
+		}
+	case 363:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1946
+		{
+			yyVAL.tableName = yyDollar[1].tableName
+		}
+	case 364:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1952
+		{
+			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
+		}
+	case 365:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1956
+		{
+			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
+		}
+	case 366:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1961
+		{
+			yyVAL.indexHints = nil
+		}
+	case 367:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1965
+		{
+			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].columns}
+		}
+	case 368:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1969
+		{
+			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].columns}
+		}
+	case 369:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1973
+		{
+			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].columns}
+		}
+	case 370:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1978
+		{
+			yyVAL.expr = nil
+		}
+	case 371:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1982
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 372:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1988
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 373:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1992
+		{
+			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
+		}
+	case 374:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1996
+		{
+			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
+		}
+	case 375:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2000
+		{
+			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
+		}
+	case 376:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2004
+		{
+			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
+		}
+	case 377:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2008
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 378:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2012
+		{
+			yyVAL.expr = &Default{ColName: yyDollar[2].str}
+		}
+	case 379:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2018
+		{
+			yyVAL.str = ""
+		}
+	case 380:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2022
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 381:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2028
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 382:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2032
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 383:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2038
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
+		}
+	case 384:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2042
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
+		}
+	case 385:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2046
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
+		}
+	case 386:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2050
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
+		}
+	case 387:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2054
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
+		}
+	case 388:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2058
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
+		}
+	case 389:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2062
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
+		}
+	case 390:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2066
+		{
+			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
+		}
+	case 391:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2070
+		{
+			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
+		}
+	case 392:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2074
+		{
+			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
+		}
+	case 393:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2080
+		{
+			yyVAL.str = IsNullStr
+		}
+	case 394:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2084
+		{
+			yyVAL.str = IsNotNullStr
+		}
+	case 395:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2088
+		{
+			yyVAL.str = IsTrueStr
+		}
+	case 396:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2092
+		{
+			yyVAL.str = IsNotTrueStr
+		}
+	case 397:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2096
+		{
+			yyVAL.str = IsFalseStr
+		}
+	case 398:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2100
+		{
+			yyVAL.str = IsNotFalseStr
+		}
+	case 399:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2106
+		{
+			yyVAL.str = EqualStr
+		}
+	case 400:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2110
+		{
+			yyVAL.str = LessThanStr
+		}
+	case 401:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2114
+		{
+			yyVAL.str = GreaterThanStr
+		}
+	case 402:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2118
+		{
+			yyVAL.str = LessEqualStr
+		}
+	case 403:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2122
+		{
+			yyVAL.str = GreaterEqualStr
+		}
+	case 404:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2126
+		{
+			yyVAL.str = NotEqualStr
+		}
+	case 405:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2130
+		{
+			yyVAL.str = NullSafeEqualStr
+		}
+	case 406:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2135
+		{
+			yyVAL.expr = nil
+		}
+	case 407:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2139
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 408:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2145
+		{
+			yyVAL.colTuple = yyDollar[1].valTuple
+		}
+	case 409:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2149
+		{
+			yyVAL.colTuple = yyDollar[1].subquery
+		}
+	case 410:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2153
+		{
+			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
+		}
+	case 411:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2159
+		{
+			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
+		}
+	case 412:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2165
+		{
+			yyVAL.exprs = Exprs{yyDollar[1].expr}
+		}
+	case 413:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2169
+		{
+			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
+		}
+	case 414:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2175
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 415:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2179
+		{
+			yyVAL.expr = yyDollar[1].boolVal
+		}
+	case 416:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2183
+		{
+			yyVAL.expr = yyDollar[1].colName
+		}
+	case 417:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2187
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 418:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2191
+		{
+			yyVAL.expr = yyDollar[1].subquery
+		}
+	case 419:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2195
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
+		}
+	case 420:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2199
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
+		}
+	case 421:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2203
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
+		}
+	case 422:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2207
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
+		}
+	case 423:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2211
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
+		}
+	case 424:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2215
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
+		}
+	case 425:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2219
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
+		}
+	case 426:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2223
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
+		}
+	case 427:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2227
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
+		}
+	case 428:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2231
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
+		}
+	case 429:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2235
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
+		}
+	case 430:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2239
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
+		}
+	case 431:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2243
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
+		}
+	case 432:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2247
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
+		}
+	case 433:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2251
+		{
+			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
+		}
+	case 434:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2255
+		{
+			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
+		}
+	case 435:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2259
+		{
+			yyVAL.expr = &UnaryExpr{Operator: UBinaryStr, Expr: yyDollar[2].expr}
+		}
+	case 436:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2263
+		{
+			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
+				yyVAL.expr = num
+			} else {
+				yyVAL.expr = &UnaryExpr{Operator: UPlusStr, Expr: yyDollar[2].expr}
+			}
+		}
+	case 437:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2271
+		{
+			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
+				// Handle double negative
+				if num.Val[0] == '-' {
+					num.Val = num.Val[1:]
+					yyVAL.expr = num
+				} else {
+					yyVAL.expr = NewIntVal(append([]byte("-"), num.Val...))
+				}
+			} else {
+				yyVAL.expr = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[2].expr}
+			}
+		}
+	case 438:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2285
+		{
+			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
+		}
+	case 439:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2289
+		{
+			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
+		}
+	case 440:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2293
+		{
+			// This rule prevents the usage of INTERVAL
+			// as a function. If support is needed for that,
+			// we'll need to revisit this. The solution
+			// will be non-trivial because of grammar conflicts.
+			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
+		}
+	case 445:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2311
+		{
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
+		}
+	case 446:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2315
+		{
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
+		}
+	case 447:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2319
+		{
+			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
+		}
+	case 448:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2329
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
+		}
+	case 449:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2333
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
+		}
+	case 450:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2337
+		{
+			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
+		}
+	case 451:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2341
+		{
+			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
+		}
+	case 452:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2345
+		{
+			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
+		}
+	case 453:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2349
+		{
+			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: nil}
+		}
+	case 454:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2353
+		{
+			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
+		}
+	case 455:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2357
+		{
+			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
+		}
+	case 456:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2361
+		{
+			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: nil}
+		}
+	case 457:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2365
+		{
+			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
+		}
+	case 458:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2369
+		{
+			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
+		}
+	case 459:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:2373
+		{
+			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
+		}
+	case 460:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2377
+		{
+			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str}
+		}
+	case 461:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2381
+		{
+			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
+		}
+	case 462:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2385
+		{
+			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
+		}
+	case 463:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2395
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
+		}
+	case 464:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2399
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
+		}
+	case 465:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2403
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
+		}
+	case 466:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2407
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
+		}
+	case 467:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2412
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
+		}
+	case 468:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2417
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
+		}
+	case 469:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2422
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
+		}
+	case 470:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2427
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
+		}
+	case 473:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2441
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
+		}
+	case 474:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2445
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
+		}
+	case 475:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2449
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
+		}
+	case 476:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2453
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
+		}
+	case 477:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2459
+		{
+			yyVAL.str = ""
+		}
+	case 478:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2463
+		{
+			yyVAL.str = BooleanModeStr
+		}
+	case 479:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2467
+		{
+			yyVAL.str = NaturalLanguageModeStr
+		}
+	case 480:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2471
+		{
+			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
+		}
+	case 481:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2475
+		{
+			yyVAL.str = QueryExpansionStr
+		}
+	case 482:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2481
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 483:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2485
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 484:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2491
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+		}
+	case 485:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2495
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Operator: CharacterSetStr}
+		}
+	case 486:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2499
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: string(yyDollar[3].bytes)}
+		}
+	case 487:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2503
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 488:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2507
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+		}
+	case 489:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2511
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 490:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2517
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 491:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2521
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+		}
+	case 492:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2525
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 493:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2529
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 494:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2533
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+		}
+	case 495:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2537
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 496:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2541
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 497:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2546
+		{
+			yyVAL.expr = nil
+		}
+	case 498:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2550
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 499:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2555
+		{
+			yyVAL.str = string("")
+		}
+	case 500:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2559
+		{
+			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
+		}
+	case 501:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2565
+		{
+			yyVAL.whens = []*When{yyDollar[1].when}
+		}
+	case 502:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2569
+		{
+			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
+		}
+	case 503:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2575
+		{
+			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
+		}
+	case 504:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2580
+		{
+			yyVAL.expr = nil
+		}
+	case 505:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2584
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 506:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2590
+		{
+			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
+		}
+	case 507:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2594
+		{
+			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
+		}
+	case 508:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2598
+		{
+			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
+		}
+	case 509:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2604
+		{
+			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
+		}
+	case 510:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2608
+		{
+			yyVAL.expr = NewHexVal(yyDollar[1].bytes)
+		}
+	case 511:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2612
+		{
+			yyVAL.expr = NewBitVal(yyDollar[1].bytes)
+		}
+	case 512:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2616
+		{
+			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
+		}
+	case 513:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2620
+		{
+			yyVAL.expr = NewFloatVal(yyDollar[1].bytes)
+		}
+	case 514:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2624
+		{
+			yyVAL.expr = NewHexNum(yyDollar[1].bytes)
+		}
+	case 515:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2628
+		{
+			yyVAL.expr = NewValArg(yyDollar[1].bytes)
+		}
+	case 516:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2632
+		{
+			yyVAL.expr = &NullVal{}
+		}
+	case 517:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2638
+		{
+			// TODO(sougou): Deprecate this construct.
+			if yyDollar[1].colIdent.Lowered() != "value" {
+				yylex.Error("expecting value after next")
+				return 1
+			}
+			yyVAL.expr = NewIntVal([]byte("1"))
+		}
+	case 518:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2647
+		{
+			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
+		}
+	case 519:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2651
+		{
+			yyVAL.expr = NewValArg(yyDollar[1].bytes)
+		}
+	case 520:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2656
+		{
+			yyVAL.exprs = nil
+		}
+	case 521:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2660
+		{
+			yyVAL.exprs = yyDollar[3].exprs
+		}
+	case 522:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2665
+		{
+			yyVAL.expr = nil
+		}
+	case 523:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2669
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 524:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2674
+		{
+			yyVAL.orderBy = nil
+		}
+	case 525:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2678
+		{
+			yyVAL.orderBy = yyDollar[3].orderBy
+		}
+	case 526:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2684
+		{
+			yyVAL.orderBy = OrderBy{yyDollar[1].order}
+		}
+	case 527:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2688
+		{
+			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
+		}
+	case 528:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2694
+		{
+			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
+		}
+	case 529:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2699
+		{
+			yyVAL.str = AscScr
+		}
+	case 530:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2703
+		{
+			yyVAL.str = AscScr
+		}
+	case 531:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2707
+		{
+			yyVAL.str = DescScr
+		}
+	case 532:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2712
+		{
+			yyVAL.limit = nil
+		}
+	case 533:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2716
+		{
+			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
+		}
+	case 534:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2720
+		{
+			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
+		}
+	case 535:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2724
+		{
+			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
+		}
+	case 536:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2729
+		{
+			yyVAL.str = ""
+		}
+	case 537:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2733
+		{
+			yyVAL.str = ForUpdateStr
+		}
+	case 538:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2737
+		{
+			yyVAL.str = ShareModeStr
+		}
+	case 539:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2750
+		{
+			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
+		}
+	case 540:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2754
+		{
+			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
+		}
+	case 541:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2758
+		{
+			// Drop the redundant parenthesis.
+			yyVAL.ins = &Insert{Rows: yyDollar[2].selStmt}
+		}
+	case 542:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2763
+		{
+			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
+		}
+	case 543:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2767
+		{
+			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
+		}
+	case 544:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2771
+		{
+			// Drop the redundant parenthesis.
+			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].selStmt}
+		}
+	case 545:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2778
+		{
+			yyVAL.columns = Columns{yyDollar[1].colIdent}
+		}
+	case 546:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2782
+		{
+			yyVAL.columns = Columns{yyDollar[3].colIdent}
+		}
+	case 547:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2786
+		{
+			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
+		}
+	case 548:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2790
+		{
+			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
+		}
+	case 549:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2795
+		{
+			yyVAL.updateExprs = nil
+		}
+	case 550:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2799
+		{
+			yyVAL.updateExprs = yyDollar[5].updateExprs
+		}
+	case 551:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2805
+		{
+			yyVAL.values = Values{yyDollar[1].valTuple}
+		}
+	case 552:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2809
+		{
+			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
+		}
+	case 553:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2815
+		{
+			yyVAL.valTuple = yyDollar[1].valTuple
+		}
+	case 554:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2819
+		{
+			yyVAL.valTuple = ValTuple{}
+		}
+	case 555:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2825
+		{
+			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
+		}
+	case 556:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2831
+		{
+			if len(yyDollar[1].valTuple) == 1 {
+				yyVAL.expr = &ParenExpr{yyDollar[1].valTuple[0]}
+			} else {
+				yyVAL.expr = yyDollar[1].valTuple
+			}
+		}
+	case 557:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2841
+		{
+			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
+		}
+	case 558:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2845
+		{
+			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
+		}
+	case 559:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2851
+		{
+			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
+		}
+	case 560:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2857
+		{
+			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
+		}
+	case 561:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2861
+		{
+			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
+		}
+	case 562:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2867
+		{
+			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("on"))}
+		}
+	case 563:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2871
+		{
+			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: yyDollar[3].expr}
+		}
+	case 564:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2875
+		{
+			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Expr: yyDollar[2].expr}
+		}
+	case 566:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2882
+		{
+			yyVAL.bytes = []byte("charset")
+		}
+	case 568:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2889
+		{
+			yyVAL.expr = NewStrVal([]byte(yyDollar[1].colIdent.String()))
+		}
+	case 569:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2893
+		{
+			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
+		}
+	case 570:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2897
+		{
+			yyVAL.expr = &Default{}
+		}
+	case 573:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2906
+		{
+			yyVAL.byt = 0
+		}
+	case 574:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2908
+		{
+			yyVAL.byt = 1
+		}
+	case 575:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2911
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 576:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2913
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 577:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2916
+		{
+			yyVAL.str = ""
+		}
+	case 578:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2918
+		{
+			yyVAL.str = IgnoreStr
+		}
+	case 579:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2922
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 580:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2924
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 581:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2926
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 582:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2928
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 583:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2930
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 584:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2932
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 585:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2934
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 586:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2936
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 587:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2938
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 588:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2940
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 589:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2943
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 590:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2945
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 591:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2947
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 592:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2951
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 593:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2953
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 594:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2956
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 595:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2958
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 596:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2960
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 597:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2963
+		{
+			yyVAL.colIdent = ColIdent{}
+		}
+	case 598:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2965
+		{
+			yyVAL.colIdent = yyDollar[2].colIdent
+		}
+	case 599:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2969
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 600:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2973
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 602:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2980
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 603:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2986
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 604:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2990
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 606:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2997
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 797:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3213
+		{
+			if incNesting(yylex) {
+				yylex.Error("max nesting level reached")
+				return 1
+			}
+		}
+	case 798:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3222
+		{
+			decNesting(yylex)
+		}
+	case 799:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3227
+		{
+			forceEOF(yylex)
+		}
+	case 800:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3232
+		{
+			forceEOF(yylex)
+		}
+	case 801:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3236
+		{
+			forceEOF(yylex)
+		}
+	case 802:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3240
+		{
+			forceEOF(yylex)
+		}
+	}
+	goto yystack /* stack new state and value */
+}
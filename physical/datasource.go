@@ -56,35 +56,105 @@ func (repo *DataSourceRepository) Register(dataSourceName string, factory DataSo
 }
 
 // DataSourceBuilder is used to build a data source instance with an alias.
-// It may be given filters, which are later executed at the database level.
+// It may be given filters, sampling, a grouping, an ordering, a limit and joined tables, which are later executed at the database level.
+// Sampling is nil if the data source shouldn't be sampled.
+// Grouping is nil if the data source records shouldn't be grouped. It's only set if AvailableAggregates contains all its aggregates.
+// Ordering is nil if the data source records may come in any order. It's only set if AvailableOrdering is.
+// Limit is the maximum number of records the data source should return, nil meaning no limit.
+// It's only set if AvailableLimit is, and is applied after the filter, sampling, grouping and ordering.
+// SortedBy lists the columns, by which the data source records are known to be sorted in ascending order.
+// Columns lists the columns the data source records need to contain, nil meaning all of them.
+// Connection identifies the database the data source's Table is in, data sources with the same non-empty Connection
+// may have their tables joined by the database. Joins lists such joined tables, with whose records the data source records get joined,
+// the Filter is then applied to the joined records.
 type DataSourceBuilder struct {
-	Executor         func(formula Formula, alias string) (execution.Node, error)
-	PrimaryKeys      []octosql.VariableName
-	AvailableFilters map[FieldType]map[Relation]struct{}
-	Filter           Formula
-	Alias            string
+	Executor            Executor
+	PrimaryKeys         []octosql.VariableName
+	SortedBy            []octosql.VariableName
+	AvailableFilters    map[FieldType]map[Relation]struct{}
+	AvailableSampling   map[SampleMethod]struct{}
+	AvailableLimit      bool
+	AvailableOrdering   bool
+	AvailableAggregates map[Aggregate]struct{}
+	Filter              Formula
+	Sampling            *Sampling
+	Limit               Expression
+	Ordering            *Ordering
+	Grouping            *Grouping
+	Columns             []octosql.VariableName
+	Alias               string
+	Connection          string
+	Table               string
+	Joins               []*TableJoin
 }
 
-func NewDataSourceBuilderFactory(executor func(filter Formula, alias string) (execution.Node, error), primaryKeys []octosql.VariableName, availableFilters map[FieldType]map[Relation]struct{}) DataSourceBuilderFactory {
+// TableJoin is a table joined to a data source builder's table in their database.
+// Filter is the join condition, which may use the columns of all the tables joined before.
+// Columns lists the columns of the joined table the records need to contain, nil meaning all of them.
+type TableJoin struct {
+	Table    string
+	Alias    string
+	Filter   Formula
+	JoinType JoinType
+	Columns  []octosql.VariableName
+}
+
+// ExecutorOptions describe the query a data source builder passes to its Executor when materialized.
+// The fields have the same meaning as the ones of the DataSourceBuilder,
+// apart from Ctx, which is the context of the materialization, for the executor to use in the queries it makes while creating the node.
+type ExecutorOptions struct {
+	Ctx      context.Context
+	Filter   Formula
+	Alias    string
+	Sampling *Sampling
+	Limit    Expression
+	Ordering *Ordering
+	Grouping *Grouping
+	Joins    []*TableJoin
+	Columns  []octosql.VariableName
+}
+
+// Executor creates the execution node of a data source for the given query.
+type Executor func(options *ExecutorOptions) (execution.Node, error)
+
+// NewDataSourceBuilderFactory creates a factory of copies of the given data source builder,
+// with the alias set and a filter always being true.
+func NewDataSourceBuilderFactory(builder DataSourceBuilder) DataSourceBuilderFactory {
 	return func(alias string) *DataSourceBuilder {
-		return &DataSourceBuilder{
-			Executor:         executor,
-			PrimaryKeys:      primaryKeys,
-			AvailableFilters: availableFilters,
-			Filter:           NewConstant(true),
-			Alias:            alias,
-		}
+		out := builder.Clone()
+		out.Filter = NewConstant(true)
+		out.Alias = alias
+		return out
 	}
 }
 
+// Clone returns a shallow copy of the data source builder, which may have its fields changed without changing the original.
+func (dsb *DataSourceBuilder) Clone() *DataSourceBuilder {
+	out := *dsb
+	return &out
+}
+
 func (dsb *DataSourceBuilder) Transform(ctx context.Context, transformers *Transformers) Node {
-	var transformed Node = &DataSourceBuilder{
-		Executor:         dsb.Executor,
-		PrimaryKeys:      dsb.PrimaryKeys,
-		AvailableFilters: dsb.AvailableFilters,
-		Filter:           dsb.Filter.Transform(ctx, transformers),
-		Alias:            dsb.Alias,
+	var limit Expression
+	if dsb.Limit != nil {
+		limit = dsb.Limit.Transform(ctx, transformers)
+	}
+	var joins []*TableJoin
+	for _, join := range dsb.Joins {
+		joins = append(joins, &TableJoin{
+			Table:    join.Table,
+			Alias:    join.Alias,
+			Filter:   join.Filter.Transform(ctx, transformers),
+			JoinType: join.JoinType,
+			Columns:  join.Columns,
+		})
 	}
+	out := dsb.Clone()
+	out.Filter = dsb.Filter.Transform(ctx, transformers)
+	out.Sampling = dsb.Sampling.Transform(ctx, transformers)
+	out.Limit = limit
+	out.Joins = joins
+	var transformed Node = out
 	if transformers.NodeT != nil {
 		transformed = transformers.NodeT(transformed)
 	}
@@ -92,5 +162,15 @@ func (dsb *DataSourceBuilder) Transform(ctx context.Context, transformers *Trans
 }
 
 func (dsb *DataSourceBuilder) Materialize(ctx context.Context) (execution.Node, error) {
-	return dsb.Executor(dsb.Filter, dsb.Alias)
+	return dsb.Executor(&ExecutorOptions{
+		Ctx:      ctx,
+		Filter:   dsb.Filter,
+		Alias:    dsb.Alias,
+		Sampling: dsb.Sampling,
+		Limit:    dsb.Limit,
+		Ordering: dsb.Ordering,
+		Grouping: dsb.Grouping,
+		Joins:    dsb.Joins,
+		Columns:  dsb.Columns,
+	})
 }
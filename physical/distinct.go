@@ -33,5 +33,5 @@ func (node *Distinct) Materialize(ctx context.Context) (execution.Node, error) {
 		return nil, errors.Wrap(err, "couldn't materialize child node in distinct")
 	}
 
-	return execution.NewDistinct(childNode), nil
+	return execution.NewDistinct(childNode, GroupMemoryLimit(ctx)), nil
 }
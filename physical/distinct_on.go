@@ -0,0 +1,53 @@
+package physical
+
+import (
+	"context"
+
+	"github.com/cube2222/octosql/execution"
+	"github.com/pkg/errors"
+)
+
+type DistinctOn struct {
+	Key    []Expression
+	Source Node
+}
+
+func NewDistinctOn(key []Expression, source Node) *DistinctOn {
+	return &DistinctOn{Key: key, Source: source}
+}
+
+func (node *DistinctOn) Transform(ctx context.Context, transformers *Transformers) Node {
+	key := make([]Expression, len(node.Key))
+	for i := range node.Key {
+		key[i] = node.Key[i].Transform(ctx, transformers)
+	}
+
+	var transformed Node = &DistinctOn{
+		Key:    key,
+		Source: node.Source.Transform(ctx, transformers),
+	}
+
+	if transformers.NodeT != nil {
+		transformed = transformers.NodeT(transformed)
+	}
+
+	return transformed
+}
+
+func (node *DistinctOn) Materialize(ctx context.Context) (execution.Node, error) {
+	key := make([]execution.Expression, len(node.Key))
+	for i := range node.Key {
+		var err error
+		key[i], err = node.Key[i].Materialize(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't materialize distinct on key expression with index %v", i)
+		}
+	}
+
+	sourceNode, err := node.Source.Materialize(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't materialize source node in distinct on")
+	}
+
+	return execution.NewDistinctOn(key, sourceNode), nil
+}
@@ -36,5 +36,5 @@ func (node *Filter) Materialize(ctx context.Context) (execution.Node, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't materialize Source")
 	}
-	return execution.NewFilter(materializedFormula, materializedSource), nil
+	return execution.NewFilter(materializedFormula, materializedSource, Parallelism(ctx)), nil
 }
@@ -0,0 +1,37 @@
+package physical
+
+import (
+	"context"
+
+	"github.com/cube2222/octosql/execution"
+	"github.com/pkg/errors"
+)
+
+// FullJoin returns all the matching pairs of records, as well as the unmatched records of both sides.
+// The joined node can't depend on the source records, so the join condition is kept separately.
+// It can only be executed as a hash join, so the condition has to contain an equality between both sides.
+type FullJoin struct {
+	Source    Node
+	Joined    Node
+	Condition Formula
+}
+
+func NewFullJoin(source Node, joined Node, condition Formula) *FullJoin {
+	return &FullJoin{Source: source, Joined: joined, Condition: condition}
+}
+
+func (node *FullJoin) Transform(ctx context.Context, transformers *Transformers) Node {
+	var transformed Node = &FullJoin{
+		Source:    node.Source.Transform(ctx, transformers),
+		Joined:    node.Joined.Transform(ctx, transformers),
+		Condition: node.Condition.Transform(ctx, transformers),
+	}
+	if transformers.NodeT != nil {
+		transformed = transformers.NodeT(transformed)
+	}
+	return transformed
+}
+
+func (node *FullJoin) Materialize(ctx context.Context) (execution.Node, error) {
+	return nil, errors.New("full join is only supported with an equality condition between both sides")
+}
@@ -29,6 +29,38 @@ func NewAggregate(aggregate string) Aggregate {
 	return Aggregate(strings.ToLower(aggregate))
 }
 
+// Grouping describes how a data source should group its records.
+// Key lists the unqualified columns to group by. The grouped records contain the aggregates
+// of the unqualified column Fields, "*star*" meaning the whole record, named by the variables in As.
+// Fields aggregated with First or Last must be a part of the Key.
+type Grouping struct {
+	Key        []octosql.VariableName
+	Fields     []octosql.VariableName
+	Aggregates []Aggregate
+	As         []octosql.VariableName
+}
+
+func NewGrouping(key []octosql.VariableName, fields []octosql.VariableName, aggregates []Aggregate, as []octosql.VariableName) *Grouping {
+	return &Grouping{Key: key, Fields: fields, Aggregates: aggregates, As: as}
+}
+
+type groupMemoryLimitKey struct{}
+
+// WithGroupMemoryLimit returns a context, in which materialized group by, pivot and distinct nodes
+// keep up to approximately the given number of bytes of groups in memory, before spilling records to disk.
+func WithGroupMemoryLimit(ctx context.Context, memoryLimit int) context.Context {
+	return context.WithValue(ctx, groupMemoryLimitKey{}, memoryLimit)
+}
+
+// GroupMemoryLimit returns the group by and distinct memory limit set in the context, it defaults to execution.DefaultGroupMemoryLimit.
+func GroupMemoryLimit(ctx context.Context) int {
+	memoryLimit, ok := ctx.Value(groupMemoryLimitKey{}).(int)
+	if !ok {
+		return execution.DefaultGroupMemoryLimit
+	}
+	return memoryLimit
+}
+
 type GroupBy struct {
 	Source Node
 	Key    []Expression
@@ -87,5 +119,5 @@ func (node *GroupBy) Materialize(ctx context.Context) (execution.Node, error) {
 		aggregatePrototypes[i] = aggregates.AggregateTable[string(node.Aggregates[i])]
 	}
 
-	return execution.NewGroupBy(source, key, node.Fields, aggregatePrototypes, node.As), nil
+	return execution.NewGroupBy(source, key, node.Fields, aggregatePrototypes, node.As, GroupMemoryLimit(ctx)), nil
 }
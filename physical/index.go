@@ -0,0 +1,117 @@
+package physical
+
+import (
+	"context"
+
+	"github.com/cube2222/octosql"
+	"github.com/cube2222/octosql/execution"
+	"github.com/pkg/errors"
+)
+
+// IndexedFilters are the filters available to data sources created with NewIndexedExecutor.
+var IndexedFilters = map[FieldType]map[Relation]struct{}{
+	Primary: {},
+	Secondary: {
+		Equal:        {},
+		In:           {},
+		MoreThan:     {},
+		LessThan:     {},
+		GreaterEqual: {},
+		LessEqual:    {},
+	},
+}
+
+// NewIndexedExecutor creates a data source executor out of one creating a node, which can't filter records itself.
+// If the data source gets a filter, the node's records are loaded once per query into an in-memory table,
+// indexed on the columns used in the filter. Equalities, INs and ranges between a column
+// and an expression not using the data source's columns then become index lookups.
+// It should be used together with IndexedFilters.
+func NewIndexedExecutor(executor func(alias string, columns []octosql.VariableName) (execution.Node, error)) Executor {
+	return func(options *ExecutorOptions) (execution.Node, error) {
+		source, err := executor(options.Alias, options.Columns)
+		if err != nil {
+			return nil, err
+		}
+
+		if constant, ok := options.Filter.(*Constant); ok && constant.Value {
+			return source, nil
+		}
+
+		lookups, err := extractIndexLookups(options.Filter, options.Alias)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't extract index lookups")
+		}
+		materializedFilter, err := options.Filter.Materialize(context.Background())
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't materialize filter")
+		}
+
+		return execution.NewIndexedTable(source, lookups, materializedFilter), nil
+	}
+}
+
+// extractIndexLookups finds the predicates of the filter, which compare a column of the data source with the given alias
+// to an expression using none of its columns, with a relation an index can handle.
+func extractIndexLookups(formula Formula, alias string) ([]execution.IndexLookup, error) {
+	var lookups []execution.IndexLookup
+	for _, part := range formula.SplitByAnd() {
+		predicate, ok := part.(*Predicate)
+		if !ok {
+			continue
+		}
+
+		column, value, relation, ok := indexLookup(predicate.Left, predicate.Relation, predicate.Right, alias)
+		if !ok {
+			column, value, relation, ok = indexLookup(predicate.Right, reverseRelation(predicate.Relation), predicate.Left, alias)
+		}
+		if !ok {
+			continue
+		}
+
+		materializedValue, err := value.Materialize(context.Background())
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't materialize lookup value of %v", column.Name)
+		}
+		lookups = append(lookups, execution.IndexLookup{
+			Column:   column.Name,
+			Relation: relation.Materialize(context.Background()),
+			Value:    materializedValue,
+		})
+	}
+	return lookups, nil
+}
+
+func indexLookup(column Expression, relation Relation, value Expression, alias string) (*Variable, Expression, Relation, bool) {
+	if _, ok := IndexedFilters[Secondary][relation]; !ok {
+		return nil, nil, "", false
+	}
+	variable, ok := column.(*Variable)
+	if !ok || variable.Name.Source() != alias {
+		return nil, nil, "", false
+	}
+	for _, name := range expressionVariables(value) {
+		if name.Source() == alias {
+			return nil, nil, "", false
+		}
+	}
+
+	return variable, value, relation, true
+}
+
+// reverseRelation returns the relation with swapped operands, or an empty relation if there's no such relation.
+func reverseRelation(relation Relation) Relation {
+	switch relation {
+	case Equal:
+		return Equal
+	case MoreThan:
+		return LessThan
+	case LessThan:
+		return MoreThan
+	case GreaterEqual:
+		return LessEqual
+	case LessEqual:
+		return GreaterEqual
+	default:
+		return ""
+	}
+}
@@ -38,5 +38,5 @@ func (node *InnerJoin) Materialize(ctx context.Context) (execution.Node, error)
 		return nil, errors.Wrap(err, "couldn't materialize joined node")
 	}
 
-	return execution.NewInnerJoin(materializedSource, materializedJoined), nil
+	return execution.NewInnerJoin(materializedSource, materializedJoined, LookupPrefetch(ctx)), nil
 }
@@ -38,5 +38,5 @@ func (node *LeftJoin) Materialize(ctx context.Context) (execution.Node, error) {
 		return nil, errors.Wrap(err, "couldn't materialize joined node")
 	}
 
-	return execution.NewLeftJoin(materializedSource, materializedJoined), nil
+	return execution.NewLeftJoin(materializedSource, materializedJoined, LookupPrefetch(ctx)), nil
 }
@@ -8,18 +8,18 @@ import (
 )
 
 type Limit struct {
-	data      Node
-	limitExpr Expression
+	Source    Node
+	LimitExpr Expression
 }
 
 func NewLimit(data Node, expr Expression) *Limit {
-	return &Limit{data: data, limitExpr: expr}
+	return &Limit{Source: data, LimitExpr: expr}
 }
 
 func (node *Limit) Transform(ctx context.Context, transformers *Transformers) Node {
 	var transformed Node = &Limit{
-		data:      node.data.Transform(ctx, transformers),
-		limitExpr: node.limitExpr.Transform(ctx, transformers),
+		Source:    node.Source.Transform(ctx, transformers),
+		LimitExpr: node.LimitExpr.Transform(ctx, transformers),
 	}
 	if transformers.NodeT != nil {
 		transformed = transformers.NodeT(transformed)
@@ -28,15 +28,15 @@ func (node *Limit) Transform(ctx context.Context, transformers *Transformers) No
 }
 
 func (node *Limit) Materialize(ctx context.Context) (execution.Node, error) {
-	dataNode, err := node.data.Materialize(ctx)
+	SourceNode, err := node.Source.Materialize(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't materialize data node")
 	}
 
-	limitExpr, err := node.limitExpr.Materialize(ctx)
+	LimitExpr, err := node.LimitExpr.Materialize(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't materialize limit expression")
 	}
 
-	return execution.NewLimit(dataNode, limitExpr), nil
+	return execution.NewLimit(SourceNode, LimitExpr), nil
 }
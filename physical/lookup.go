@@ -0,0 +1,137 @@
+package physical
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cube2222/octosql"
+	"github.com/cube2222/octosql/execution"
+)
+
+type lookupPrefetchKey struct{}
+
+// WithLookupPrefetch returns a context, in which materialized lookup joins
+// fetch the joined records for the given number of source records concurrently.
+func WithLookupPrefetch(ctx context.Context, prefetch int) context.Context {
+	return context.WithValue(ctx, lookupPrefetchKey{}, prefetch)
+}
+
+// LookupPrefetch returns the lookup join prefetch set in the context, it defaults to 1, which disables prefetching.
+func LookupPrefetch(ctx context.Context) int {
+	prefetch, ok := ctx.Value(lookupPrefetchKey{}).(int)
+	if !ok {
+		return 1
+	}
+	return prefetch
+}
+
+// WithLookupCache wraps a data source executor, so that data sources filtered by non-constant variables of other sources,
+// like the joined data sources of lookup joins, cache the records of up to size records of the most recently looked up keys.
+// Only nodes implementing execution.LookupKeyNode and not joining other tables are cached, a size of 0 disables the cache.
+func WithLookupCache(executor Executor, size int) Executor {
+	return func(options *ExecutorOptions) (execution.Node, error) {
+		node, err := executor(options)
+		if err != nil || size <= 0 || options.Sampling != nil || options.Limit != nil || len(options.Joins) > 0 {
+			return node, err
+		}
+
+		keyNode, ok := node.(execution.LookupKeyNode)
+		if !ok {
+			return node, nil
+		}
+		for _, predicate := range options.Filter.ExtractPredicates() {
+			if usesOtherSources(predicate.Left, options.Alias) || usesOtherSources(predicate.Right, options.Alias) {
+				return execution.NewLookupCache(keyNode, size), nil
+			}
+		}
+		return node, nil
+	}
+}
+
+// ExtractLookupKeys splits a data source filter into lookup keys and the rest of the formula.
+// A lookup key is an equality between a column of the data source with the given alias
+// and an expression, which uses non-constant variables of other sources, like a lookup join's source record.
+// It returns false if there are no lookup keys,
+// or if the rest of the formula uses non-constant variables of other sources too.
+func ExtractLookupKeys(formula Formula, alias string) ([]*Variable, []Expression, Formula, bool) {
+	var columns []*Variable
+	var values []Expression
+	var rest []Formula
+
+	for _, part := range formula.SplitByAnd() {
+		if predicate, ok := part.(*Predicate); ok && predicate.Relation == Equal {
+			if column, value, ok := lookupKey(predicate.Left, predicate.Right, alias); ok {
+				columns = append(columns, column)
+				values = append(values, value)
+				continue
+			}
+			if column, value, ok := lookupKey(predicate.Right, predicate.Left, alias); ok {
+				columns = append(columns, column)
+				values = append(values, value)
+				continue
+			}
+		}
+
+		for _, predicate := range part.ExtractPredicates() {
+			if usesOtherSources(predicate.Left, alias) || usesOtherSources(predicate.Right, alias) {
+				return nil, nil, nil, false
+			}
+		}
+		rest = append(rest, part)
+	}
+
+	if len(columns) == 0 {
+		return nil, nil, nil, false
+	}
+
+	if len(rest) == 0 {
+		return columns, values, NewConstant(true), true
+	}
+	for len(rest) > 1 {
+		rest[1] = NewAnd(rest[0], rest[1])
+		rest = rest[1:]
+	}
+
+	return columns, values, rest[0], true
+}
+
+func lookupKey(column Expression, value Expression, alias string) (*Variable, Expression, bool) {
+	variable, ok := column.(*Variable)
+	if !ok || variable.Name.Source() != alias {
+		return nil, nil, false
+	}
+
+	foundOtherSource := false
+	for _, name := range expressionVariables(value) {
+		if name.Source() == alias {
+			return nil, nil, false
+		}
+		if !strings.HasPrefix(name.Name(), "const_") {
+			foundOtherSource = true
+		}
+	}
+
+	return variable, value, foundOtherSource
+}
+
+func usesOtherSources(expr Expression, alias string) bool {
+	for _, name := range expressionVariables(expr) {
+		if name.Source() != alias && !strings.HasPrefix(name.Name(), "const_") {
+			return true
+		}
+	}
+	return false
+}
+
+func expressionVariables(expr Expression) []octosql.VariableName {
+	var out []octosql.VariableName
+	expr.Transform(context.Background(), &Transformers{
+		NamedExprT: func(expr NamedExpression) NamedExpression {
+			if variable, ok := expr.(*Variable); ok {
+				out = append(out, variable.Name)
+			}
+			return expr
+		},
+	})
+	return out
+}
@@ -47,5 +47,5 @@ func (node *Map) Materialize(ctx context.Context) (execution.Node, error) {
 		return nil, errors.Wrap(err, "couldn't materialize Source node")
 	}
 
-	return execution.NewMap(matExprs, materialized, node.Keep), nil
+	return execution.NewMap(matExprs, materialized, node.Keep, Parallelism(ctx)), nil
 }
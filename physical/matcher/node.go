@@ -147,3 +147,298 @@ func (m *DataSourceBuilderMatcher) Match(match *Match, node physical.Node) bool
 	}
 	return true
 }
+
+// TableSampleMatcher matches a table sample with the given attribute matches.
+type TableSampleMatcher struct {
+	Name   string
+	Source NodeMatcher
+}
+
+func (m *TableSampleMatcher) Match(match *Match, node physical.Node) bool {
+	tableSample, ok := node.(*physical.TableSample)
+	if !ok {
+		return false
+	}
+	if m.Source != nil {
+		matched := m.Source.Match(match, tableSample.Source)
+		if !matched {
+			return false
+		}
+	}
+	if len(m.Name) > 0 {
+		match.Nodes[m.Name] = node
+	}
+	return true
+}
+
+// InnerJoinMatcher matches an inner join with the given attribute matches.
+type InnerJoinMatcher struct {
+	Name   string
+	Source NodeMatcher
+	Joined NodeMatcher
+}
+
+func (m *InnerJoinMatcher) Match(match *Match, node physical.Node) bool {
+	join, ok := node.(*physical.InnerJoin)
+	if !ok {
+		return false
+	}
+	if m.Source != nil {
+		matched := m.Source.Match(match, join.Source)
+		if !matched {
+			return false
+		}
+	}
+	if m.Joined != nil {
+		matched := m.Joined.Match(match, join.Joined)
+		if !matched {
+			return false
+		}
+	}
+	if len(m.Name) > 0 {
+		match.Nodes[m.Name] = node
+	}
+	return true
+}
+
+// LeftJoinMatcher matches a left join with the given attribute matches.
+type LeftJoinMatcher struct {
+	Name   string
+	Source NodeMatcher
+	Joined NodeMatcher
+}
+
+func (m *LeftJoinMatcher) Match(match *Match, node physical.Node) bool {
+	join, ok := node.(*physical.LeftJoin)
+	if !ok {
+		return false
+	}
+	if m.Source != nil {
+		matched := m.Source.Match(match, join.Source)
+		if !matched {
+			return false
+		}
+	}
+	if m.Joined != nil {
+		matched := m.Joined.Match(match, join.Joined)
+		if !matched {
+			return false
+		}
+	}
+	if len(m.Name) > 0 {
+		match.Nodes[m.Name] = node
+	}
+	return true
+}
+
+// FullJoinMatcher matches a full join with the given attribute matches.
+type FullJoinMatcher struct {
+	Name      string
+	Source    NodeMatcher
+	Joined    NodeMatcher
+	Condition FormulaMatcher
+}
+
+func (m *FullJoinMatcher) Match(match *Match, node physical.Node) bool {
+	join, ok := node.(*physical.FullJoin)
+	if !ok {
+		return false
+	}
+	if m.Source != nil {
+		matched := m.Source.Match(match, join.Source)
+		if !matched {
+			return false
+		}
+	}
+	if m.Joined != nil {
+		matched := m.Joined.Match(match, join.Joined)
+		if !matched {
+			return false
+		}
+	}
+	if m.Condition != nil {
+		matched := m.Condition.Match(match, join.Condition)
+		if !matched {
+			return false
+		}
+	}
+	if len(m.Name) > 0 {
+		match.Nodes[m.Name] = node
+	}
+	return true
+}
+
+// HashJoinMatcher matches a hash join with the given attribute matches.
+type HashJoinMatcher struct {
+	Name   string
+	Source NodeMatcher
+	Joined NodeMatcher
+}
+
+func (m *HashJoinMatcher) Match(match *Match, node physical.Node) bool {
+	join, ok := node.(*physical.HashJoin)
+	if !ok {
+		return false
+	}
+	if m.Source != nil {
+		matched := m.Source.Match(match, join.Source)
+		if !matched {
+			return false
+		}
+	}
+	if m.Joined != nil {
+		matched := m.Joined.Match(match, join.Joined)
+		if !matched {
+			return false
+		}
+	}
+	if len(m.Name) > 0 {
+		match.Nodes[m.Name] = node
+	}
+	return true
+}
+
+// LimitMatcher matches a limit with the given attribute matches.
+type LimitMatcher struct {
+	Name   string
+	Source NodeMatcher
+}
+
+func (m *LimitMatcher) Match(match *Match, node physical.Node) bool {
+	limit, ok := node.(*physical.Limit)
+	if !ok {
+		return false
+	}
+	if m.Source != nil {
+		matched := m.Source.Match(match, limit.Source)
+		if !matched {
+			return false
+		}
+	}
+	if len(m.Name) > 0 {
+		match.Nodes[m.Name] = node
+	}
+	return true
+}
+
+// OffsetMatcher matches an offset with the given attribute matches.
+type OffsetMatcher struct {
+	Name   string
+	Source NodeMatcher
+}
+
+func (m *OffsetMatcher) Match(match *Match, node physical.Node) bool {
+	offset, ok := node.(*physical.Offset)
+	if !ok {
+		return false
+	}
+	if m.Source != nil {
+		matched := m.Source.Match(match, offset.Source)
+		if !matched {
+			return false
+		}
+	}
+	if len(m.Name) > 0 {
+		match.Nodes[m.Name] = node
+	}
+	return true
+}
+
+// GroupByMatcher matches a group by with the given attribute matches.
+type GroupByMatcher struct {
+	Name   string
+	Source NodeMatcher
+}
+
+func (m *GroupByMatcher) Match(match *Match, node physical.Node) bool {
+	groupBy, ok := node.(*physical.GroupBy)
+	if !ok {
+		return false
+	}
+	if m.Source != nil {
+		matched := m.Source.Match(match, groupBy.Source)
+		if !matched {
+			return false
+		}
+	}
+	if len(m.Name) > 0 {
+		match.Nodes[m.Name] = node
+	}
+	return true
+}
+
+// OrderByMatcher matches an order by with the given attribute matches.
+type OrderByMatcher struct {
+	Name   string
+	Source NodeMatcher
+}
+
+func (m *OrderByMatcher) Match(match *Match, node physical.Node) bool {
+	orderBy, ok := node.(*physical.OrderBy)
+	if !ok {
+		return false
+	}
+	if m.Source != nil {
+		matched := m.Source.Match(match, orderBy.Source)
+		if !matched {
+			return false
+		}
+	}
+	if len(m.Name) > 0 {
+		match.Nodes[m.Name] = node
+	}
+	return true
+}
+
+// DistinctMatcher matches a distinct with the given attribute matches.
+type DistinctMatcher struct {
+	Name  string
+	Child NodeMatcher
+}
+
+func (m *DistinctMatcher) Match(match *Match, node physical.Node) bool {
+	distinct, ok := node.(*physical.Distinct)
+	if !ok {
+		return false
+	}
+	if m.Child != nil {
+		matched := m.Child.Match(match, distinct.Child)
+		if !matched {
+			return false
+		}
+	}
+	if len(m.Name) > 0 {
+		match.Nodes[m.Name] = node
+	}
+	return true
+}
+
+// UnionAllMatcher matches a union all with the given attribute matches.
+type UnionAllMatcher struct {
+	Name   string
+	First  NodeMatcher
+	Second NodeMatcher
+}
+
+func (m *UnionAllMatcher) Match(match *Match, node physical.Node) bool {
+	unionAll, ok := node.(*physical.UnionAll)
+	if !ok {
+		return false
+	}
+	if m.First != nil {
+		matched := m.First.Match(match, unionAll.First)
+		if !matched {
+			return false
+		}
+	}
+	if m.Second != nil {
+		matched := m.Second.Match(match, unionAll.Second)
+		if !matched {
+			return false
+		}
+	}
+	if len(m.Name) > 0 {
+		match.Nodes[m.Name] = node
+	}
+	return true
+}
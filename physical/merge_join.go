@@ -0,0 +1,90 @@
+package physical
+
+import (
+	"context"
+
+	"github.com/cube2222/octosql/execution"
+	"github.com/pkg/errors"
+)
+
+// MergeJoin joins the records of its source and joined nodes on equal keys,
+// streaming through both of them at once, so both must already be sorted by their keys in ascending order.
+// The joined node gets read once, so it mustn't depend on the source records.
+type MergeJoin struct {
+	Source    Node
+	Joined    Node
+	SourceKey []Expression
+	JoinedKey []Expression
+	Filter    Formula
+	JoinType  JoinType
+}
+
+func NewMergeJoin(source Node, joined Node, sourceKey []Expression, joinedKey []Expression, filter Formula, joinType JoinType) *MergeJoin {
+	return &MergeJoin{
+		Source:    source,
+		Joined:    joined,
+		SourceKey: sourceKey,
+		JoinedKey: joinedKey,
+		Filter:    filter,
+		JoinType:  joinType,
+	}
+}
+
+func (node *MergeJoin) Transform(ctx context.Context, transformers *Transformers) Node {
+	sourceKey := make([]Expression, len(node.SourceKey))
+	for i := range node.SourceKey {
+		sourceKey[i] = node.SourceKey[i].Transform(ctx, transformers)
+	}
+	joinedKey := make([]Expression, len(node.JoinedKey))
+	for i := range node.JoinedKey {
+		joinedKey[i] = node.JoinedKey[i].Transform(ctx, transformers)
+	}
+
+	var transformed Node = &MergeJoin{
+		Source:    node.Source.Transform(ctx, transformers),
+		Joined:    node.Joined.Transform(ctx, transformers),
+		SourceKey: sourceKey,
+		JoinedKey: joinedKey,
+		Filter:    node.Filter.Transform(ctx, transformers),
+		JoinType:  node.JoinType,
+	}
+	if transformers.NodeT != nil {
+		transformed = transformers.NodeT(transformed)
+	}
+	return transformed
+}
+
+func (node *MergeJoin) Materialize(ctx context.Context) (execution.Node, error) {
+	materializedSource, err := node.Source.Materialize(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't materialize source node")
+	}
+
+	materializedJoined, err := node.Joined.Materialize(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't materialize joined node")
+	}
+
+	sourceKey := make([]execution.Expression, len(node.SourceKey))
+	for i := range node.SourceKey {
+		sourceKey[i], err = node.SourceKey[i].Materialize(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't materialize source key expression with index %v", i)
+		}
+	}
+
+	joinedKey := make([]execution.Expression, len(node.JoinedKey))
+	for i := range node.JoinedKey {
+		joinedKey[i], err = node.JoinedKey[i].Materialize(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't materialize joined key expression with index %v", i)
+		}
+	}
+
+	materializedFilter, err := node.Filter.Materialize(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't materialize join filter")
+	}
+
+	return execution.NewMergeJoin(materializedSource, materializedJoined, sourceKey, joinedKey, materializedFilter, execution.JoinType(node.JoinType)), nil
+}
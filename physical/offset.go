@@ -8,18 +8,18 @@ import (
 )
 
 type Offset struct {
-	data       Node
-	offsetExpr Expression
+	Source     Node
+	OffsetExpr Expression
 }
 
 func NewOffset(data Node, expr Expression) *Offset {
-	return &Offset{data: data, offsetExpr: expr}
+	return &Offset{Source: data, OffsetExpr: expr}
 }
 
 func (node *Offset) Transform(ctx context.Context, transformers *Transformers) Node {
 	var transformed Node = &Offset{
-		data:       node.data.Transform(ctx, transformers),
-		offsetExpr: node.offsetExpr.Transform(ctx, transformers),
+		Source:     node.Source.Transform(ctx, transformers),
+		OffsetExpr: node.OffsetExpr.Transform(ctx, transformers),
 	}
 	if transformers.NodeT != nil {
 		transformed = transformers.NodeT(transformed)
@@ -28,15 +28,15 @@ func (node *Offset) Transform(ctx context.Context, transformers *Transformers) N
 }
 
 func (node *Offset) Materialize(ctx context.Context) (execution.Node, error) {
-	dataNode, err := node.data.Materialize(ctx)
+	SourceNode, err := node.Source.Materialize(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't materialize data node")
 	}
 
-	offsetExpr, err := node.offsetExpr.Materialize(ctx)
+	OffsetExpr, err := node.OffsetExpr.Materialize(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't materialize offset expression")
 	}
 
-	return execution.NewOffset(dataNode, offsetExpr), nil
+	return execution.NewOffset(SourceNode, OffsetExpr), nil
 }
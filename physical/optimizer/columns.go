@@ -0,0 +1,364 @@
+package optimizer
+
+import (
+	"context"
+	"sort"
+
+	"github.com/cube2222/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+// PruneColumns passes the columns, which are actually used in the plan, down to its data source builders,
+// so that they only read those. It also removes unused map expressions,
+// and stops maps from keeping source fields which aren't used above them.
+// The records of the plan itself keep all of their fields.
+func PruneColumns(ctx context.Context, plan physical.Node) physical.Node {
+	return pruneColumns(ctx, plan, nil)
+}
+
+// neededVariables is the set of variables the records of a node need to contain, nil meaning all of them.
+type neededVariables map[octosql.VariableName]struct{}
+
+// with returns the set extended with the given variables.
+func (needed neededVariables) with(vars ...[]octosql.VariableName) neededVariables {
+	if needed == nil {
+		return nil
+	}
+
+	out := make(neededVariables, len(needed))
+	for varname := range needed {
+		out[varname] = struct{}{}
+	}
+	for i := range vars {
+		for _, varname := range vars[i] {
+			out[varname] = struct{}{}
+		}
+	}
+	return out
+}
+
+func (needed neededVariables) sorted() []octosql.VariableName {
+	out := make([]octosql.VariableName, 0, len(needed))
+	for varname := range needed {
+		out = append(out, varname)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i] < out[j]
+	})
+	return out
+}
+
+// pruneColumns prunes the node, whose records only need to contain the given variables.
+// Nodes it doesn't know are left as they are, together with their sources.
+func pruneColumns(ctx context.Context, node physical.Node, needed neededVariables) physical.Node {
+	switch node := node.(type) {
+	case *physical.DataSourceBuilder:
+		// The columns of grouped records are given by their grouping.
+		if needed == nil || node.Grouping != nil {
+			return node
+		}
+		needed = needed.with(nonConstantVariables(node.Filter))
+		columns := make(neededVariables)
+		for varname := range needed {
+			if varname.Source() == node.Alias {
+				columns[octosql.NewVariableName(varname.Name())] = struct{}{}
+			}
+		}
+
+		var joins []*physical.TableJoin
+		for _, join := range node.Joins {
+			joinColumns := make(neededVariables)
+			for varname := range needed {
+				if varname.Source() == join.Alias {
+					joinColumns[octosql.NewVariableName(varname.Name())] = struct{}{}
+				}
+			}
+			joins = append(joins, &physical.TableJoin{
+				Table:    join.Table,
+				Alias:    join.Alias,
+				Filter:   join.Filter,
+				JoinType: join.JoinType,
+				Columns:  joinColumns.sorted(),
+			})
+		}
+
+		out := node.Clone()
+		out.Columns = columns.sorted()
+		out.Joins = joins
+		return out
+
+	case *physical.Requalifier:
+		return &physical.Requalifier{
+			Qualifier: node.Qualifier,
+			Source:    pruneColumns(ctx, node.Source, unqualifyNeeded(needed, node.Qualifier, node.Source)),
+		}
+
+	case *physical.Filter:
+		return &physical.Filter{
+			Formula: node.Formula,
+			Source:  pruneColumns(ctx, node.Source, needed.with(nonConstantVariables(node.Formula))),
+		}
+
+	case *physical.Map:
+		return pruneMapColumns(ctx, node, needed)
+
+	case *physical.GroupBy:
+		return &physical.GroupBy{
+			Source:     pruneColumns(ctx, node.Source, groupByNeeded(ctx, node)),
+			Key:        node.Key,
+			Fields:     node.Fields,
+			Aggregates: node.Aggregates,
+			As:         node.As,
+		}
+
+	case *physical.OrderBy:
+		return &physical.OrderBy{
+			Expressions: node.Expressions,
+			Directions:  node.Directions,
+			Source:      pruneColumns(ctx, node.Source, needed.with(expressionsVariables(ctx, node.Expressions...))),
+		}
+
+	case *physical.TopN:
+		return &physical.TopN{
+			Expressions: node.Expressions,
+			Directions:  node.Directions,
+			Limit:       node.Limit,
+			Offset:      node.Offset,
+			Source:      pruneColumns(ctx, node.Source, needed.with(expressionsVariables(ctx, node.Expressions...), expressionsVariables(ctx, node.Limit, node.Offset))),
+		}
+
+	case *physical.Limit:
+		return &physical.Limit{
+			Source:    pruneColumns(ctx, node.Source, needed.with(expressionsVariables(ctx, node.LimitExpr))),
+			LimitExpr: node.LimitExpr,
+		}
+
+	case *physical.Offset:
+		return &physical.Offset{
+			Source:     pruneColumns(ctx, node.Source, needed.with(expressionsVariables(ctx, node.OffsetExpr))),
+			OffsetExpr: node.OffsetExpr,
+		}
+
+	case *physical.Distinct:
+		// Distinct compares whole records, so all of their fields are needed.
+		return &physical.Distinct{
+			Child: pruneColumns(ctx, node.Child, nil),
+		}
+
+	case *physical.DistinctOn:
+		return &physical.DistinctOn{
+			Key:    node.Key,
+			Source: pruneColumns(ctx, node.Source, needed.with(expressionsVariables(ctx, node.Key...))),
+		}
+
+	case *physical.TableSample:
+		return &physical.TableSample{
+			Sampling: node.Sampling,
+			Source:   pruneColumns(ctx, node.Source, needed),
+		}
+
+	case *physical.UnionAll:
+		return &physical.UnionAll{
+			First:  pruneColumns(ctx, node.First, needed),
+			Second: pruneColumns(ctx, node.Second, needed),
+		}
+
+	case *physical.InnerJoin:
+		// The joined node gets the source records as variables.
+		joined := pruneColumns(ctx, node.Joined, needed)
+		return &physical.InnerJoin{
+			Source: pruneColumns(ctx, node.Source, needed.with(nodeVariables(ctx, joined))),
+			Joined: joined,
+		}
+
+	case *physical.LeftJoin:
+		joined := pruneColumns(ctx, node.Joined, needed)
+		return &physical.LeftJoin{
+			Source: pruneColumns(ctx, node.Source, needed.with(nodeVariables(ctx, joined))),
+			Joined: joined,
+		}
+
+	case *physical.FullJoin:
+		joined := pruneColumns(ctx, node.Joined, needed.with(nonConstantVariables(node.Condition)))
+		return &physical.FullJoin{
+			Source:    pruneColumns(ctx, node.Source, needed.with(nonConstantVariables(node.Condition), nodeVariables(ctx, joined))),
+			Joined:    joined,
+			Condition: node.Condition,
+		}
+
+	case *physical.HashJoin:
+		return &physical.HashJoin{
+			Source:    pruneColumns(ctx, node.Source, needed.with(expressionsVariables(ctx, node.SourceKey...), nonConstantVariables(node.Filter))),
+			Joined:    pruneColumns(ctx, node.Joined, needed.with(expressionsVariables(ctx, node.JoinedKey...), nonConstantVariables(node.Filter))),
+			SourceKey: node.SourceKey,
+			JoinedKey: node.JoinedKey,
+			Filter:    node.Filter,
+			JoinType:  node.JoinType,
+		}
+
+	case *physical.MergeJoin:
+		return &physical.MergeJoin{
+			Source:    pruneColumns(ctx, node.Source, needed.with(expressionsVariables(ctx, node.SourceKey...), nonConstantVariables(node.Filter))),
+			Joined:    pruneColumns(ctx, node.Joined, needed.with(expressionsVariables(ctx, node.JoinedKey...), nonConstantVariables(node.Filter))),
+			SourceKey: node.SourceKey,
+			JoinedKey: node.JoinedKey,
+			Filter:    node.Filter,
+			JoinType:  node.JoinType,
+		}
+
+	default:
+		return node
+	}
+}
+
+// pruneMapColumns removes the expressions of the map which aren't needed.
+// If the map keeps its source fields and the qualifiers of the source are known,
+// it's changed to only pass on the needed source fields instead.
+func pruneMapColumns(ctx context.Context, node *physical.Map, needed neededVariables) physical.Node {
+	if needed == nil {
+		var sourceNeeded neededVariables
+		if !node.Keep {
+			sourceNeeded = neededVariables{}.with(namedExpressionsVariables(ctx, node.Expressions))
+		}
+		return &physical.Map{
+			Expressions: node.Expressions,
+			Source:      pruneColumns(ctx, node.Source, sourceNeeded),
+			Keep:        node.Keep,
+		}
+	}
+
+	var expressions []physical.NamedExpression
+	mapped := make(neededVariables)
+	for _, expr := range node.Expressions {
+		name, ok := namedExpressionName(expr)
+		if ok {
+			if _, isNeeded := needed[name]; !isNeeded {
+				continue
+			}
+			mapped[name] = struct{}{}
+		}
+		expressions = append(expressions, expr)
+	}
+	sourceNeeded := neededVariables{}.with(namedExpressionsVariables(ctx, expressions))
+
+	if !node.Keep {
+		return &physical.Map{
+			Expressions: expressions,
+			Source:      pruneColumns(ctx, node.Source, sourceNeeded),
+			Keep:        false,
+		}
+	}
+
+	var kept []octosql.VariableName
+	for varname := range needed {
+		if _, ok := mapped[varname]; !ok {
+			kept = append(kept, varname)
+		}
+	}
+	sourceNeeded = sourceNeeded.with(kept)
+
+	sourceQualifiers, ok := physical.Qualifiers(node.Source)
+	if !ok {
+		return &physical.Map{
+			Expressions: expressions,
+			Source:      pruneColumns(ctx, node.Source, sourceNeeded),
+			Keep:        true,
+		}
+	}
+
+	// Only the variables with the qualifiers of the source can be its fields, the others come from outside the map.
+	for _, varname := range (neededVariables{}).with(kept).sorted() {
+		if _, ok := sourceQualifiers[varname.Source()]; ok {
+			expressions = append(expressions, physical.NewVariable(varname))
+		}
+	}
+	return &physical.Map{
+		Expressions: expressions,
+		Source:      pruneColumns(ctx, node.Source, sourceNeeded),
+		Keep:        false,
+	}
+}
+
+// unqualifyNeeded returns the variables the source of a requalifier needs to contain.
+// They're only known if the source has a single qualifier.
+func unqualifyNeeded(needed neededVariables, qualifier string, source physical.Node) neededVariables {
+	if needed == nil {
+		return nil
+	}
+	sourceQualifiers, ok := physical.Qualifiers(source)
+	if !ok || len(sourceQualifiers) != 1 {
+		return nil
+	}
+	var sourceQualifier string
+	for q := range sourceQualifiers {
+		sourceQualifier = q
+	}
+
+	out := make(neededVariables)
+	for varname := range needed {
+		if varname.Source() == qualifier {
+			out[requalify(varname, qualifier, sourceQualifier)] = struct{}{}
+		}
+	}
+	return out
+}
+
+// groupByNeeded returns the variables the source of a group by needs to contain.
+// Aggregates of whole records, other than counts, need all of them.
+func groupByNeeded(ctx context.Context, node *physical.GroupBy) neededVariables {
+	out := neededVariables{}.with(expressionsVariables(ctx, node.Key...))
+	for i := range node.Fields {
+		if node.Fields[i] == "*star*" {
+			if node.Aggregates[i] != physical.Count {
+				return nil
+			}
+			continue
+		}
+		out[node.Fields[i]] = struct{}{}
+	}
+	return out
+}
+
+func namedExpressionName(expr physical.NamedExpression) (octosql.VariableName, bool) {
+	switch expr := expr.(type) {
+	case *physical.Variable:
+		return expr.Name, true
+	case *physical.AliasedExpression:
+		return expr.Name, true
+	default:
+		return "", false
+	}
+}
+
+// expressionsVariables returns the variables used by the expressions, which may be nil.
+func expressionsVariables(ctx context.Context, exprs ...physical.Expression) []octosql.VariableName {
+	var out []octosql.VariableName
+	for _, expr := range exprs {
+		if expr != nil {
+			out = append(out, GetVariables(ctx, expr)...)
+		}
+	}
+	return out
+}
+
+func namedExpressionsVariables(ctx context.Context, exprs []physical.NamedExpression) []octosql.VariableName {
+	var out []octosql.VariableName
+	for _, expr := range exprs {
+		out = append(out, GetVariables(ctx, expr)...)
+	}
+	return out
+}
+
+// nodeVariables returns all the variables used in the node and its sources.
+func nodeVariables(ctx context.Context, node physical.Node) []octosql.VariableName {
+	var out []octosql.VariableName
+	node.Transform(ctx, &physical.Transformers{
+		NamedExprT: func(expr physical.NamedExpression) physical.NamedExpression {
+			if variable, ok := expr.(*physical.Variable); ok {
+				out = append(out, variable.Name)
+			}
+			return expr
+		},
+	})
+	return out
+}
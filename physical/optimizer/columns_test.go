@@ -0,0 +1,175 @@
+package optimizer
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/cube2222/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+func TestPruneColumns(t *testing.T) {
+	dataSource := func(alias string, filter physical.Formula, columns ...octosql.VariableName) *physical.DataSourceBuilder {
+		return &physical.DataSourceBuilder{
+			Filter:  filter,
+			Columns: columns,
+			Alias:   alias,
+		}
+	}
+	ageFilter := physical.NewPredicate(physical.NewVariable("a.age"), physical.MoreThan, physical.NewVariable("const_0"))
+	joinFilter := physical.NewPredicate(physical.NewVariable("b.id"), physical.Equal, physical.NewVariable("a.id"))
+
+	type args struct {
+		plan physical.Node
+	}
+	tests := []struct {
+		name string
+		args args
+		want physical.Node
+	}{
+		{
+			name: "map over data source",
+			args: args{
+				plan: physical.NewMap(
+					[]physical.NamedExpression{physical.NewVariable("a.name")},
+					dataSource("a", physical.NewConstant(true)),
+					false,
+				),
+			},
+			want: physical.NewMap(
+				[]physical.NamedExpression{physical.NewVariable("a.name")},
+				dataSource("a", physical.NewConstant(true), "name"),
+				false,
+			),
+		},
+		{
+			name: "filtered data source",
+			args: args{
+				plan: physical.NewMap(
+					[]physical.NamedExpression{physical.NewVariable("a.name")},
+					dataSource("a", ageFilter),
+					false,
+				),
+			},
+			want: physical.NewMap(
+				[]physical.NamedExpression{physical.NewVariable("a.name")},
+				dataSource("a", ageFilter, "age", "name"),
+				false,
+			),
+		},
+		{
+			name: "unused map expression and kept fields",
+			args: args{
+				plan: physical.NewMap(
+					[]physical.NamedExpression{physical.NewVariable("a.name")},
+					physical.NewMap(
+						[]physical.NamedExpression{physical.NewAliasedExpression("older", physical.NewVariable("a.age"))},
+						dataSource("a", physical.NewConstant(true)),
+						true,
+					),
+					false,
+				),
+			},
+			want: physical.NewMap(
+				[]physical.NamedExpression{physical.NewVariable("a.name")},
+				physical.NewMap(
+					[]physical.NamedExpression{physical.NewVariable("a.name")},
+					dataSource("a", physical.NewConstant(true), "name"),
+					false,
+				),
+				false,
+			),
+		},
+		{
+			name: "lookup join",
+			args: args{
+				plan: physical.NewMap(
+					[]physical.NamedExpression{physical.NewVariable("a.name")},
+					physical.NewInnerJoin(
+						dataSource("a", physical.NewConstant(true)),
+						dataSource("b", joinFilter),
+					),
+					false,
+				),
+			},
+			want: physical.NewMap(
+				[]physical.NamedExpression{physical.NewVariable("a.name")},
+				physical.NewInnerJoin(
+					dataSource("a", physical.NewConstant(true), "id", "name"),
+					dataSource("b", joinFilter, "id"),
+				),
+				false,
+			),
+		},
+		{
+			name: "tables joined in database",
+			args: args{
+				plan: physical.NewMap(
+					[]physical.NamedExpression{physical.NewVariable("a.name"), physical.NewVariable("b.city")},
+					&physical.DataSourceBuilder{
+						Filter: ageFilter,
+						Alias:  "a",
+						Joins: []*physical.TableJoin{
+							{Table: "cities", Alias: "b", Filter: joinFilter, JoinType: physical.InnerJoinType},
+						},
+					},
+					false,
+				),
+			},
+			want: physical.NewMap(
+				[]physical.NamedExpression{physical.NewVariable("a.name"), physical.NewVariable("b.city")},
+				&physical.DataSourceBuilder{
+					Filter:  ageFilter,
+					Columns: []octosql.VariableName{"age", "name"},
+					Alias:   "a",
+					Joins: []*physical.TableJoin{
+						{Table: "cities", Alias: "b", Filter: joinFilter, JoinType: physical.InnerJoinType, Columns: []octosql.VariableName{"city"}},
+					},
+				},
+				false,
+			),
+		},
+		{
+			name: "group by with count of records",
+			args: args{
+				plan: physical.NewGroupBy(
+					dataSource("a", physical.NewConstant(true)),
+					[]physical.Expression{physical.NewVariable("a.city")},
+					[]octosql.VariableName{"a.city", "*star*"},
+					[]physical.Aggregate{physical.First, physical.Count},
+					[]octosql.VariableName{"a.city", "count"},
+				),
+			},
+			want: physical.NewGroupBy(
+				dataSource("a", physical.NewConstant(true), "city"),
+				[]physical.Expression{physical.NewVariable("a.city")},
+				[]octosql.VariableName{"a.city", "*star*"},
+				[]physical.Aggregate{physical.First, physical.Count},
+				[]octosql.VariableName{"a.city", "count"},
+			),
+		},
+		{
+			name: "distinct",
+			args: args{
+				plan: physical.NewMap(
+					[]physical.NamedExpression{physical.NewVariable("a.name")},
+					physical.NewDistinct(dataSource("a", physical.NewConstant(true))),
+					false,
+				),
+			},
+			want: physical.NewMap(
+				[]physical.NamedExpression{physical.NewVariable("a.name")},
+				physical.NewDistinct(dataSource("a", physical.NewConstant(true))),
+				false,
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PruneColumns(context.Background(), tt.args.plan); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PruneColumns() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package optimizer
 
 import (
 	"context"
+	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/cube2222/octosql"
@@ -14,7 +16,27 @@ var DefaultScenarios = []Scenario{
 	MergeFilters,
 	MergeDataSourceBuilderWithRequalifier,
 	MergeDataSourceBuilderWithFilter,
+	MergeDataSourceBuilderWithTableSample,
+	MergeDataSourceBuilderWithGroupBy,
+	MergeDataSourceBuilderWithOrderBy,
+	MergeDataSourceBuilderWithLimit,
 	PushFilterBelowMap,
+	PushFilterBelowJoin,
+	PushFilterBelowGroupBy,
+	PushFilterBelowOrderBy,
+	PushFilterBelowDistinct,
+	PushFilterBelowRequalifier,
+	PushFilterBelowUnionAll,
+	PushLimitBelowMap,
+	PushOffsetBelowMap,
+	PushLimitBelowUnionAll,
+	MergeDataSourceBuildersOfInnerJoin,
+	MergeDataSourceBuildersOfLeftJoin,
+	UseHashJoinForInnerJoin,
+	UseHashJoinForLeftJoin,
+	UseHashJoinForFullJoin,
+	UseMergeJoinForSortedInputs,
+	UseTopNForLimitedOrderBy,
 }
 
 var MergeRequalifiers = Scenario{
@@ -73,16 +95,21 @@ var MergeDataSourceBuilderWithRequalifier = Scenario{
 			Name: "data_source_builder",
 		},
 	},
+	CandidateApprover: func(match *Match) bool {
+		ds := match.Nodes["data_source_builder"].(*physical.DataSourceBuilder)
+
+		// The fields of grouped and joined records aren't all qualified by the alias.
+		return ds.Grouping == nil && len(ds.Joins) == 0
+	},
 	Reassembler: func(match *Match) physical.Node {
 		dataSourceBuilder := match.Nodes["data_source_builder"].(*physical.DataSourceBuilder)
 
-		return &physical.DataSourceBuilder{
-			Executor:         dataSourceBuilder.Executor,
-			PrimaryKeys:      dataSourceBuilder.PrimaryKeys,
-			AvailableFilters: dataSourceBuilder.AvailableFilters,
-			Filter:           dataSourceBuilder.Filter, // TODO: fixme variable names
-			Alias:            match.Strings["qualifier"],
-		}
+		out := dataSourceBuilder.Clone()
+		out.Filter = renameVariables(dataSourceBuilder.Filter, func(name octosql.VariableName) octosql.VariableName {
+			return requalify(name, dataSourceBuilder.Alias, match.Strings["qualifier"])
+		})
+		out.Alias = match.Strings["qualifier"]
+		return out
 	},
 }
 
@@ -101,6 +128,11 @@ var MergeDataSourceBuilderWithFilter = Scenario{
 		filters := match.Formulas["parent_filter"].SplitByAnd()
 		ds := match.Nodes["data_source_builder"].(*physical.DataSourceBuilder)
 
+		// The limit and grouping are applied after the data source's filter.
+		if ds.Limit != nil || ds.Grouping != nil {
+			return false
+		}
+
 	filterChecker:
 		for _, filter := range filters {
 			predicates := filter.ExtractPredicates()
@@ -114,12 +146,12 @@ var MergeDataSourceBuilderWithFilter = Scenario{
 				localVarsRight := make([]octosql.VariableName, 0)
 
 				for i := range varsLeft {
-					if varsLeft[i].Source() == ds.Alias {
+					if isDataSourceColumn(ds, varsLeft[i]) {
 						localVarsLeft = append(localVarsLeft, varsLeft[i])
 					}
 				}
 				for i := range varsRight {
-					if varsRight[i].Source() == ds.Alias {
+					if isDataSourceColumn(ds, varsRight[i]) {
 						localVarsRight = append(localVarsRight, varsRight[i])
 					}
 				}
@@ -168,12 +200,12 @@ var MergeDataSourceBuilderWithFilter = Scenario{
 				localVarsRight := make([]octosql.VariableName, 0)
 
 				for i := range varsLeft {
-					if varsLeft[i].Source() == ds.Alias {
+					if isDataSourceColumn(ds, varsLeft[i]) {
 						localVarsLeft = append(localVarsLeft, varsLeft[i])
 					}
 				}
 				for i := range varsRight {
-					if varsRight[i].Source() == ds.Alias {
+					if isDataSourceColumn(ds, varsRight[i]) {
 						localVarsRight = append(localVarsRight, varsRight[i])
 					}
 				}
@@ -207,13 +239,9 @@ var MergeDataSourceBuilderWithFilter = Scenario{
 		filters[extractable] = filters[len(filters)-1]
 		filters = filters[:len(filters)-1]
 
-		var out physical.Node = &physical.DataSourceBuilder{
-			Executor:         ds.Executor,
-			PrimaryKeys:      ds.PrimaryKeys,
-			AvailableFilters: ds.AvailableFilters,
-			Filter:           dsFilter,
-			Alias:            ds.Alias,
-		}
+		merged := ds.Clone()
+		merged.Filter = dsFilter
+		var out physical.Node = merged
 
 		if len(filters) > 0 {
 			for len(filters) > 1 {
@@ -227,6 +255,201 @@ var MergeDataSourceBuilderWithFilter = Scenario{
 	},
 }
 
+var MergeDataSourceBuilderWithTableSample = Scenario{
+	Name:        "merge data source builder with table sample",
+	Description: "Moves the sampling into the data source, if the data source supports its sample method.",
+	CandidateMatcher: &TableSampleMatcher{
+		Name: "table_sample",
+		Source: &DataSourceBuilderMatcher{
+			Name: "data_source_builder",
+		},
+	},
+	CandidateApprover: func(match *Match) bool {
+		tableSample := match.Nodes["table_sample"].(*physical.TableSample)
+		ds := match.Nodes["data_source_builder"].(*physical.DataSourceBuilder)
+
+		if ds.Sampling != nil || ds.Limit != nil || ds.Grouping != nil || len(ds.Joins) > 0 {
+			return false
+		}
+		_, ok := ds.AvailableSampling[tableSample.Sampling.Method]
+		return ok
+	},
+	Reassembler: func(match *Match) physical.Node {
+		tableSample := match.Nodes["table_sample"].(*physical.TableSample)
+		ds := match.Nodes["data_source_builder"].(*physical.DataSourceBuilder)
+
+		out := ds.Clone()
+		out.Sampling = tableSample.Sampling
+		return out
+	},
+}
+
+var MergeDataSourceBuilderWithGroupBy = Scenario{
+	Name:        "merge data source builder with group by",
+	Description: "Moves the group by into the data source, if the data source supports all of its aggregates, and it groups by and aggregates the data source's columns.",
+	CandidateMatcher: &GroupByMatcher{
+		Name: "group_by",
+		Source: &DataSourceBuilderMatcher{
+			Name: "data_source_builder",
+		},
+	},
+	CandidateApprover: func(match *Match) bool {
+		groupBy := match.Nodes["group_by"].(*physical.GroupBy)
+		ds := match.Nodes["data_source_builder"].(*physical.DataSourceBuilder)
+
+		if ds.Grouping != nil || ds.Ordering != nil || ds.Limit != nil {
+			return false
+		}
+
+		key := make(map[octosql.VariableName]struct{}, len(groupBy.Key))
+		for _, expr := range groupBy.Key {
+			variable, ok := expr.(*physical.Variable)
+			if !ok || variable.Name.Source() != ds.Alias {
+				return false
+			}
+			key[variable.Name] = struct{}{}
+		}
+
+		for i := range groupBy.Fields {
+			if _, ok := ds.AvailableAggregates[groupBy.Aggregates[i]]; !ok {
+				return false
+			}
+			switch {
+			case groupBy.Fields[i] == "*star*":
+				if groupBy.Aggregates[i] != physical.Count {
+					return false
+				}
+			case groupBy.Aggregates[i] == physical.First || groupBy.Aggregates[i] == physical.Last:
+				// Other columns have no single value in a database group.
+				if _, ok := key[groupBy.Fields[i]]; !ok {
+					return false
+				}
+			case groupBy.Fields[i].Source() != ds.Alias:
+				return false
+			}
+		}
+
+		return true
+	},
+	Reassembler: func(match *Match) physical.Node {
+		groupBy := match.Nodes["group_by"].(*physical.GroupBy)
+		ds := match.Nodes["data_source_builder"].(*physical.DataSourceBuilder)
+
+		var key []octosql.VariableName
+		for i := range groupBy.Key {
+			key = append(key, octosql.NewVariableName(groupBy.Key[i].(*physical.Variable).Name.Name()))
+		}
+
+		fields := make([]octosql.VariableName, len(groupBy.Fields))
+		as := make([]octosql.VariableName, len(groupBy.Fields))
+		for i := range groupBy.Fields {
+			fields[i] = groupBy.Fields[i]
+			if fields[i] != "*star*" {
+				fields[i] = octosql.NewVariableName(fields[i].Name())
+			}
+
+			as[i] = groupBy.As[i]
+			if len(as[i]) == 0 {
+				as[i] = octosql.NewVariableName(fmt.Sprintf("%s_%s", groupBy.Fields[i], groupBy.Aggregates[i]))
+			}
+		}
+
+		out := ds.Clone()
+		out.Grouping = physical.NewGrouping(key, fields, groupBy.Aggregates, as)
+		return out
+	},
+}
+
+var MergeDataSourceBuilderWithOrderBy = Scenario{
+	Name:        "merge data source builder with order by",
+	Description: "Moves the order by into the data source, if the data source supports ordering and all the sort expressions are its columns.",
+	CandidateMatcher: &OrderByMatcher{
+		Name: "order_by",
+		Source: &DataSourceBuilderMatcher{
+			Name: "data_source_builder",
+		},
+	},
+	CandidateApprover: func(match *Match) bool {
+		orderBy := match.Nodes["order_by"].(*physical.OrderBy)
+		ds := match.Nodes["data_source_builder"].(*physical.DataSourceBuilder)
+
+		// The limit is applied after the ordering, and the grouped records don't contain the source columns anymore.
+		if !ds.AvailableOrdering || ds.Ordering != nil || ds.Limit != nil || ds.Grouping != nil {
+			return false
+		}
+		for _, expr := range orderBy.Expressions {
+			variable, ok := expr.(*physical.Variable)
+			if !ok || variable.Name.Source() != ds.Alias {
+				return false
+			}
+		}
+		return true
+	},
+	Reassembler: func(match *Match) physical.Node {
+		orderBy := match.Nodes["order_by"].(*physical.OrderBy)
+		ds := match.Nodes["data_source_builder"].(*physical.DataSourceBuilder)
+
+		columns := make([]octosql.VariableName, len(orderBy.Expressions))
+		for i := range orderBy.Expressions {
+			columns[i] = octosql.NewVariableName(orderBy.Expressions[i].(*physical.Variable).Name.Name())
+		}
+
+		out := ds.Clone()
+		out.Ordering = physical.NewOrdering(columns, orderBy.Directions)
+		return out
+	},
+}
+
+var MergeDataSourceBuilderWithLimit = Scenario{
+	Name:        "merge data source builder with limit",
+	Description: "Moves the limit into the data source, if the data source supports limits. Below an offset, the data source gets the limit plus the offset.",
+	CandidateMatcher: &LimitMatcher{
+		Name: "limit",
+	},
+	CandidateApprover: func(match *Match) bool {
+		_, ds, ok := splitLimitedDataSource(match.Nodes["limit"].(*physical.Limit))
+		if !ok {
+			return false
+		}
+
+		return ds.AvailableLimit && ds.Limit == nil
+	},
+	Reassembler: func(match *Match) physical.Node {
+		limit := match.Nodes["limit"].(*physical.Limit)
+		offset, ds, _ := splitLimitedDataSource(limit)
+
+		out := ds.Clone()
+		out.Limit = limitWithOffset(limit.LimitExpr, offset)
+		if offset == nil {
+			return out
+		}
+
+		return physical.NewLimit(physical.NewOffset(out, offset.OffsetExpr), limit.LimitExpr)
+	},
+}
+
+// splitLimitedDataSource descends from the limit through an optional offset to a data source builder.
+// It returns the offset and the data source builder, or false if there's no data source builder.
+func splitLimitedDataSource(limit *physical.Limit) (*physical.Offset, *physical.DataSourceBuilder, bool) {
+	node := limit.Source
+
+	offset, ok := node.(*physical.Offset)
+	if ok {
+		node = offset.Source
+	}
+
+	ds, ok := node.(*physical.DataSourceBuilder)
+	return offset, ds, ok
+}
+
+// limitWithOffset returns the number of records needed below the offset, so that the limit above it can be satisfied.
+func limitWithOffset(limit physical.Expression, offset *physical.Offset) physical.Expression {
+	if offset == nil {
+		return limit
+	}
+	return physical.NewFunctionExpression("+", []physical.Expression{limit, offset.OffsetExpr})
+}
+
 func subset(set []octosql.VariableName, subset []octosql.VariableName) bool {
 	for i := range subset {
 		if !containsVariableName(set, subset[i]) {
@@ -245,6 +468,19 @@ func containsVariableName(vns []octosql.VariableName, vn octosql.VariableName) b
 	return false
 }
 
+// isDataSourceColumn checks if the variable is a column of the data source builder's table, or of one of its joined tables.
+func isDataSourceColumn(ds *physical.DataSourceBuilder, name octosql.VariableName) bool {
+	if name.Source() == ds.Alias {
+		return true
+	}
+	for _, join := range ds.Joins {
+		if name.Source() == join.Alias {
+			return true
+		}
+	}
+	return false
+}
+
 var PushFilterBelowMap = Scenario{
 	Name:        "push filter below map",
 	Description: "Creates a new filter under the map containing predicates which can be checked before mapping.",
@@ -335,3 +571,872 @@ var PushFilterBelowMap = Scenario{
 		return out
 	},
 }
+
+var PushFilterBelowJoin = Scenario{
+	Name:        "push filter below join",
+	Description: "Moves the parts of a filter above an inner or left join into the join inputs, or the join filter, depending on the qualifiers they use.",
+	CandidateMatcher: &FilterMatcher{
+		Formula: &AnyFormulaMatcher{
+			Name: "parent_filter",
+		},
+		Source: &AnyNodeMatcher{
+			Name: "join",
+		},
+	},
+	CandidateApprover: func(match *Match) bool {
+		join, ok := getFilterableJoin(match.Nodes["join"])
+		if !ok {
+			return false
+		}
+		_, _, _, rest := splitFilterAboveJoin(match.Formulas["parent_filter"], join)
+
+		return len(rest) < len(match.Formulas["parent_filter"].SplitByAnd())
+	},
+	Reassembler: func(match *Match) physical.Node {
+		join, _ := getFilterableJoin(match.Nodes["join"])
+		toSource, toJoined, toJoinFilter, rest := splitFilterAboveJoin(match.Formulas["parent_filter"], join)
+
+		source := join.source
+		if len(toSource) > 0 {
+			source = physical.NewFilter(andAll(toSource), source)
+		}
+		joined := join.joined
+		if len(toJoined) > 0 {
+			joined = physical.NewFilter(andAll(toJoined), joined)
+		}
+		filter := join.filter
+		if len(toJoinFilter) > 0 {
+			if constant, ok := filter.(*physical.Constant); ok && constant.Value {
+				filter = andAll(toJoinFilter)
+			} else {
+				filter = physical.NewAnd(filter, andAll(toJoinFilter))
+			}
+		}
+
+		out := join.rebuild(source, joined, filter)
+		if len(rest) > 0 {
+			out = physical.NewFilter(andAll(rest), out)
+		}
+		return out
+	},
+}
+
+// filterableJoin is an inner or left join, with the inputs and join filter a filter above it may be moved into.
+type filterableJoin struct {
+	source   physical.Node
+	joined   physical.Node
+	joinType physical.JoinType
+	// lookup is set for lookup joins, which evaluate the joined node for each source record.
+	lookup bool
+	// filter is the join filter of hash and merge joins.
+	filter  physical.Formula
+	rebuild func(source, joined physical.Node, filter physical.Formula) physical.Node
+}
+
+func getFilterableJoin(node physical.Node) (*filterableJoin, bool) {
+	switch node := node.(type) {
+	case *physical.InnerJoin:
+		return &filterableJoin{
+			source:   node.Source,
+			joined:   node.Joined,
+			joinType: physical.InnerJoinType,
+			lookup:   true,
+			rebuild: func(source, joined physical.Node, filter physical.Formula) physical.Node {
+				return physical.NewInnerJoin(source, joined)
+			},
+		}, true
+
+	case *physical.LeftJoin:
+		return &filterableJoin{
+			source:   node.Source,
+			joined:   node.Joined,
+			joinType: physical.LeftJoinType,
+			lookup:   true,
+			rebuild: func(source, joined physical.Node, filter physical.Formula) physical.Node {
+				return physical.NewLeftJoin(source, joined)
+			},
+		}, true
+
+	case *physical.HashJoin:
+		if node.JoinType == physical.FullJoinType {
+			return nil, false
+		}
+		return &filterableJoin{
+			source:   node.Source,
+			joined:   node.Joined,
+			joinType: node.JoinType,
+			filter:   node.Filter,
+			rebuild: func(source, joined physical.Node, filter physical.Formula) physical.Node {
+				return physical.NewHashJoin(source, joined, node.SourceKey, node.JoinedKey, filter, node.JoinType)
+			},
+		}, true
+
+	case *physical.MergeJoin:
+		if node.JoinType == physical.FullJoinType {
+			return nil, false
+		}
+		return &filterableJoin{
+			source:   node.Source,
+			joined:   node.Joined,
+			joinType: node.JoinType,
+			filter:   node.Filter,
+			rebuild: func(source, joined physical.Node, filter physical.Formula) physical.Node {
+				return physical.NewMergeJoin(source, joined, node.SourceKey, node.JoinedKey, filter, node.JoinType)
+			},
+		}, true
+
+	default:
+		return nil, false
+	}
+}
+
+// splitFilterAboveJoin splits the filter into the parts which can be checked on the join source,
+// on the joined node, in the join filter, and the rest, which has to stay above the join.
+// Parts not using the joined node's variables are checked on the source.
+// For inner joins, the other parts are checked on the joined node if it's a lookup join,
+// otherwise on the joined node if they don't use the source's variables, or in the join filter.
+// For left joins they stay above the join, as they also have to be checked on the unmatched source records.
+func splitFilterAboveJoin(formula physical.Formula, join *filterableJoin) (toSource, toJoined, toJoinFilter, rest []physical.Formula) {
+	sourceQualifiers, sourceKnown := physical.Qualifiers(join.source)
+	joinedQualifiers, joinedKnown := physical.Qualifiers(join.joined)
+
+	for _, part := range formula.SplitByAnd() {
+		usesSource := !sourceKnown
+		usesJoined := !joinedKnown
+		for _, varname := range nonConstantVariables(part) {
+			if _, ok := sourceQualifiers[varname.Source()]; ok {
+				usesSource = true
+			}
+			if _, ok := joinedQualifiers[varname.Source()]; ok {
+				usesJoined = true
+			}
+		}
+
+		switch {
+		case !usesJoined:
+			toSource = append(toSource, part)
+		case join.joinType != physical.InnerJoinType:
+			rest = append(rest, part)
+		case join.lookup || !usesSource:
+			toJoined = append(toJoined, part)
+		default:
+			toJoinFilter = append(toJoinFilter, part)
+		}
+	}
+
+	return toSource, toJoined, toJoinFilter, rest
+}
+
+// andAll returns the conjunction of the given formulas.
+func andAll(formulas []physical.Formula) physical.Formula {
+	out := formulas[0]
+	for _, formula := range formulas[1:] {
+		out = physical.NewAnd(out, formula)
+	}
+	return out
+}
+
+// nonConstantVariables returns the variables used by the formula, apart from constants.
+func nonConstantVariables(formula physical.Formula) []octosql.VariableName {
+	var out []octosql.VariableName
+	for _, predicate := range formula.ExtractPredicates() {
+		vars := append(GetVariables(context.Background(), predicate.Left), GetVariables(context.Background(), predicate.Right)...)
+		for _, varname := range vars {
+			if !strings.HasPrefix(varname.Name(), "const_") {
+				out = append(out, varname)
+			}
+		}
+	}
+	return out
+}
+
+// renameVariables returns the formula with the variables renamed using the given function.
+func renameVariables(formula physical.Formula, rename func(octosql.VariableName) octosql.VariableName) physical.Formula {
+	return formula.Transform(context.Background(), &physical.Transformers{
+		NamedExprT: func(expr physical.NamedExpression) physical.NamedExpression {
+			if variable, ok := expr.(*physical.Variable); ok {
+				return physical.NewVariable(rename(variable.Name))
+			}
+			return expr
+		},
+	})
+}
+
+var PushFilterBelowGroupBy = Scenario{
+	Name:        "push filter below group by",
+	Description: "Creates a new filter under the group by containing predicates which only use fields of the group key.",
+	CandidateMatcher: &FilterMatcher{
+		Formula: &AnyFormulaMatcher{
+			Name: "parent_filter",
+		},
+		Source: &GroupByMatcher{
+			Name: "group_by",
+		},
+	},
+	CandidateApprover: func(match *Match) bool {
+		pushable, _ := splitFilterAboveGroupBy(match.Formulas["parent_filter"], match.Nodes["group_by"].(*physical.GroupBy))
+
+		return len(pushable) > 0
+	},
+	Reassembler: func(match *Match) physical.Node {
+		groupBy := match.Nodes["group_by"].(*physical.GroupBy)
+		pushable, rest := splitFilterAboveGroupBy(match.Formulas["parent_filter"], groupBy)
+
+		var out physical.Node = &physical.GroupBy{
+			Source:     physical.NewFilter(andAll(pushable), groupBy.Source),
+			Key:        groupBy.Key,
+			Fields:     groupBy.Fields,
+			Aggregates: groupBy.Aggregates,
+			As:         groupBy.As,
+		}
+		if len(rest) > 0 {
+			out = physical.NewFilter(andAll(rest), out)
+		}
+		return out
+	},
+}
+
+// splitFilterAboveGroupBy splits the filter into the parts which only use fields of the group key,
+// renamed to the source variables they come from, and the rest.
+// A field of the group key is the first value of a variable which is part of the key, so it's the same for all records of a group.
+func splitFilterAboveGroupBy(formula physical.Formula, groupBy *physical.GroupBy) (pushable, rest []physical.Formula) {
+	keyFields := make(map[octosql.VariableName]octosql.VariableName)
+	for i := range groupBy.Fields {
+		if groupBy.Aggregates[i] != physical.First {
+			continue
+		}
+		for _, keyPart := range groupBy.Key {
+			if variable, ok := keyPart.(*physical.Variable); ok && variable.Name == groupBy.Fields[i] {
+				name := groupBy.As[i]
+				if len(name) == 0 {
+					name = octosql.NewVariableName(fmt.Sprintf("%s_%s", groupBy.Fields[i], groupBy.Aggregates[i]))
+				}
+				keyFields[name] = groupBy.Fields[i]
+			}
+		}
+	}
+
+	for _, part := range formula.SplitByAnd() {
+		onlyKeyFields := true
+		for _, varname := range nonConstantVariables(part) {
+			if _, ok := keyFields[varname]; !ok {
+				onlyKeyFields = false
+			}
+		}
+		if !onlyKeyFields {
+			rest = append(rest, part)
+			continue
+		}
+
+		pushable = append(pushable, renameVariables(part, func(name octosql.VariableName) octosql.VariableName {
+			if field, ok := keyFields[name]; ok {
+				return field
+			}
+			return name
+		}))
+	}
+
+	return pushable, rest
+}
+
+var PushFilterBelowOrderBy = Scenario{
+	Name:        "push filter below order by",
+	Description: "Moves a filter under the order by, so that fewer records get sorted.",
+	CandidateMatcher: &FilterMatcher{
+		Formula: &AnyFormulaMatcher{
+			Name: "parent_filter",
+		},
+		Source: &OrderByMatcher{
+			Name: "order_by",
+		},
+	},
+	Reassembler: func(match *Match) physical.Node {
+		orderBy := match.Nodes["order_by"].(*physical.OrderBy)
+
+		return &physical.OrderBy{
+			Expressions: orderBy.Expressions,
+			Directions:  orderBy.Directions,
+			Source:      physical.NewFilter(match.Formulas["parent_filter"], orderBy.Source),
+		}
+	},
+}
+
+var PushFilterBelowDistinct = Scenario{
+	Name:        "push filter below distinct",
+	Description: "Moves a filter under the distinct, as it removes the same records from any duplicates.",
+	CandidateMatcher: &FilterMatcher{
+		Formula: &AnyFormulaMatcher{
+			Name: "parent_filter",
+		},
+		Source: &DistinctMatcher{
+			Child: &AnyNodeMatcher{
+				Name: "child",
+			},
+		},
+	},
+	Reassembler: func(match *Match) physical.Node {
+		return &physical.Distinct{
+			Child: physical.NewFilter(match.Formulas["parent_filter"], match.Nodes["child"]),
+		}
+	},
+}
+
+var PushFilterBelowRequalifier = Scenario{
+	Name:        "push filter below requalifier",
+	Description: "Moves a filter under the requalifier, changing the qualifier of its variables back to the one of the requalified records.",
+	CandidateMatcher: &FilterMatcher{
+		Formula: &AnyFormulaMatcher{
+			Name: "parent_filter",
+		},
+		Source: &RequalifierMatcher{
+			Qualifier: &AnyStringMatcher{
+				Name: "qualifier",
+			},
+			Source: &AnyNodeMatcher{
+				Name: "source",
+			},
+		},
+	},
+	CandidateApprover: func(match *Match) bool {
+		_, ok := unqualifyFilter(match.Formulas["parent_filter"], match.Strings["qualifier"], match.Nodes["source"])
+
+		return ok
+	},
+	Reassembler: func(match *Match) physical.Node {
+		formula, _ := unqualifyFilter(match.Formulas["parent_filter"], match.Strings["qualifier"], match.Nodes["source"])
+
+		return &physical.Requalifier{
+			Qualifier: match.Strings["qualifier"],
+			Source:    physical.NewFilter(formula, match.Nodes["source"]),
+		}
+	},
+}
+
+// unqualifyFilter changes the qualifier of the variables of a filter above a requalifier back to the single qualifier of its source.
+// It returns false if the source's qualifier isn't known,
+// or if the filter uses other variables with the source's qualifier, which would get mistaken for the source's fields.
+func unqualifyFilter(formula physical.Formula, qualifier string, source physical.Node) (physical.Formula, bool) {
+	sourceQualifiers, ok := physical.Qualifiers(source)
+	if !ok || len(sourceQualifiers) != 1 {
+		return nil, false
+	}
+	var sourceQualifier string
+	for qualifier := range sourceQualifiers {
+		sourceQualifier = qualifier
+	}
+
+	for _, varname := range nonConstantVariables(formula) {
+		if varname.Source() != qualifier && varname.Source() == sourceQualifier {
+			return nil, false
+		}
+	}
+
+	return renameVariables(formula, func(name octosql.VariableName) octosql.VariableName {
+		return requalify(name, qualifier, sourceQualifier)
+	}), true
+}
+
+// requalify changes the qualifier of the variable to the new one, if it has the old one.
+func requalify(name octosql.VariableName, oldQualifier, newQualifier string) octosql.VariableName {
+	if name.Source() != oldQualifier || strings.HasPrefix(name.Name(), "const_") {
+		return name
+	}
+	if len(newQualifier) == 0 {
+		return octosql.NewVariableName(name.Name())
+	}
+	return octosql.NewVariableName(fmt.Sprintf("%s.%s", newQualifier, name.Name()))
+}
+
+var PushFilterBelowUnionAll = Scenario{
+	Name:        "push filter below union all",
+	Description: "Duplicates a filter above a union all into both of its inputs.",
+	CandidateMatcher: &FilterMatcher{
+		Formula: &AnyFormulaMatcher{
+			Name: "parent_filter",
+		},
+		Source: &UnionAllMatcher{
+			First: &AnyNodeMatcher{
+				Name: "first",
+			},
+			Second: &AnyNodeMatcher{
+				Name: "second",
+			},
+		},
+	},
+	Reassembler: func(match *Match) physical.Node {
+		return &physical.UnionAll{
+			First:  physical.NewFilter(match.Formulas["parent_filter"], match.Nodes["first"]),
+			Second: physical.NewFilter(match.Formulas["parent_filter"], match.Nodes["second"]),
+		}
+	},
+}
+
+var PushLimitBelowMap = Scenario{
+	Name:        "push limit below map",
+	Description: "Moves a limit below a map, which maps each record to exactly one record.",
+	CandidateMatcher: &LimitMatcher{
+		Name: "limit",
+		Source: &MapMatcher{
+			Name: "map",
+		},
+	},
+	Reassembler: func(match *Match) physical.Node {
+		limit := match.Nodes["limit"].(*physical.Limit)
+		mapNode := match.Nodes["map"].(*physical.Map)
+
+		return physical.NewMap(mapNode.Expressions, physical.NewLimit(mapNode.Source, limit.LimitExpr), mapNode.Keep)
+	},
+}
+
+var PushOffsetBelowMap = Scenario{
+	Name:        "push offset below map",
+	Description: "Moves an offset below a map, which maps each record to exactly one record.",
+	CandidateMatcher: &OffsetMatcher{
+		Name: "offset",
+		Source: &MapMatcher{
+			Name: "map",
+		},
+	},
+	Reassembler: func(match *Match) physical.Node {
+		offset := match.Nodes["offset"].(*physical.Offset)
+		mapNode := match.Nodes["map"].(*physical.Map)
+
+		return physical.NewMap(mapNode.Expressions, physical.NewOffset(mapNode.Source, offset.OffsetExpr), mapNode.Keep)
+	},
+}
+
+var PushLimitBelowUnionAll = Scenario{
+	Name:        "push limit below union all",
+	Description: "Limits both inputs of a union all below a limit, and an optional offset, to the limit plus the offset.",
+	CandidateMatcher: &LimitMatcher{
+		Name: "limit",
+	},
+	CandidateApprover: func(match *Match) bool {
+		limit := match.Nodes["limit"].(*physical.Limit)
+		offset, unionAll, ok := splitLimitedUnionAll(limit)
+		if !ok {
+			return false
+		}
+
+		branchLimit := limitWithOffset(limit.LimitExpr, offset)
+		return !isLimitedTo(unionAll.First, branchLimit) || !isLimitedTo(unionAll.Second, branchLimit)
+	},
+	Reassembler: func(match *Match) physical.Node {
+		limit := match.Nodes["limit"].(*physical.Limit)
+		offset, unionAll, _ := splitLimitedUnionAll(limit)
+
+		branchLimit := limitWithOffset(limit.LimitExpr, offset)
+		limitBranch := func(branch physical.Node) physical.Node {
+			if isLimitedTo(branch, branchLimit) {
+				return branch
+			}
+			return physical.NewLimit(branch, branchLimit)
+		}
+
+		var out physical.Node = physical.NewUnionAll(limitBranch(unionAll.First), limitBranch(unionAll.Second))
+		if offset != nil {
+			out = physical.NewOffset(out, offset.OffsetExpr)
+		}
+		return physical.NewLimit(out, limit.LimitExpr)
+	},
+}
+
+// splitLimitedUnionAll descends from the limit through an optional offset to a union all.
+// It returns the offset and the union all, or false if there's no union all.
+func splitLimitedUnionAll(limit *physical.Limit) (*physical.Offset, *physical.UnionAll, bool) {
+	node := limit.Source
+
+	offset, ok := node.(*physical.Offset)
+	if ok {
+		node = offset.Source
+	}
+
+	unionAll, ok := node.(*physical.UnionAll)
+	return offset, unionAll, ok
+}
+
+// isLimitedTo checks if the node is already limited by the given limit expression,
+// also after the limit has been pushed further down below maps, into a data source or into a top n.
+func isLimitedTo(node physical.Node, limit physical.Expression) bool {
+	switch node := node.(type) {
+	case *physical.Limit:
+		return reflect.DeepEqual(node.LimitExpr, limit)
+	case *physical.TopN:
+		return node.Offset == nil && reflect.DeepEqual(node.Limit, limit)
+	case *physical.DataSourceBuilder:
+		return node.Limit != nil && reflect.DeepEqual(node.Limit, limit)
+	case *physical.Map:
+		return isLimitedTo(node.Source, limit)
+	default:
+		return false
+	}
+}
+
+var MergeDataSourceBuildersOfInnerJoin = Scenario{
+	Name:        "merge data source builders of inner join",
+	Description: "Joins the table of the joined data source builder to the source one's in their database, if both are in the same one.",
+	CandidateMatcher: &InnerJoinMatcher{
+		Source: &DataSourceBuilderMatcher{
+			Name: "source",
+		},
+		Joined: &DataSourceBuilderMatcher{
+			Name: "joined",
+		},
+	},
+	CandidateApprover: func(match *Match) bool {
+		return canJoinInDatabase(match.Nodes["source"].(*physical.DataSourceBuilder), match.Nodes["joined"].(*physical.DataSourceBuilder))
+	},
+	Reassembler: func(match *Match) physical.Node {
+		return joinInDatabase(match.Nodes["source"].(*physical.DataSourceBuilder), match.Nodes["joined"].(*physical.DataSourceBuilder), physical.InnerJoinType)
+	},
+}
+
+var MergeDataSourceBuildersOfLeftJoin = Scenario{
+	Name:        "merge data source builders of left join",
+	Description: "Left joins the table of the joined data source builder to the source one's in their database, if both are in the same one.",
+	CandidateMatcher: &LeftJoinMatcher{
+		Source: &DataSourceBuilderMatcher{
+			Name: "source",
+		},
+		Joined: &DataSourceBuilderMatcher{
+			Name: "joined",
+		},
+	},
+	CandidateApprover: func(match *Match) bool {
+		return canJoinInDatabase(match.Nodes["source"].(*physical.DataSourceBuilder), match.Nodes["joined"].(*physical.DataSourceBuilder))
+	},
+	Reassembler: func(match *Match) physical.Node {
+		return joinInDatabase(match.Nodes["source"].(*physical.DataSourceBuilder), match.Nodes["joined"].(*physical.DataSourceBuilder), physical.LeftJoinType)
+	},
+}
+
+// canJoinInDatabase checks if the tables of the data source builders are in the same database,
+// and the joined one is only filtered, so its filter can become the join condition.
+// The source may already have joined tables, but nothing else which would have to be applied before the join.
+func canJoinInDatabase(source, joined *physical.DataSourceBuilder) bool {
+	if len(source.Connection) == 0 || source.Connection != joined.Connection {
+		return false
+	}
+	if source.Sampling != nil || source.Limit != nil || source.Ordering != nil || source.Grouping != nil {
+		return false
+	}
+	if joined.Sampling != nil || joined.Limit != nil || joined.Ordering != nil || joined.Grouping != nil || len(joined.Joins) > 0 {
+		return false
+	}
+
+	// Each table of the query needs its own alias.
+	qualifiers, _ := physical.Qualifiers(source)
+	_, ok := qualifiers[joined.Alias]
+	return !ok
+}
+
+// joinInDatabase adds the table of the joined data source builder to the joined tables of the source one.
+func joinInDatabase(source, joined *physical.DataSourceBuilder, joinType physical.JoinType) physical.Node {
+	joins := make([]*physical.TableJoin, len(source.Joins), len(source.Joins)+1)
+	copy(joins, source.Joins)
+	joins = append(joins, &physical.TableJoin{
+		Table:    joined.Table,
+		Alias:    joined.Alias,
+		Filter:   joined.Filter,
+		JoinType: joinType,
+	})
+
+	out := source.Clone()
+	out.Joins = joins
+	return out
+}
+
+var UseHashJoinForInnerJoin = Scenario{
+	Name:        "use hash join for inner join",
+	Description: "Replaces an inner lookup join with a hash join, if the join filter contains equalities which the joined data source can't handle itself.",
+	CandidateMatcher: &InnerJoinMatcher{
+		Source: &AnyNodeMatcher{
+			Name: "source",
+		},
+		Joined: &FilterMatcher{
+			Formula: &AnyFormulaMatcher{
+				Name: "join_formula",
+			},
+			Source: &DataSourceBuilderMatcher{
+				Name: "data_source_builder",
+			},
+		},
+	},
+	CandidateApprover: func(match *Match) bool {
+		ds := match.Nodes["data_source_builder"].(*physical.DataSourceBuilder)
+		sourceKey, _, _ := extractHashJoinKeys(match.Formulas["join_formula"], ds, true)
+
+		return len(sourceKey) > 0 && len(ds.Joins) == 0 && !dependsOnOtherSources(ds)
+	},
+	Reassembler: func(match *Match) physical.Node {
+		ds := match.Nodes["data_source_builder"].(*physical.DataSourceBuilder)
+		sourceKey, joinedKey, filter := extractHashJoinKeys(match.Formulas["join_formula"], ds, true)
+
+		return physical.NewHashJoin(match.Nodes["source"], ds, sourceKey, joinedKey, filter, physical.InnerJoinType)
+	},
+}
+
+var UseHashJoinForLeftJoin = Scenario{
+	Name:        "use hash join for left join",
+	Description: "Replaces a left lookup join with a hash join, if the join filter contains equalities which the joined data source can't handle itself.",
+	CandidateMatcher: &LeftJoinMatcher{
+		Source: &AnyNodeMatcher{
+			Name: "source",
+		},
+		Joined: &FilterMatcher{
+			Formula: &AnyFormulaMatcher{
+				Name: "join_formula",
+			},
+			Source: &DataSourceBuilderMatcher{
+				Name: "data_source_builder",
+			},
+		},
+	},
+	CandidateApprover: func(match *Match) bool {
+		ds := match.Nodes["data_source_builder"].(*physical.DataSourceBuilder)
+		sourceKey, _, _ := extractHashJoinKeys(match.Formulas["join_formula"], ds, true)
+
+		return len(sourceKey) > 0 && len(ds.Joins) == 0 && !dependsOnOtherSources(ds)
+	},
+	Reassembler: func(match *Match) physical.Node {
+		ds := match.Nodes["data_source_builder"].(*physical.DataSourceBuilder)
+		sourceKey, joinedKey, filter := extractHashJoinKeys(match.Formulas["join_formula"], ds, true)
+
+		return physical.NewHashJoin(match.Nodes["source"], ds, sourceKey, joinedKey, filter, physical.LeftJoinType)
+	},
+}
+
+var UseHashJoinForFullJoin = Scenario{
+	Name:        "use hash join for full join",
+	Description: "Replaces a full join with a hash join, if the join condition contains equalities between both sides.",
+	CandidateMatcher: &FullJoinMatcher{
+		Source: &AnyNodeMatcher{
+			Name: "source",
+		},
+		Joined: &DataSourceBuilderMatcher{
+			Name: "data_source_builder",
+		},
+		Condition: &AnyFormulaMatcher{
+			Name: "join_formula",
+		},
+	},
+	CandidateApprover: func(match *Match) bool {
+		ds := match.Nodes["data_source_builder"].(*physical.DataSourceBuilder)
+		sourceKey, _, _ := extractHashJoinKeys(match.Formulas["join_formula"], ds, false)
+
+		return len(sourceKey) > 0 && len(ds.Joins) == 0 && !dependsOnOtherSources(ds)
+	},
+	Reassembler: func(match *Match) physical.Node {
+		ds := match.Nodes["data_source_builder"].(*physical.DataSourceBuilder)
+		sourceKey, joinedKey, filter := extractHashJoinKeys(match.Formulas["join_formula"], ds, false)
+
+		return physical.NewHashJoin(match.Nodes["source"], ds, sourceKey, joinedKey, filter, physical.FullJoinType)
+	},
+}
+
+var UseMergeJoinForSortedInputs = Scenario{
+	Name:        "use merge join for sorted inputs",
+	Description: "Replaces a hash join with a merge join, if both of its inputs are known to be sorted by a join key.",
+	CandidateMatcher: &HashJoinMatcher{
+		Name: "hash_join",
+	},
+	CandidateApprover: func(match *Match) bool {
+		hashJoin := match.Nodes["hash_join"].(*physical.HashJoin)
+		sourceKey, _, _ := extractMergeJoinKeys(hashJoin)
+
+		return len(sourceKey) > 0
+	},
+	Reassembler: func(match *Match) physical.Node {
+		hashJoin := match.Nodes["hash_join"].(*physical.HashJoin)
+		sourceKey, joinedKey, filter := extractMergeJoinKeys(hashJoin)
+
+		return physical.NewMergeJoin(hashJoin.Source, hashJoin.Joined, sourceKey, joinedKey, filter, hashJoin.JoinType)
+	},
+}
+
+// extractMergeJoinKeys orders the hash join keys by the sort order of both inputs.
+// The key pairs of variables matching the longest common prefix of both sort orders become the merge join keys,
+// the remaining key pairs are moved into the filter.
+func extractMergeJoinKeys(hashJoin *physical.HashJoin) ([]physical.Expression, []physical.Expression, physical.Formula) {
+	sourceSortedBy := physical.SortedBy(hashJoin.Source)
+	joinedSortedBy := physical.SortedBy(hashJoin.Joined)
+
+	used := make([]bool, len(hashJoin.SourceKey))
+	var sourceKey, joinedKey []physical.Expression
+	for i := 0; i < len(sourceSortedBy) && i < len(joinedSortedBy); i++ {
+		found := false
+		for j := range hashJoin.SourceKey {
+			sourceVariable, ok := hashJoin.SourceKey[j].(*physical.Variable)
+			if !ok || used[j] || sourceVariable.Name != sourceSortedBy[i] {
+				continue
+			}
+			joinedVariable, ok := hashJoin.JoinedKey[j].(*physical.Variable)
+			if !ok || joinedVariable.Name != joinedSortedBy[i] {
+				continue
+			}
+
+			used[j] = true
+			sourceKey = append(sourceKey, sourceVariable)
+			joinedKey = append(joinedKey, joinedVariable)
+			found = true
+			break
+		}
+		if !found {
+			break
+		}
+	}
+
+	filter := hashJoin.Filter
+	for j := range hashJoin.SourceKey {
+		if used[j] {
+			continue
+		}
+		predicate := physical.NewPredicate(hashJoin.SourceKey[j], physical.Equal, hashJoin.JoinedKey[j])
+		if constant, ok := filter.(*physical.Constant); ok && constant.Value {
+			filter = predicate
+		} else {
+			filter = physical.NewAnd(filter, predicate)
+		}
+	}
+
+	return sourceKey, joinedKey, filter
+}
+
+var UseTopNForLimitedOrderBy = Scenario{
+	Name:        "use top n for limited order by",
+	Description: "Replaces an order by under a limit, an optional offset and any record-wise maps with a top n node, which keeps only the needed records in memory.",
+	CandidateMatcher: &LimitMatcher{
+		Name: "limit",
+	},
+	CandidateApprover: func(match *Match) bool {
+		_, _, _, ok := splitLimitedOrderBy(match.Nodes["limit"].(*physical.Limit))
+
+		return ok
+	},
+	Reassembler: func(match *Match) physical.Node {
+		limit := match.Nodes["limit"].(*physical.Limit)
+		offset, maps, orderBy, _ := splitLimitedOrderBy(limit)
+
+		var out physical.Node = physical.NewTopN(orderBy.Expressions, orderBy.Directions, limit.LimitExpr, offset, orderBy.Source)
+		for i := len(maps) - 1; i >= 0; i-- {
+			out = physical.NewMap(maps[i].Expressions, out, maps[i].Keep)
+		}
+
+		return out
+	},
+}
+
+// splitLimitedOrderBy descends from the limit through an optional offset and any maps, which don't change the number of records,
+// to an order by. It returns the offset expression, the maps from the top and the order by, or false if there's no order by.
+func splitLimitedOrderBy(limit *physical.Limit) (physical.Expression, []*physical.Map, *physical.OrderBy, bool) {
+	node := limit.Source
+
+	var offset physical.Expression
+	if offsetNode, ok := node.(*physical.Offset); ok {
+		offset = offsetNode.OffsetExpr
+		node = offsetNode.Source
+	}
+
+	var maps []*physical.Map
+	for {
+		mapNode, ok := node.(*physical.Map)
+		if !ok {
+			break
+		}
+		maps = append(maps, mapNode)
+		node = mapNode.Source
+	}
+
+	orderBy, ok := node.(*physical.OrderBy)
+	return offset, maps, orderBy, ok
+}
+
+// extractHashJoinKeys splits the join formula into equal pairs of source and joined key expressions and the remaining filter.
+// Only equalities between an expression using just the data source's variables
+// and an expression using none of them, but at least one other non-constant variable, become keys.
+// If skipPushable is set, equalities which the data source could filter on itself are left in the filter.
+func extractHashJoinKeys(formula physical.Formula, ds *physical.DataSourceBuilder, skipPushable bool) ([]physical.Expression, []physical.Expression, physical.Formula) {
+	var sourceKey, joinedKey []physical.Expression
+	var rest []physical.Formula
+
+	for _, part := range formula.SplitByAnd() {
+		predicate, ok := part.(*physical.Predicate)
+		if !ok || predicate.Relation != physical.Equal {
+			rest = append(rest, part)
+			continue
+		}
+
+		varsLeft := GetVariables(context.Background(), predicate.Left)
+		varsRight := GetVariables(context.Background(), predicate.Right)
+
+		var sourceExpr, joinedExpr physical.Expression
+		var localVars []octosql.VariableName
+		if onlyLocalVariables(varsLeft, ds.Alias) && onlyOtherVariables(varsRight, ds.Alias) {
+			sourceExpr, joinedExpr, localVars = predicate.Right, predicate.Left, varsLeft
+		} else if onlyLocalVariables(varsRight, ds.Alias) && onlyOtherVariables(varsLeft, ds.Alias) {
+			sourceExpr, joinedExpr, localVars = predicate.Left, predicate.Right, varsRight
+		} else {
+			rest = append(rest, part)
+			continue
+		}
+
+		if skipPushable {
+			if _, ok := ds.AvailableFilters[physical.Secondary][physical.Equal]; ok {
+				rest = append(rest, part)
+				continue
+			}
+			if _, ok := ds.AvailableFilters[physical.Primary][physical.Equal]; ok && subset(ds.PrimaryKeys, localVars) {
+				rest = append(rest, part)
+				continue
+			}
+		}
+
+		sourceKey = append(sourceKey, sourceExpr)
+		joinedKey = append(joinedKey, joinedExpr)
+	}
+
+	if len(rest) == 0 {
+		return sourceKey, joinedKey, physical.NewConstant(true)
+	}
+	for len(rest) > 1 {
+		rest[1] = physical.NewAnd(rest[0], rest[1])
+		rest = rest[1:]
+	}
+
+	return sourceKey, joinedKey, rest[0]
+}
+
+func onlyLocalVariables(vars []octosql.VariableName, alias string) bool {
+	for i := range vars {
+		if vars[i].Source() != alias {
+			return false
+		}
+	}
+	return len(vars) > 0
+}
+
+func onlyOtherVariables(vars []octosql.VariableName, alias string) bool {
+	foundNonConstant := false
+	for i := range vars {
+		if vars[i].Source() == alias {
+			return false
+		}
+		if !strings.HasPrefix(vars[i].Name(), "const_") {
+			foundNonConstant = true
+		}
+	}
+	return foundNonConstant
+}
+
+// dependsOnOtherSources checks if the data source builder's filter uses any non-constant variables of other sources.
+func dependsOnOtherSources(ds *physical.DataSourceBuilder) bool {
+	for _, predicate := range ds.Filter.ExtractPredicates() {
+		vars := append(GetVariables(context.Background(), predicate.Left), GetVariables(context.Background(), predicate.Right)...)
+		for i := range vars {
+			if vars[i].Source() != ds.Alias && !strings.HasPrefix(vars[i].Name(), "const_") {
+				return true
+			}
+		}
+	}
+	return false
+}
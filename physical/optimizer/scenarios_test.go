@@ -358,6 +358,30 @@ func TestMergeDataSourceWithRequalifier(t *testing.T) {
 				Alias: "a",
 			},
 		},
+		{
+			name: "filter variables requalified",
+			args: args{
+				plan: &physical.Requalifier{
+					Qualifier: "a",
+					Source: &physical.DataSourceBuilder{
+						Filter: physical.NewPredicate(
+							physical.NewVariable("b.age"),
+							physical.MoreThan,
+							physical.NewVariable("const_0"),
+						),
+						Alias: "b",
+					},
+				},
+			},
+			want: &physical.DataSourceBuilder{
+				Filter: physical.NewPredicate(
+					physical.NewVariable("a.age"),
+					physical.MoreThan,
+					physical.NewVariable("const_0"),
+				),
+				Alias: "a",
+			},
+		},
 		{
 			name: "multi merge",
 			args: args{
@@ -685,6 +709,1479 @@ func TestMergeDataSourceWithFilter(t *testing.T) {
 	}
 }
 
+func TestMergeDataSourceWithTableSample(t *testing.T) {
+	type args struct {
+		plan physical.Node
+	}
+	tests := []struct {
+		name string
+		args args
+		want physical.Node
+	}{
+		{
+			name: "supported sample method",
+			args: args{
+				plan: &physical.TableSample{
+					Sampling: physical.NewSampling(physical.Bernoulli, physical.NewVariable("const_0"), nil),
+					Source: &physical.DataSourceBuilder{
+						Executor: nil,
+						AvailableSampling: map[physical.SampleMethod]struct{}{
+							physical.Bernoulli: struct{}{},
+						},
+						Filter: physical.NewConstant(true),
+						Alias:  "a",
+					},
+				},
+			},
+			want: &physical.DataSourceBuilder{
+				Executor: nil,
+				AvailableSampling: map[physical.SampleMethod]struct{}{
+					physical.Bernoulli: struct{}{},
+				},
+				Filter:   physical.NewConstant(true),
+				Sampling: physical.NewSampling(physical.Bernoulli, physical.NewVariable("const_0"), nil),
+				Alias:    "a",
+			},
+		},
+		{
+			name: "unsupported sample method",
+			args: args{
+				plan: &physical.TableSample{
+					Sampling: physical.NewSampling(physical.Reservoir, physical.NewVariable("const_0"), nil),
+					Source: &physical.DataSourceBuilder{
+						Executor: nil,
+						AvailableSampling: map[physical.SampleMethod]struct{}{
+							physical.Bernoulli: struct{}{},
+						},
+						Filter: physical.NewConstant(true),
+						Alias:  "a",
+					},
+				},
+			},
+			want: &physical.TableSample{
+				Sampling: physical.NewSampling(physical.Reservoir, physical.NewVariable("const_0"), nil),
+				Source: &physical.DataSourceBuilder{
+					Executor: nil,
+					AvailableSampling: map[physical.SampleMethod]struct{}{
+						physical.Bernoulli: struct{}{},
+					},
+					Filter: physical.NewConstant(true),
+					Alias:  "a",
+				},
+			},
+		},
+		{
+			name: "already sampled data source",
+			args: args{
+				plan: &physical.TableSample{
+					Sampling: physical.NewSampling(physical.System, physical.NewVariable("const_0"), nil),
+					Source: &physical.DataSourceBuilder{
+						Executor: nil,
+						AvailableSampling: map[physical.SampleMethod]struct{}{
+							physical.Bernoulli: struct{}{},
+							physical.System:    struct{}{},
+						},
+						Filter:   physical.NewConstant(true),
+						Sampling: physical.NewSampling(physical.Bernoulli, physical.NewVariable("const_1"), nil),
+						Alias:    "a",
+					},
+				},
+			},
+			want: &physical.TableSample{
+				Sampling: physical.NewSampling(physical.System, physical.NewVariable("const_0"), nil),
+				Source: &physical.DataSourceBuilder{
+					Executor: nil,
+					AvailableSampling: map[physical.SampleMethod]struct{}{
+						physical.Bernoulli: struct{}{},
+						physical.System:    struct{}{},
+					},
+					Filter:   physical.NewConstant(true),
+					Sampling: physical.NewSampling(physical.Bernoulli, physical.NewVariable("const_1"), nil),
+					Alias:    "a",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Optimize(context.Background(), []Scenario{MergeDataSourceBuilderWithTableSample}, tt.args.plan); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MergeDataSourceWithTableSample() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeDataSourceWithOrderBy(t *testing.T) {
+	dataSource := func(availableOrdering bool, ordering *physical.Ordering, limit physical.Expression) *physical.DataSourceBuilder {
+		return &physical.DataSourceBuilder{
+			AvailableLimit:    true,
+			AvailableOrdering: availableOrdering,
+			Filter:            physical.NewConstant(true),
+			Limit:             limit,
+			Ordering:          ordering,
+			Alias:             "a",
+		}
+	}
+	directions := []physical.OrderDirection{physical.Descending, physical.Ascending}
+
+	type args struct {
+		plan physical.Node
+	}
+	tests := []struct {
+		name string
+		args args
+		want physical.Node
+	}{
+		{
+			name: "order by columns",
+			args: args{
+				plan: physical.NewOrderBy(
+					[]physical.Expression{physical.NewVariable("a.age"), physical.NewVariable("a.name")},
+					directions,
+					dataSource(true, nil, nil),
+				),
+			},
+			want: dataSource(true, physical.NewOrdering([]octosql.VariableName{"age", "name"}, directions), nil),
+		},
+		{
+			name: "limit over order by",
+			args: args{
+				plan: physical.NewLimit(
+					physical.NewOrderBy(
+						[]physical.Expression{physical.NewVariable("a.age"), physical.NewVariable("a.name")},
+						directions,
+						dataSource(true, nil, nil),
+					),
+					physical.NewVariable("const_0"),
+				),
+			},
+			want: dataSource(true, physical.NewOrdering([]octosql.VariableName{"age", "name"}, directions), physical.NewVariable("const_0")),
+		},
+		{
+			name: "order by expression",
+			args: args{
+				plan: physical.NewOrderBy(
+					[]physical.Expression{physical.NewFunctionExpression("lower", []physical.Expression{physical.NewVariable("a.name")})},
+					[]physical.OrderDirection{physical.Ascending},
+					dataSource(true, nil, nil),
+				),
+			},
+			want: physical.NewOrderBy(
+				[]physical.Expression{physical.NewFunctionExpression("lower", []physical.Expression{physical.NewVariable("a.name")})},
+				[]physical.OrderDirection{physical.Ascending},
+				dataSource(true, nil, nil),
+			),
+		},
+		{
+			name: "order by variable of other source",
+			args: args{
+				plan: physical.NewOrderBy(
+					[]physical.Expression{physical.NewVariable("b.age")},
+					[]physical.OrderDirection{physical.Ascending},
+					dataSource(true, nil, nil),
+				),
+			},
+			want: physical.NewOrderBy(
+				[]physical.Expression{physical.NewVariable("b.age")},
+				[]physical.OrderDirection{physical.Ascending},
+				dataSource(true, nil, nil),
+			),
+		},
+		{
+			name: "ordering not available",
+			args: args{
+				plan: physical.NewOrderBy(
+					[]physical.Expression{physical.NewVariable("a.age")},
+					[]physical.OrderDirection{physical.Ascending},
+					dataSource(false, nil, nil),
+				),
+			},
+			want: physical.NewOrderBy(
+				[]physical.Expression{physical.NewVariable("a.age")},
+				[]physical.OrderDirection{physical.Ascending},
+				dataSource(false, nil, nil),
+			),
+		},
+		{
+			name: "order by over limit",
+			args: args{
+				plan: physical.NewOrderBy(
+					[]physical.Expression{physical.NewVariable("a.age")},
+					[]physical.OrderDirection{physical.Ascending},
+					physical.NewLimit(dataSource(true, nil, nil), physical.NewVariable("const_0")),
+				),
+			},
+			want: physical.NewOrderBy(
+				[]physical.Expression{physical.NewVariable("a.age")},
+				[]physical.OrderDirection{physical.Ascending},
+				dataSource(true, nil, physical.NewVariable("const_0")),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scenarios := []Scenario{MergeDataSourceBuilderWithOrderBy, MergeDataSourceBuilderWithLimit, UseTopNForLimitedOrderBy}
+			if got := Optimize(context.Background(), scenarios, tt.args.plan); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MergeDataSourceWithOrderBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeDataSourceWithGroupBy(t *testing.T) {
+	aggregates := map[physical.Aggregate]struct{}{
+		physical.Count: {},
+		physical.First: {},
+		physical.Sum:   {},
+	}
+	dataSource := func(availableAggregates map[physical.Aggregate]struct{}, grouping *physical.Grouping) *physical.DataSourceBuilder {
+		return &physical.DataSourceBuilder{
+			AvailableFilters: map[physical.FieldType]map[physical.Relation]struct{}{
+				physical.Primary:   make(map[physical.Relation]struct{}),
+				physical.Secondary: {physical.Equal: {}},
+			},
+			AvailableAggregates: availableAggregates,
+			Filter:              physical.NewConstant(true),
+			Grouping:            grouping,
+			Alias:               "a",
+		}
+	}
+
+	type args struct {
+		plan physical.Node
+	}
+	tests := []struct {
+		name string
+		args args
+		want physical.Node
+	}{
+		{
+			name: "count star without group by",
+			args: args{
+				plan: physical.NewGroupBy(
+					dataSource(aggregates, nil),
+					nil,
+					[]octosql.VariableName{"*star*"},
+					[]physical.Aggregate{physical.Count},
+					[]octosql.VariableName{""},
+				),
+			},
+			want: dataSource(aggregates, physical.NewGrouping(
+				nil,
+				[]octosql.VariableName{"*star*"},
+				[]physical.Aggregate{physical.Count},
+				[]octosql.VariableName{"*star*_count"},
+			)),
+		},
+		{
+			name: "key with sum",
+			args: args{
+				plan: physical.NewGroupBy(
+					dataSource(aggregates, nil),
+					[]physical.Expression{physical.NewVariable("a.city")},
+					[]octosql.VariableName{"a.city", "a.amount"},
+					[]physical.Aggregate{physical.First, physical.Sum},
+					[]octosql.VariableName{"a.city", "total"},
+				),
+			},
+			want: dataSource(aggregates, physical.NewGrouping(
+				[]octosql.VariableName{"city"},
+				[]octosql.VariableName{"city", "amount"},
+				[]physical.Aggregate{physical.First, physical.Sum},
+				[]octosql.VariableName{"a.city", "total"},
+			)),
+		},
+		{
+			name: "first of column outside of key",
+			args: args{
+				plan: physical.NewGroupBy(
+					dataSource(aggregates, nil),
+					[]physical.Expression{physical.NewVariable("a.city")},
+					[]octosql.VariableName{"a.name"},
+					[]physical.Aggregate{physical.First},
+					[]octosql.VariableName{""},
+				),
+			},
+			want: physical.NewGroupBy(
+				dataSource(aggregates, nil),
+				[]physical.Expression{physical.NewVariable("a.city")},
+				[]octosql.VariableName{"a.name"},
+				[]physical.Aggregate{physical.First},
+				[]octosql.VariableName{""},
+			),
+		},
+		{
+			name: "expression key",
+			args: args{
+				plan: physical.NewGroupBy(
+					dataSource(aggregates, nil),
+					[]physical.Expression{physical.NewFunctionExpression("lower", []physical.Expression{physical.NewVariable("a.city")})},
+					[]octosql.VariableName{"*star*"},
+					[]physical.Aggregate{physical.Count},
+					[]octosql.VariableName{""},
+				),
+			},
+			want: physical.NewGroupBy(
+				dataSource(aggregates, nil),
+				[]physical.Expression{physical.NewFunctionExpression("lower", []physical.Expression{physical.NewVariable("a.city")})},
+				[]octosql.VariableName{"*star*"},
+				[]physical.Aggregate{physical.Count},
+				[]octosql.VariableName{""},
+			),
+		},
+		{
+			name: "aggregate not available",
+			args: args{
+				plan: physical.NewGroupBy(
+					dataSource(nil, nil),
+					[]physical.Expression{physical.NewVariable("a.city")},
+					[]octosql.VariableName{"a.amount"},
+					[]physical.Aggregate{physical.Sum},
+					[]octosql.VariableName{""},
+				),
+			},
+			want: physical.NewGroupBy(
+				dataSource(nil, nil),
+				[]physical.Expression{physical.NewVariable("a.city")},
+				[]octosql.VariableName{"a.amount"},
+				[]physical.Aggregate{physical.Sum},
+				[]octosql.VariableName{""},
+			),
+		},
+		{
+			name: "filter above grouped data source",
+			args: args{
+				plan: physical.NewFilter(
+					physical.NewPredicate(physical.NewVariable("a.city"), physical.Equal, physical.NewVariable("const_0")),
+					physical.NewGroupBy(
+						dataSource(aggregates, nil),
+						[]physical.Expression{physical.NewVariable("a.city")},
+						[]octosql.VariableName{"a.city", "a.amount"},
+						[]physical.Aggregate{physical.First, physical.Sum},
+						[]octosql.VariableName{"a.city", "total"},
+					),
+				),
+			},
+			want: physical.NewFilter(
+				physical.NewPredicate(physical.NewVariable("a.city"), physical.Equal, physical.NewVariable("const_0")),
+				dataSource(aggregates, physical.NewGrouping(
+					[]octosql.VariableName{"city"},
+					[]octosql.VariableName{"city", "amount"},
+					[]physical.Aggregate{physical.First, physical.Sum},
+					[]octosql.VariableName{"a.city", "total"},
+				)),
+			),
+		},
+		{
+			name: "requalifier above grouped data source",
+			args: args{
+				plan: physical.NewRequalifier(
+					"b",
+					physical.NewGroupBy(
+						dataSource(aggregates, nil),
+						nil,
+						[]octosql.VariableName{"*star*"},
+						[]physical.Aggregate{physical.Count},
+						[]octosql.VariableName{"c"},
+					),
+				),
+			},
+			want: physical.NewRequalifier(
+				"b",
+				dataSource(aggregates, physical.NewGrouping(
+					nil,
+					[]octosql.VariableName{"*star*"},
+					[]physical.Aggregate{physical.Count},
+					[]octosql.VariableName{"c"},
+				)),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scenarios := []Scenario{MergeDataSourceBuilderWithRequalifier, MergeDataSourceBuilderWithFilter, MergeDataSourceBuilderWithGroupBy}
+			if got := Optimize(context.Background(), scenarios, tt.args.plan); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MergeDataSourceWithGroupBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeDataSourceWithLimit(t *testing.T) {
+	dataSource := func(availableLimit bool, limit physical.Expression) *physical.DataSourceBuilder {
+		return &physical.DataSourceBuilder{
+			AvailableLimit: availableLimit,
+			Filter:         physical.NewConstant(true),
+			Limit:          limit,
+			Alias:          "a",
+		}
+	}
+	limitPlusOffset := physical.NewFunctionExpression("+", []physical.Expression{physical.NewVariable("const_0"), physical.NewVariable("const_1")})
+
+	type args struct {
+		plan physical.Node
+	}
+	tests := []struct {
+		name string
+		args args
+		want physical.Node
+	}{
+		{
+			name: "limit",
+			args: args{
+				plan: physical.NewLimit(dataSource(true, nil), physical.NewVariable("const_0")),
+			},
+			want: dataSource(true, physical.NewVariable("const_0")),
+		},
+		{
+			name: "limit over offset",
+			args: args{
+				plan: physical.NewLimit(physical.NewOffset(dataSource(true, nil), physical.NewVariable("const_1")), physical.NewVariable("const_0")),
+			},
+			want: physical.NewLimit(physical.NewOffset(dataSource(true, limitPlusOffset), physical.NewVariable("const_1")), physical.NewVariable("const_0")),
+		},
+		{
+			name: "limit not available",
+			args: args{
+				plan: physical.NewLimit(dataSource(false, nil), physical.NewVariable("const_0")),
+			},
+			want: physical.NewLimit(dataSource(false, nil), physical.NewVariable("const_0")),
+		},
+		{
+			name: "already limited",
+			args: args{
+				plan: physical.NewLimit(dataSource(true, physical.NewVariable("const_1")), physical.NewVariable("const_0")),
+			},
+			want: physical.NewLimit(dataSource(true, physical.NewVariable("const_1")), physical.NewVariable("const_0")),
+		},
+		{
+			name: "filter not merged below limit",
+			args: args{
+				plan: physical.NewFilter(
+					physical.NewPredicate(physical.NewVariable("a.age"), physical.MoreThan, physical.NewVariable("const_1")),
+					physical.NewLimit(dataSource(true, nil), physical.NewVariable("const_0")),
+				),
+			},
+			want: physical.NewFilter(
+				physical.NewPredicate(physical.NewVariable("a.age"), physical.MoreThan, physical.NewVariable("const_1")),
+				dataSource(true, physical.NewVariable("const_0")),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Optimize(context.Background(), []Scenario{MergeDataSourceBuilderWithLimit, MergeDataSourceBuilderWithFilter}, tt.args.plan); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MergeDataSourceWithLimit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPushFilterBelowJoin(t *testing.T) {
+	source := &physical.Requalifier{
+		Qualifier: "a",
+		Source: &PlaceholderNode{
+			Name: "source",
+		},
+	}
+	joined := &physical.Requalifier{
+		Qualifier: "b",
+		Source: &PlaceholderNode{
+			Name: "joined",
+		},
+	}
+	sourceOnly := physical.NewPredicate(physical.NewVariable("a.age"), physical.MoreThan, physical.NewVariable("const_0"))
+	joinedOnly := physical.NewPredicate(physical.NewVariable("b.name"), physical.Equal, physical.NewVariable("const_1"))
+	mixed := physical.NewPredicate(physical.NewVariable("a.age"), physical.LessThan, physical.NewVariable("b.age"))
+	filter := physical.NewAnd(physical.NewAnd(sourceOnly, joinedOnly), mixed)
+
+	type args struct {
+		plan physical.Node
+	}
+	tests := []struct {
+		name string
+		args args
+		want physical.Node
+	}{
+		{
+			name: "inner join",
+			args: args{
+				plan: physical.NewFilter(filter, physical.NewInnerJoin(source, joined)),
+			},
+			want: physical.NewInnerJoin(
+				physical.NewFilter(sourceOnly, source),
+				physical.NewFilter(physical.NewAnd(joinedOnly, mixed), joined),
+			),
+		},
+		{
+			name: "left join",
+			args: args{
+				plan: physical.NewFilter(filter, physical.NewLeftJoin(source, joined)),
+			},
+			want: physical.NewFilter(
+				physical.NewAnd(joinedOnly, mixed),
+				physical.NewLeftJoin(physical.NewFilter(sourceOnly, source), joined),
+			),
+		},
+		{
+			name: "inner hash join",
+			args: args{
+				plan: physical.NewFilter(filter, physical.NewHashJoin(
+					source,
+					joined,
+					[]physical.Expression{physical.NewVariable("a.id")},
+					[]physical.Expression{physical.NewVariable("b.id")},
+					physical.NewConstant(true),
+					physical.InnerJoinType,
+				)),
+			},
+			want: physical.NewHashJoin(
+				physical.NewFilter(sourceOnly, source),
+				physical.NewFilter(joinedOnly, joined),
+				[]physical.Expression{physical.NewVariable("a.id")},
+				[]physical.Expression{physical.NewVariable("b.id")},
+				mixed,
+				physical.InnerJoinType,
+			),
+		},
+		{
+			name: "unknown joined qualifiers",
+			args: args{
+				plan: physical.NewFilter(sourceOnly, physical.NewLeftJoin(source, &PlaceholderNode{Name: "joined"})),
+			},
+			want: physical.NewFilter(sourceOnly, physical.NewLeftJoin(source, &PlaceholderNode{Name: "joined"})),
+		},
+		{
+			name: "full join",
+			args: args{
+				plan: physical.NewFilter(sourceOnly, &physical.FullJoin{
+					Source:    source,
+					Joined:    joined,
+					Condition: physical.NewConstant(true),
+				}),
+			},
+			want: physical.NewFilter(sourceOnly, &physical.FullJoin{
+				Source:    source,
+				Joined:    joined,
+				Condition: physical.NewConstant(true),
+			}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Optimize(context.Background(), []Scenario{PushFilterBelowJoin}, tt.args.plan); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PushFilterBelowJoin() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPushFilterBelowGroupBy(t *testing.T) {
+	groupBy := func(source physical.Node) physical.Node {
+		return physical.NewGroupBy(
+			source,
+			[]physical.Expression{physical.NewVariable("a.city")},
+			[]octosql.VariableName{"a.city", "a.age", "a.age"},
+			[]physical.Aggregate{physical.First, physical.First, physical.Avg},
+			[]octosql.VariableName{"a.city", "a.age", ""},
+		)
+	}
+	onCity := physical.NewPredicate(physical.NewVariable("a.city"), physical.Equal, physical.NewVariable("const_0"))
+	onAge := physical.NewPredicate(physical.NewVariable("a.age"), physical.MoreThan, physical.NewVariable("const_1"))
+	onAverage := physical.NewPredicate(physical.NewVariable("a.age_avg"), physical.MoreThan, physical.NewVariable("const_1"))
+
+	type args struct {
+		plan physical.Node
+	}
+	tests := []struct {
+		name string
+		args args
+		want physical.Node
+	}{
+		{
+			name: "predicates on key and aggregates",
+			args: args{
+				plan: physical.NewFilter(
+					physical.NewAnd(physical.NewAnd(onCity, onAge), onAverage),
+					groupBy(&PlaceholderNode{Name: "stub"}),
+				),
+			},
+			want: physical.NewFilter(
+				physical.NewAnd(onAge, onAverage),
+				groupBy(physical.NewFilter(onCity, &PlaceholderNode{Name: "stub"})),
+			),
+		},
+		{
+			name: "renamed key field",
+			args: args{
+				plan: physical.NewFilter(
+					physical.NewPredicate(physical.NewVariable("city_first"), physical.Equal, physical.NewVariable("const_0")),
+					physical.NewGroupBy(
+						&PlaceholderNode{Name: "stub"},
+						[]physical.Expression{physical.NewVariable("city")},
+						[]octosql.VariableName{"city"},
+						[]physical.Aggregate{physical.First},
+						[]octosql.VariableName{""},
+					),
+				),
+			},
+			want: physical.NewGroupBy(
+				physical.NewFilter(
+					physical.NewPredicate(physical.NewVariable("city"), physical.Equal, physical.NewVariable("const_0")),
+					&PlaceholderNode{Name: "stub"},
+				),
+				[]physical.Expression{physical.NewVariable("city")},
+				[]octosql.VariableName{"city"},
+				[]physical.Aggregate{physical.First},
+				[]octosql.VariableName{""},
+			),
+		},
+		{
+			name: "no predicates on key",
+			args: args{
+				plan: physical.NewFilter(onAverage, groupBy(&PlaceholderNode{Name: "stub"})),
+			},
+			want: physical.NewFilter(onAverage, groupBy(&PlaceholderNode{Name: "stub"})),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Optimize(context.Background(), []Scenario{PushFilterBelowGroupBy}, tt.args.plan); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PushFilterBelowGroupBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPushFilterBelowOrderBy(t *testing.T) {
+	filter := physical.NewPredicate(physical.NewVariable("a.age"), physical.MoreThan, physical.NewVariable("const_0"))
+	plan := physical.NewFilter(filter, physical.NewOrderBy(
+		[]physical.Expression{physical.NewVariable("a.age")},
+		[]physical.OrderDirection{physical.Descending},
+		&PlaceholderNode{Name: "stub"},
+	))
+	want := physical.NewOrderBy(
+		[]physical.Expression{physical.NewVariable("a.age")},
+		[]physical.OrderDirection{physical.Descending},
+		physical.NewFilter(filter, &PlaceholderNode{Name: "stub"}),
+	)
+
+	if got := Optimize(context.Background(), []Scenario{PushFilterBelowOrderBy}, plan); !reflect.DeepEqual(got, want) {
+		t.Errorf("PushFilterBelowOrderBy() = %v, want %v", got, want)
+	}
+}
+
+func TestPushFilterBelowDistinct(t *testing.T) {
+	filter := physical.NewPredicate(physical.NewVariable("a.age"), physical.MoreThan, physical.NewVariable("const_0"))
+	plan := physical.NewFilter(filter, physical.NewDistinct(&PlaceholderNode{Name: "stub"}))
+	want := physical.NewDistinct(physical.NewFilter(filter, &PlaceholderNode{Name: "stub"}))
+
+	if got := Optimize(context.Background(), []Scenario{PushFilterBelowDistinct}, plan); !reflect.DeepEqual(got, want) {
+		t.Errorf("PushFilterBelowDistinct() = %v, want %v", got, want)
+	}
+}
+
+func TestPushFilterBelowRequalifier(t *testing.T) {
+	source := physical.NewMap(
+		[]physical.NamedExpression{physical.NewVariable("a.age"), physical.NewVariable("a.name")},
+		&PlaceholderNode{Name: "stub"},
+		false,
+	)
+
+	type args struct {
+		plan physical.Node
+	}
+	tests := []struct {
+		name string
+		args args
+		want physical.Node
+	}{
+		{
+			name: "requalified variables",
+			args: args{
+				plan: physical.NewFilter(
+					physical.NewAnd(
+						physical.NewPredicate(physical.NewVariable("q.age"), physical.MoreThan, physical.NewVariable("const_0")),
+						physical.NewPredicate(physical.NewVariable("q.name"), physical.Equal, physical.NewVariable("b.name")),
+					),
+					physical.NewRequalifier("q", source),
+				),
+			},
+			want: physical.NewRequalifier("q", physical.NewFilter(
+				physical.NewAnd(
+					physical.NewPredicate(physical.NewVariable("a.age"), physical.MoreThan, physical.NewVariable("const_0")),
+					physical.NewPredicate(physical.NewVariable("a.name"), physical.Equal, physical.NewVariable("b.name")),
+				),
+				source,
+			)),
+		},
+		{
+			name: "variable with source qualifier",
+			args: args{
+				plan: physical.NewFilter(
+					physical.NewPredicate(physical.NewVariable("q.age"), physical.MoreThan, physical.NewVariable("a.age")),
+					physical.NewRequalifier("q", source),
+				),
+			},
+			want: physical.NewFilter(
+				physical.NewPredicate(physical.NewVariable("q.age"), physical.MoreThan, physical.NewVariable("a.age")),
+				physical.NewRequalifier("q", source),
+			),
+		},
+		{
+			name: "unknown source qualifier",
+			args: args{
+				plan: physical.NewFilter(
+					physical.NewPredicate(physical.NewVariable("q.age"), physical.MoreThan, physical.NewVariable("const_0")),
+					physical.NewRequalifier("q", &PlaceholderNode{Name: "stub"}),
+				),
+			},
+			want: physical.NewFilter(
+				physical.NewPredicate(physical.NewVariable("q.age"), physical.MoreThan, physical.NewVariable("const_0")),
+				physical.NewRequalifier("q", &PlaceholderNode{Name: "stub"}),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Optimize(context.Background(), []Scenario{PushFilterBelowRequalifier}, tt.args.plan); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PushFilterBelowRequalifier() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPushFilterBelowUnionAll(t *testing.T) {
+	filter := physical.NewPredicate(physical.NewVariable("a.age"), physical.MoreThan, physical.NewVariable("const_0"))
+	plan := physical.NewFilter(filter, physical.NewUnionAll(&PlaceholderNode{Name: "first"}, &PlaceholderNode{Name: "second"}))
+	want := physical.NewUnionAll(
+		physical.NewFilter(filter, &PlaceholderNode{Name: "first"}),
+		physical.NewFilter(filter, &PlaceholderNode{Name: "second"}),
+	)
+
+	if got := Optimize(context.Background(), []Scenario{PushFilterBelowUnionAll}, plan); !reflect.DeepEqual(got, want) {
+		t.Errorf("PushFilterBelowUnionAll() = %v, want %v", got, want)
+	}
+}
+
+func TestPushLimit(t *testing.T) {
+	mapNode := func(source physical.Node) physical.Node {
+		return &physical.Map{
+			Expressions: []physical.NamedExpression{physical.NewVariable("a.name")},
+			Source:      source,
+		}
+	}
+	dataSource := func(alias string, limit physical.Expression) *physical.DataSourceBuilder {
+		return &physical.DataSourceBuilder{
+			AvailableLimit: true,
+			Filter:         physical.NewConstant(true),
+			Limit:          limit,
+			Alias:          alias,
+		}
+	}
+	limitPlusOffset := physical.NewFunctionExpression("+", []physical.Expression{physical.NewVariable("const_0"), physical.NewVariable("const_1")})
+
+	type args struct {
+		plan physical.Node
+	}
+	tests := []struct {
+		name string
+		args args
+		want physical.Node
+	}{
+		{
+			name: "limit over offset over map",
+			args: args{
+				plan: physical.NewLimit(
+					physical.NewOffset(mapNode(&PlaceholderNode{Name: "stub"}), physical.NewVariable("const_1")),
+					physical.NewVariable("const_0"),
+				),
+			},
+			want: mapNode(physical.NewLimit(
+				physical.NewOffset(&PlaceholderNode{Name: "stub"}, physical.NewVariable("const_1")),
+				physical.NewVariable("const_0"),
+			)),
+		},
+		{
+			name: "limit over union all",
+			args: args{
+				plan: physical.NewLimit(
+					physical.NewUnionAll(&PlaceholderNode{Name: "first"}, &PlaceholderNode{Name: "second"}),
+					physical.NewVariable("const_0"),
+				),
+			},
+			want: physical.NewLimit(
+				physical.NewUnionAll(
+					physical.NewLimit(&PlaceholderNode{Name: "first"}, physical.NewVariable("const_0")),
+					physical.NewLimit(&PlaceholderNode{Name: "second"}, physical.NewVariable("const_0")),
+				),
+				physical.NewVariable("const_0"),
+			),
+		},
+		{
+			name: "limit over offset over union all of maps over data sources",
+			args: args{
+				plan: physical.NewLimit(
+					physical.NewOffset(
+						physical.NewUnionAll(mapNode(dataSource("a", nil)), dataSource("b", nil)),
+						physical.NewVariable("const_1"),
+					),
+					physical.NewVariable("const_0"),
+				),
+			},
+			want: physical.NewLimit(
+				physical.NewOffset(
+					physical.NewUnionAll(mapNode(dataSource("a", limitPlusOffset)), dataSource("b", limitPlusOffset)),
+					physical.NewVariable("const_1"),
+				),
+				physical.NewVariable("const_0"),
+			),
+		},
+		{
+			name: "offset over union all",
+			args: args{
+				plan: physical.NewOffset(
+					physical.NewUnionAll(&PlaceholderNode{Name: "first"}, &PlaceholderNode{Name: "second"}),
+					physical.NewVariable("const_1"),
+				),
+			},
+			want: physical.NewOffset(
+				physical.NewUnionAll(&PlaceholderNode{Name: "first"}, &PlaceholderNode{Name: "second"}),
+				physical.NewVariable("const_1"),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scenarios := []Scenario{PushLimitBelowMap, PushOffsetBelowMap, PushLimitBelowUnionAll, MergeDataSourceBuilderWithLimit}
+			if got := Optimize(context.Background(), scenarios, tt.args.plan); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PushLimit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeDataSourceBuildersOfJoins(t *testing.T) {
+	dataSource := func(connection, table, alias string, filter physical.Formula) *physical.DataSourceBuilder {
+		return &physical.DataSourceBuilder{
+			AvailableFilters: map[physical.FieldType]map[physical.Relation]struct{}{
+				physical.Primary:   make(map[physical.Relation]struct{}),
+				physical.Secondary: {physical.Equal: {}},
+			},
+			Filter:     filter,
+			Alias:      alias,
+			Connection: connection,
+			Table:      table,
+		}
+	}
+	joined := func(ds *physical.DataSourceBuilder, joins ...*physical.TableJoin) *physical.DataSourceBuilder {
+		ds.Joins = joins
+		return ds
+	}
+	joinFilter := func(left, right octosql.VariableName) physical.Formula {
+		return physical.NewPredicate(physical.NewVariable(left), physical.Equal, physical.NewVariable(right))
+	}
+
+	type args struct {
+		plan physical.Node
+	}
+	tests := []struct {
+		name string
+		args args
+		want physical.Node
+	}{
+		{
+			name: "inner join in same database",
+			args: args{
+				plan: physical.NewInnerJoin(
+					dataSource("db", "users", "u", physical.NewConstant(true)),
+					physical.NewFilter(joinFilter("o.user_id", "u.id"), dataSource("db", "orders", "o", physical.NewConstant(true))),
+				),
+			},
+			want: joined(
+				dataSource("db", "users", "u", physical.NewConstant(true)),
+				&physical.TableJoin{
+					Table:    "orders",
+					Alias:    "o",
+					Filter:   physical.NewAnd(joinFilter("o.user_id", "u.id"), physical.NewConstant(true)),
+					JoinType: physical.InnerJoinType,
+				},
+			),
+		},
+		{
+			name: "left joins of three tables",
+			args: args{
+				plan: physical.NewLeftJoin(
+					physical.NewLeftJoin(
+						dataSource("db", "users", "u", physical.NewConstant(true)),
+						dataSource("db", "orders", "o", joinFilter("o.user_id", "u.id")),
+					),
+					dataSource("db", "items", "i", joinFilter("i.order_id", "o.id")),
+				),
+			},
+			want: joined(
+				dataSource("db", "users", "u", physical.NewConstant(true)),
+				&physical.TableJoin{
+					Table:    "orders",
+					Alias:    "o",
+					Filter:   joinFilter("o.user_id", "u.id"),
+					JoinType: physical.LeftJoinType,
+				},
+				&physical.TableJoin{
+					Table:    "items",
+					Alias:    "i",
+					Filter:   joinFilter("i.order_id", "o.id"),
+					JoinType: physical.LeftJoinType,
+				},
+			),
+		},
+		{
+			name: "filter above joined tables",
+			args: args{
+				plan: physical.NewFilter(
+					joinFilter("o.status", "const_0"),
+					physical.NewInnerJoin(
+						dataSource("db", "users", "u", physical.NewConstant(true)),
+						dataSource("db", "orders", "o", joinFilter("o.user_id", "u.id")),
+					),
+				),
+			},
+			want: joined(
+				dataSource("db", "users", "u", physical.NewAnd(joinFilter("o.status", "const_0"), physical.NewConstant(true))),
+				&physical.TableJoin{
+					Table:    "orders",
+					Alias:    "o",
+					Filter:   joinFilter("o.user_id", "u.id"),
+					JoinType: physical.InnerJoinType,
+				},
+			),
+		},
+		{
+			name: "different databases",
+			args: args{
+				plan: physical.NewInnerJoin(
+					dataSource("db", "users", "u", physical.NewConstant(true)),
+					dataSource("other", "orders", "o", joinFilter("o.user_id", "u.id")),
+				),
+			},
+			want: physical.NewInnerJoin(
+				dataSource("db", "users", "u", physical.NewConstant(true)),
+				dataSource("other", "orders", "o", joinFilter("o.user_id", "u.id")),
+			),
+		},
+		{
+			name: "no database",
+			args: args{
+				plan: physical.NewInnerJoin(
+					dataSource("", "users", "u", physical.NewConstant(true)),
+					dataSource("", "orders", "o", joinFilter("o.user_id", "u.id")),
+				),
+			},
+			want: physical.NewInnerJoin(
+				dataSource("", "users", "u", physical.NewConstant(true)),
+				dataSource("", "orders", "o", joinFilter("o.user_id", "u.id")),
+			),
+		},
+		{
+			name: "limited joined table",
+			args: args{
+				plan: physical.NewInnerJoin(
+					dataSource("db", "users", "u", physical.NewConstant(true)),
+					physical.NewLimit(dataSource("db", "orders", "o", joinFilter("o.user_id", "u.id")), physical.NewVariable("const_0")),
+				),
+			},
+			want: physical.NewInnerJoin(
+				dataSource("db", "users", "u", physical.NewConstant(true)),
+				physical.NewLimit(dataSource("db", "orders", "o", joinFilter("o.user_id", "u.id")), physical.NewVariable("const_0")),
+			),
+		},
+		{
+			name: "same alias",
+			args: args{
+				plan: physical.NewInnerJoin(
+					dataSource("db", "users", "u", physical.NewConstant(true)),
+					dataSource("db", "users", "u", physical.NewConstant(true)),
+				),
+			},
+			want: physical.NewInnerJoin(
+				dataSource("db", "users", "u", physical.NewConstant(true)),
+				dataSource("db", "users", "u", physical.NewConstant(true)),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scenarios := []Scenario{MergeDataSourceBuilderWithFilter, MergeDataSourceBuildersOfInnerJoin, MergeDataSourceBuildersOfLeftJoin}
+			if got := Optimize(context.Background(), scenarios, tt.args.plan); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MergeDataSourceBuildersOfJoins() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUseHashJoin(t *testing.T) {
+	noFilters := map[physical.FieldType]map[physical.Relation]struct{}{
+		physical.Primary:   {},
+		physical.Secondary: {},
+	}
+	equalFilters := map[physical.FieldType]map[physical.Relation]struct{}{
+		physical.Primary: {},
+		physical.Secondary: {
+			physical.Equal: struct{}{},
+		},
+	}
+
+	type args struct {
+		plan physical.Node
+	}
+	tests := []struct {
+		name string
+		args args
+		want physical.Node
+	}{
+		{
+			name: "inner join without pushdown",
+			args: args{
+				plan: &physical.InnerJoin{
+					Source: &PlaceholderNode{
+						Name: "stub",
+					},
+					Joined: &physical.Filter{
+						Formula: physical.NewPredicate(
+							physical.NewVariable("u.id"),
+							physical.Equal,
+							physical.NewVariable("e.user_id"),
+						),
+						Source: &physical.DataSourceBuilder{
+							AvailableFilters: noFilters,
+							Filter:           physical.NewConstant(true),
+							Alias:            "u",
+						},
+					},
+				},
+			},
+			want: &physical.HashJoin{
+				Source: &PlaceholderNode{
+					Name: "stub",
+				},
+				Joined: &physical.DataSourceBuilder{
+					AvailableFilters: noFilters,
+					Filter:           physical.NewConstant(true),
+					Alias:            "u",
+				},
+				SourceKey: []physical.Expression{physical.NewVariable("e.user_id")},
+				JoinedKey: []physical.Expression{physical.NewVariable("u.id")},
+				Filter:    physical.NewConstant(true),
+				JoinType:  physical.InnerJoinType,
+			},
+		},
+		{
+			name: "inner join with pushdown",
+			args: args{
+				plan: &physical.InnerJoin{
+					Source: &PlaceholderNode{
+						Name: "stub",
+					},
+					Joined: &physical.Filter{
+						Formula: physical.NewPredicate(
+							physical.NewVariable("u.id"),
+							physical.Equal,
+							physical.NewVariable("e.user_id"),
+						),
+						Source: &physical.DataSourceBuilder{
+							AvailableFilters: equalFilters,
+							Filter:           physical.NewConstant(true),
+							Alias:            "u",
+						},
+					},
+				},
+			},
+			want: &physical.InnerJoin{
+				Source: &PlaceholderNode{
+					Name: "stub",
+				},
+				Joined: &physical.Filter{
+					Formula: physical.NewPredicate(
+						physical.NewVariable("u.id"),
+						physical.Equal,
+						physical.NewVariable("e.user_id"),
+					),
+					Source: &physical.DataSourceBuilder{
+						AvailableFilters: equalFilters,
+						Filter:           physical.NewConstant(true),
+						Alias:            "u",
+					},
+				},
+			},
+		},
+		{
+			name: "left join with residual filter",
+			args: args{
+				plan: &physical.LeftJoin{
+					Source: &PlaceholderNode{
+						Name: "stub",
+					},
+					Joined: &physical.Filter{
+						Formula: physical.NewAnd(
+							physical.NewPredicate(
+								physical.NewVariable("e.user_id"),
+								physical.Equal,
+								physical.NewVariable("u.id"),
+							),
+							physical.NewPredicate(
+								physical.NewVariable("u.name"),
+								physical.NotEqual,
+								physical.NewVariable("e.name"),
+							),
+						),
+						Source: &physical.DataSourceBuilder{
+							AvailableFilters: noFilters,
+							Filter:           physical.NewConstant(true),
+							Alias:            "u",
+						},
+					},
+				},
+			},
+			want: &physical.HashJoin{
+				Source: &PlaceholderNode{
+					Name: "stub",
+				},
+				Joined: &physical.DataSourceBuilder{
+					AvailableFilters: noFilters,
+					Filter:           physical.NewConstant(true),
+					Alias:            "u",
+				},
+				SourceKey: []physical.Expression{physical.NewVariable("e.user_id")},
+				JoinedKey: []physical.Expression{physical.NewVariable("u.id")},
+				Filter: physical.NewPredicate(
+					physical.NewVariable("u.name"),
+					physical.NotEqual,
+					physical.NewVariable("e.name"),
+				),
+				JoinType: physical.LeftJoinType,
+			},
+		},
+		{
+			name: "left join with data source depending on source",
+			args: args{
+				plan: &physical.LeftJoin{
+					Source: &PlaceholderNode{
+						Name: "stub",
+					},
+					Joined: &physical.Filter{
+						Formula: physical.NewPredicate(
+							physical.NewVariable("e.user_id"),
+							physical.Equal,
+							physical.NewVariable("u.id"),
+						),
+						Source: &physical.DataSourceBuilder{
+							AvailableFilters: noFilters,
+							Filter: physical.NewPredicate(
+								physical.NewVariable("u.name"),
+								physical.Equal,
+								physical.NewVariable("e.name"),
+							),
+							Alias: "u",
+						},
+					},
+				},
+			},
+			want: &physical.LeftJoin{
+				Source: &PlaceholderNode{
+					Name: "stub",
+				},
+				Joined: &physical.Filter{
+					Formula: physical.NewPredicate(
+						physical.NewVariable("e.user_id"),
+						physical.Equal,
+						physical.NewVariable("u.id"),
+					),
+					Source: &physical.DataSourceBuilder{
+						AvailableFilters: noFilters,
+						Filter: physical.NewPredicate(
+							physical.NewVariable("u.name"),
+							physical.Equal,
+							physical.NewVariable("e.name"),
+						),
+						Alias: "u",
+					},
+				},
+			},
+		},
+		{
+			name: "full join with pushdown",
+			args: args{
+				plan: &physical.FullJoin{
+					Source: &PlaceholderNode{
+						Name: "stub",
+					},
+					Joined: &physical.DataSourceBuilder{
+						AvailableFilters: equalFilters,
+						Filter:           physical.NewConstant(true),
+						Alias:            "u",
+					},
+					Condition: physical.NewPredicate(
+						physical.NewVariable("u.id"),
+						physical.Equal,
+						physical.NewVariable("e.user_id"),
+					),
+				},
+			},
+			want: &physical.HashJoin{
+				Source: &PlaceholderNode{
+					Name: "stub",
+				},
+				Joined: &physical.DataSourceBuilder{
+					AvailableFilters: equalFilters,
+					Filter:           physical.NewConstant(true),
+					Alias:            "u",
+				},
+				SourceKey: []physical.Expression{physical.NewVariable("e.user_id")},
+				JoinedKey: []physical.Expression{physical.NewVariable("u.id")},
+				Filter:    physical.NewConstant(true),
+				JoinType:  physical.FullJoinType,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scenarios := []Scenario{UseHashJoinForInnerJoin, UseHashJoinForLeftJoin, UseHashJoinForFullJoin}
+			if got := Optimize(context.Background(), scenarios, tt.args.plan); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UseHashJoin() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUseMergeJoin(t *testing.T) {
+	sortedUsers := &physical.DataSourceBuilder{
+		SortedBy: []octosql.VariableName{"id"},
+		Filter:   physical.NewConstant(true),
+		Alias:    "u",
+	}
+	unsortedUsers := &physical.DataSourceBuilder{
+		Filter: physical.NewConstant(true),
+		Alias:  "u",
+	}
+	sortedEvents := &physical.Requalifier{
+		Qualifier: "e",
+		Source: &physical.DataSourceBuilder{
+			SortedBy: []octosql.VariableName{"user_id", "ts"},
+			Filter:   physical.NewConstant(true),
+			Alias:    "events",
+		},
+	}
+
+	type args struct {
+		plan physical.Node
+	}
+	tests := []struct {
+		name string
+		args args
+		want physical.Node
+	}{
+		{
+			name: "sorted inputs",
+			args: args{
+				plan: &physical.HashJoin{
+					Source: sortedEvents,
+					Joined: sortedUsers,
+					SourceKey: []physical.Expression{
+						physical.NewVariable("e.action"),
+						physical.NewVariable("e.user_id"),
+					},
+					JoinedKey: []physical.Expression{
+						physical.NewVariable("u.action"),
+						physical.NewVariable("u.id"),
+					},
+					Filter:   physical.NewConstant(true),
+					JoinType: physical.LeftJoinType,
+				},
+			},
+			want: &physical.MergeJoin{
+				Source:    sortedEvents,
+				Joined:    sortedUsers,
+				SourceKey: []physical.Expression{physical.NewVariable("e.user_id")},
+				JoinedKey: []physical.Expression{physical.NewVariable("u.id")},
+				Filter: physical.NewPredicate(
+					physical.NewVariable("e.action"),
+					physical.Equal,
+					physical.NewVariable("u.action"),
+				),
+				JoinType: physical.LeftJoinType,
+			},
+		},
+		{
+			name: "sorted by data source ordering",
+			args: args{
+				plan: &physical.HashJoin{
+					Source: &physical.DataSourceBuilder{
+						Filter: physical.NewConstant(true),
+						Ordering: physical.NewOrdering(
+							[]octosql.VariableName{"user_id", "ts"},
+							[]physical.OrderDirection{physical.Ascending, physical.Descending},
+						),
+						Alias: "e",
+					},
+					Joined:    sortedUsers,
+					SourceKey: []physical.Expression{physical.NewVariable("e.user_id")},
+					JoinedKey: []physical.Expression{physical.NewVariable("u.id")},
+					Filter:    physical.NewConstant(true),
+					JoinType:  physical.InnerJoinType,
+				},
+			},
+			want: &physical.MergeJoin{
+				Source: &physical.DataSourceBuilder{
+					Filter: physical.NewConstant(true),
+					Ordering: physical.NewOrdering(
+						[]octosql.VariableName{"user_id", "ts"},
+						[]physical.OrderDirection{physical.Ascending, physical.Descending},
+					),
+					Alias: "e",
+				},
+				Joined:    sortedUsers,
+				SourceKey: []physical.Expression{physical.NewVariable("e.user_id")},
+				JoinedKey: []physical.Expression{physical.NewVariable("u.id")},
+				Filter:    physical.NewConstant(true),
+				JoinType:  physical.InnerJoinType,
+			},
+		},
+		{
+			name: "sorted by order by",
+			args: args{
+				plan: &physical.HashJoin{
+					Source: &physical.OrderBy{
+						Expressions: []physical.Expression{physical.NewVariable("e.user_id")},
+						Directions:  []physical.OrderDirection{physical.Ascending},
+						Source: &PlaceholderNode{
+							Name: "stub",
+						},
+					},
+					Joined:    sortedUsers,
+					SourceKey: []physical.Expression{physical.NewVariable("e.user_id")},
+					JoinedKey: []physical.Expression{physical.NewVariable("u.id")},
+					Filter:    physical.NewConstant(true),
+					JoinType:  physical.FullJoinType,
+				},
+			},
+			want: &physical.MergeJoin{
+				Source: &physical.OrderBy{
+					Expressions: []physical.Expression{physical.NewVariable("e.user_id")},
+					Directions:  []physical.OrderDirection{physical.Ascending},
+					Source: &PlaceholderNode{
+						Name: "stub",
+					},
+				},
+				Joined:    sortedUsers,
+				SourceKey: []physical.Expression{physical.NewVariable("e.user_id")},
+				JoinedKey: []physical.Expression{physical.NewVariable("u.id")},
+				Filter:    physical.NewConstant(true),
+				JoinType:  physical.FullJoinType,
+			},
+		},
+		{
+			name: "unsorted joined input",
+			args: args{
+				plan: &physical.HashJoin{
+					Source:    sortedEvents,
+					Joined:    unsortedUsers,
+					SourceKey: []physical.Expression{physical.NewVariable("e.user_id")},
+					JoinedKey: []physical.Expression{physical.NewVariable("u.id")},
+					Filter:    physical.NewConstant(true),
+					JoinType:  physical.InnerJoinType,
+				},
+			},
+			want: &physical.HashJoin{
+				Source:    sortedEvents,
+				Joined:    unsortedUsers,
+				SourceKey: []physical.Expression{physical.NewVariable("e.user_id")},
+				JoinedKey: []physical.Expression{physical.NewVariable("u.id")},
+				Filter:    physical.NewConstant(true),
+				JoinType:  physical.InnerJoinType,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Optimize(context.Background(), []Scenario{UseMergeJoinForSortedInputs}, tt.args.plan); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UseMergeJoin() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUseTopN(t *testing.T) {
+	orderBy := &physical.OrderBy{
+		Expressions: []physical.Expression{physical.NewVariable("a.age")},
+		Directions:  []physical.OrderDirection{physical.Descending},
+		Source: &PlaceholderNode{
+			Name: "stub",
+		},
+	}
+	mapNode := func(source physical.Node) physical.Node {
+		return &physical.Map{
+			Expressions: []physical.NamedExpression{physical.NewVariable("a.name")},
+			Source:      source,
+		}
+	}
+
+	type args struct {
+		plan physical.Node
+	}
+	tests := []struct {
+		name string
+		args args
+		want physical.Node
+	}{
+		{
+			name: "limit over order by",
+			args: args{
+				plan: physical.NewLimit(orderBy, physical.NewVariable("const_0")),
+			},
+			want: &physical.TopN{
+				Expressions: []physical.Expression{physical.NewVariable("a.age")},
+				Directions:  []physical.OrderDirection{physical.Descending},
+				Limit:       physical.NewVariable("const_0"),
+				Source: &PlaceholderNode{
+					Name: "stub",
+				},
+			},
+		},
+		{
+			name: "limit over offset over map over order by",
+			args: args{
+				plan: physical.NewLimit(
+					physical.NewOffset(mapNode(orderBy), physical.NewVariable("const_1")),
+					physical.NewVariable("const_0"),
+				),
+			},
+			want: mapNode(&physical.TopN{
+				Expressions: []physical.Expression{physical.NewVariable("a.age")},
+				Directions:  []physical.OrderDirection{physical.Descending},
+				Limit:       physical.NewVariable("const_0"),
+				Offset:      physical.NewVariable("const_1"),
+				Source: &PlaceholderNode{
+					Name: "stub",
+				},
+			}),
+		},
+		{
+			name: "limit over distinct over order by",
+			args: args{
+				plan: physical.NewLimit(physical.NewDistinct(orderBy), physical.NewVariable("const_0")),
+			},
+			want: physical.NewLimit(physical.NewDistinct(orderBy), physical.NewVariable("const_0")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Optimize(context.Background(), []Scenario{UseTopNForLimitedOrderBy}, tt.args.plan); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UseTopN() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestMultiOptimization(t *testing.T) {
 	type args struct {
 		plan physical.Node
@@ -14,6 +14,23 @@ const (
 	Descending OrderDirection = "desc"
 )
 
+type sortMemoryLimitKey struct{}
+
+// WithSortMemoryLimit returns a context, in which materialized order by nodes
+// keep up to approximately the given number of bytes of records in memory, before spilling them to disk.
+func WithSortMemoryLimit(ctx context.Context, memoryLimit int) context.Context {
+	return context.WithValue(ctx, sortMemoryLimitKey{}, memoryLimit)
+}
+
+// SortMemoryLimit returns the order by memory limit set in the context, it defaults to execution.DefaultSortMemoryLimit.
+func SortMemoryLimit(ctx context.Context) int {
+	memoryLimit, ok := ctx.Value(sortMemoryLimitKey{}).(int)
+	if !ok {
+		return execution.DefaultSortMemoryLimit
+	}
+	return memoryLimit
+}
+
 type OrderBy struct {
 	Expressions []Expression
 	Directions  []OrderDirection
@@ -66,5 +83,5 @@ func (node *OrderBy) Materialize(ctx context.Context) (execution.Node, error) {
 		return nil, errors.Wrap(err, "couldn't get execution node from order by source")
 	}
 
-	return execution.NewOrderBy(exprs, directions, sourceNode), nil
+	return execution.NewOrderBy(exprs, directions, sourceNode, SortMemoryLimit(ctx)), nil
 }
@@ -0,0 +1,70 @@
+package physical
+
+import (
+	"fmt"
+
+	"github.com/cube2222/octosql"
+)
+
+// Ordering describes the order, in which a data source should return its records.
+// Columns are the unqualified columns to sort by, each in the corresponding direction.
+type Ordering struct {
+	Columns    []octosql.VariableName
+	Directions []OrderDirection
+}
+
+func NewOrdering(columns []octosql.VariableName, directions []OrderDirection) *Ordering {
+	return &Ordering{Columns: columns, Directions: directions}
+}
+
+// SortedBy returns the variables, by which the records of the given node are known to be sorted in ascending order.
+func SortedBy(node Node) []octosql.VariableName {
+	switch node := node.(type) {
+	case *DataSourceBuilder:
+		// Grouped and joined records come in any order the database returns them in.
+		if node.Grouping != nil || len(node.Joins) > 0 {
+			return nil
+		}
+		sortedBy := node.SortedBy
+		if node.Ordering != nil {
+			sortedBy = nil
+			for i := range node.Ordering.Columns {
+				if node.Ordering.Directions[i] != Ascending {
+					break
+				}
+				sortedBy = append(sortedBy, node.Ordering.Columns[i])
+			}
+		}
+
+		out := make([]octosql.VariableName, len(sortedBy))
+		for i := range sortedBy {
+			out[i] = octosql.NewVariableName(fmt.Sprintf("%s.%s", node.Alias, sortedBy[i]))
+		}
+		return out
+
+	case *OrderBy:
+		var out []octosql.VariableName
+		for i := range node.Expressions {
+			variable, ok := node.Expressions[i].(*Variable)
+			if !ok || node.Directions[i] != Ascending {
+				break
+			}
+			out = append(out, variable.Name)
+		}
+		return out
+
+	case *Requalifier:
+		sortedBy := SortedBy(node.Source)
+		out := make([]octosql.VariableName, len(sortedBy))
+		for i := range sortedBy {
+			out[i] = octosql.NewVariableName(fmt.Sprintf("%s.%s", node.Qualifier, sortedBy[i].Name()))
+		}
+		return out
+
+	case *Filter:
+		return SortedBy(node.Source)
+
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,20 @@
+package physical
+
+import "context"
+
+type parallelismKey struct{}
+
+// WithParallelism returns a context, in which materialized maps and filters evaluate records concurrently
+// in the given number of workers, and union alls read both of their inputs concurrently.
+func WithParallelism(ctx context.Context, parallelism int) context.Context {
+	return context.WithValue(ctx, parallelismKey{}, parallelism)
+}
+
+// Parallelism returns the parallelism set in the context, it defaults to 1, which disables parallel execution.
+func Parallelism(ctx context.Context) int {
+	parallelism, ok := ctx.Value(parallelismKey{}).(int)
+	if !ok {
+		return 1
+	}
+	return parallelism
+}
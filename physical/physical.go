@@ -2,6 +2,7 @@ package physical
 
 import (
 	"context"
+	"sort"
 
 	"github.com/cube2222/octosql"
 	"github.com/cube2222/octosql/execution"
@@ -129,7 +130,29 @@ func (ne *NodeExpression) Materialize(ctx context.Context) (execution.Expression
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't materialize node")
 	}
-	return execution.NewNodeExpression(materialized), nil
+	return execution.NewCachedNodeExpression(materialized, referencedVariables(ctx, ne.Node)), nil
+}
+
+// referencedVariables returns the sorted names of all the variables used anywhere in the node.
+func referencedVariables(ctx context.Context, node Node) []octosql.VariableName {
+	found := make(map[octosql.VariableName]struct{})
+	node.Transform(ctx, &Transformers{
+		NamedExprT: func(expr NamedExpression) NamedExpression {
+			if variable, ok := expr.(*Variable); ok {
+				found[variable.Name] = struct{}{}
+			}
+			return expr
+		},
+	})
+
+	out := make([]octosql.VariableName, 0, len(found))
+	for name := range found {
+		out = append(out, name)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i] < out[j]
+	})
+	return out
 }
 
 // LogicExpressions describes a boolean expression which get's it's value from the logic formula underneath.
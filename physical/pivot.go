@@ -0,0 +1,69 @@
+package physical
+
+import (
+	"context"
+
+	"github.com/cube2222/octosql"
+	"github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/execution/aggregates"
+	"github.com/pkg/errors"
+)
+
+type Pivot struct {
+	Source Node
+
+	PivotField octosql.VariableName
+	ValueField octosql.VariableName
+	Aggregate  Aggregate
+
+	Values []Expression
+	As     []octosql.VariableName
+}
+
+func NewPivot(source Node, pivotField, valueField octosql.VariableName, aggregate Aggregate, values []Expression, as []octosql.VariableName) *Pivot {
+	return &Pivot{Source: source, PivotField: pivotField, ValueField: valueField, Aggregate: aggregate, Values: values, As: as}
+}
+
+func (node *Pivot) Transform(ctx context.Context, transformers *Transformers) Node {
+	values := make([]Expression, len(node.Values))
+	for i := range node.Values {
+		values[i] = node.Values[i].Transform(ctx, transformers)
+	}
+
+	var transformed Node = &Pivot{
+		Source:     node.Source.Transform(ctx, transformers),
+		PivotField: node.PivotField,
+		ValueField: node.ValueField,
+		Aggregate:  node.Aggregate,
+		Values:     values,
+		As:         node.As,
+	}
+
+	if transformers.NodeT != nil {
+		transformed = transformers.NodeT(transformed)
+	}
+
+	return transformed
+}
+
+func (node *Pivot) Materialize(ctx context.Context) (execution.Node, error) {
+	source, err := node.Source.Materialize(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't materialize source node in pivot")
+	}
+
+	values := make([]execution.Expression, len(node.Values))
+	for i := range node.Values {
+		values[i], err = node.Values[i].Materialize(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't materialize pivot value expression with index %v", i)
+		}
+	}
+
+	aggregatePrototype, ok := aggregates.AggregateTable[string(node.Aggregate)]
+	if !ok {
+		return nil, errors.Errorf("invalid aggregate in pivot: %v", node.Aggregate)
+	}
+
+	return execution.NewPivot(source, node.PivotField, node.ValueField, aggregatePrototype, values, node.As, GroupMemoryLimit(ctx)), nil
+}
@@ -0,0 +1,98 @@
+package physical
+
+// Qualifiers returns the qualifiers of the variables in the records of the given node.
+// It returns false if they can't be determined.
+func Qualifiers(node Node) (map[string]struct{}, bool) {
+	switch node := node.(type) {
+	case *DataSourceBuilder:
+		if node.Grouping != nil {
+			out := make(map[string]struct{})
+			for _, name := range node.Grouping.As {
+				out[name.Source()] = struct{}{}
+			}
+			return out, true
+		}
+		out := map[string]struct{}{node.Alias: {}}
+		for _, join := range node.Joins {
+			out[join.Alias] = struct{}{}
+		}
+		return out, true
+
+	case *Requalifier:
+		return map[string]struct{}{node.Qualifier: {}}, true
+
+	case *Filter:
+		return Qualifiers(node.Source)
+	case *OrderBy:
+		return Qualifiers(node.Source)
+	case *Limit:
+		return Qualifiers(node.Source)
+	case *Offset:
+		return Qualifiers(node.Source)
+	case *TopN:
+		return Qualifiers(node.Source)
+	case *Distinct:
+		return Qualifiers(node.Child)
+	case *DistinctOn:
+		return Qualifiers(node.Source)
+	case *TableSample:
+		return Qualifiers(node.Source)
+
+	case *InnerJoin:
+		return joinQualifiers(node.Source, node.Joined)
+	case *LeftJoin:
+		return joinQualifiers(node.Source, node.Joined)
+	case *FullJoin:
+		return joinQualifiers(node.Source, node.Joined)
+	case *HashJoin:
+		return joinQualifiers(node.Source, node.Joined)
+	case *MergeJoin:
+		return joinQualifiers(node.Source, node.Joined)
+
+	case *Map:
+		out := make(map[string]struct{})
+		if node.Keep {
+			sourceQualifiers, ok := Qualifiers(node.Source)
+			if !ok {
+				return nil, false
+			}
+			for qualifier := range sourceQualifiers {
+				out[qualifier] = struct{}{}
+			}
+		}
+		for _, expr := range node.Expressions {
+			switch expr := expr.(type) {
+			case *Variable:
+				out[expr.Name.Source()] = struct{}{}
+			case *AliasedExpression:
+				out[expr.Name.Source()] = struct{}{}
+			default:
+				return nil, false
+			}
+		}
+		return out, true
+
+	default:
+		return nil, false
+	}
+}
+
+func joinQualifiers(source, joined Node) (map[string]struct{}, bool) {
+	sourceQualifiers, ok := Qualifiers(source)
+	if !ok {
+		return nil, false
+	}
+	joinedQualifiers, ok := Qualifiers(joined)
+	if !ok {
+		return nil, false
+	}
+
+	out := make(map[string]struct{}, len(sourceQualifiers)+len(joinedQualifiers))
+	for qualifier := range sourceQualifiers {
+		out[qualifier] = struct{}{}
+	}
+	for qualifier := range joinedQualifiers {
+		out[qualifier] = struct{}{}
+	}
+	return out, true
+}
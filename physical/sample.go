@@ -0,0 +1,95 @@
+package physical
+
+import (
+	"context"
+
+	"github.com/cube2222/octosql/execution"
+	"github.com/pkg/errors"
+)
+
+// SampleMethod describes the way in which a table gets sampled.
+type SampleMethod string
+
+const (
+	// Bernoulli takes each record with the given percentage probability.
+	Bernoulli SampleMethod = "bernoulli"
+	// System takes whole blocks of records with the given percentage probability.
+	System SampleMethod = "system"
+	// Reservoir takes the given number of records, uniformly at random.
+	Reservoir SampleMethod = "reservoir"
+)
+
+// Sampling describes how a table should be sampled.
+// The Amount is a percentage for Bernoulli and System sampling and a record count for Reservoir sampling.
+// The Seed may be nil, in which case the sample won't be repeatable.
+type Sampling struct {
+	Method SampleMethod
+	Amount Expression
+	Seed   Expression
+}
+
+func NewSampling(method SampleMethod, amount Expression, seed Expression) *Sampling {
+	return &Sampling{Method: method, Amount: amount, Seed: seed}
+}
+
+func (s *Sampling) Transform(ctx context.Context, transformers *Transformers) *Sampling {
+	if s == nil {
+		return nil
+	}
+
+	var seed Expression
+	if s.Seed != nil {
+		seed = s.Seed.Transform(ctx, transformers)
+	}
+
+	return &Sampling{
+		Method: s.Method,
+		Amount: s.Amount.Transform(ctx, transformers),
+		Seed:   seed,
+	}
+}
+
+// TableSample samples the records of its source.
+type TableSample struct {
+	Sampling *Sampling
+	Source   Node
+}
+
+func NewTableSample(sampling *Sampling, source Node) *TableSample {
+	return &TableSample{Sampling: sampling, Source: source}
+}
+
+func (node *TableSample) Transform(ctx context.Context, transformers *Transformers) Node {
+	var transformed Node = &TableSample{
+		Sampling: node.Sampling.Transform(ctx, transformers),
+		Source:   node.Source.Transform(ctx, transformers),
+	}
+
+	if transformers.NodeT != nil {
+		transformed = transformers.NodeT(transformed)
+	}
+
+	return transformed
+}
+
+func (node *TableSample) Materialize(ctx context.Context) (execution.Node, error) {
+	amount, err := node.Sampling.Amount.Materialize(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't materialize sample amount expression")
+	}
+
+	var seed execution.Expression
+	if node.Sampling.Seed != nil {
+		seed, err = node.Sampling.Seed.Materialize(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't materialize sample seed expression")
+		}
+	}
+
+	source, err := node.Source.Materialize(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't materialize source node in table sample")
+	}
+
+	return execution.NewSample(execution.SampleMethod(node.Sampling.Method), amount, seed, source), nil
+}
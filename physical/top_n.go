@@ -0,0 +1,88 @@
+package physical
+
+import (
+	"context"
+
+	"github.com/cube2222/octosql/execution"
+	"github.com/pkg/errors"
+)
+
+// TopN is a Limit over an Offset over an OrderBy, only keeping Limit + Offset records in memory.
+// Offset may be nil.
+type TopN struct {
+	Expressions []Expression
+	Directions  []OrderDirection
+	Limit       Expression
+	Offset      Expression
+	Source      Node
+}
+
+func NewTopN(expressions []Expression, directions []OrderDirection, limit Expression, offset Expression, source Node) *TopN {
+	return &TopN{
+		Expressions: expressions,
+		Directions:  directions,
+		Limit:       limit,
+		Offset:      offset,
+		Source:      source,
+	}
+}
+
+func (node *TopN) Transform(ctx context.Context, transformers *Transformers) Node {
+	exprs := make([]Expression, len(node.Expressions))
+	for i := range node.Expressions {
+		exprs[i] = node.Expressions[i].Transform(ctx, transformers)
+	}
+	var offset Expression
+	if node.Offset != nil {
+		offset = node.Offset.Transform(ctx, transformers)
+	}
+
+	var transformed Node = &TopN{
+		Expressions: exprs,
+		Directions:  node.Directions,
+		Limit:       node.Limit.Transform(ctx, transformers),
+		Offset:      offset,
+		Source:      node.Source.Transform(ctx, transformers),
+	}
+
+	if transformers.NodeT != nil {
+		transformed = transformers.NodeT(transformed)
+	}
+	return transformed
+}
+
+func (node *TopN) Materialize(ctx context.Context) (execution.Node, error) {
+	exprs := make([]execution.Expression, len(node.Expressions))
+	for i := range node.Expressions {
+		var err error
+		exprs[i], err = node.Expressions[i].Materialize(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't materialize expression with index %v", i)
+		}
+	}
+
+	directions := make([]execution.OrderDirection, len(node.Expressions))
+	for i := range node.Directions {
+		directions[i] = execution.OrderDirection(node.Directions[i])
+	}
+
+	limit, err := node.Limit.Materialize(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't materialize limit expression")
+	}
+
+	var offset execution.Expression
+	if node.Offset != nil {
+		offset, err = node.Offset.Materialize(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't materialize offset expression")
+		}
+	}
+
+	sourceNode, err := node.Source.Materialize(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get execution node from top n source")
+	}
+
+	return execution.NewTopN(exprs, directions, limit, offset, sourceNode), nil
+}
@@ -36,5 +36,5 @@ func (node *UnionAll) Materialize(ctx context.Context) (execution.Node, error) {
 		return nil, errors.Wrap(err, "couldn't materialize second node")
 	}
 
-	return execution.NewUnionAll(firstNode, secondNode), nil
+	return execution.NewUnionAll(firstNode, secondNode, Parallelism(ctx) > 1), nil
 }
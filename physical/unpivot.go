@@ -0,0 +1,45 @@
+package physical
+
+import (
+	"context"
+
+	"github.com/cube2222/octosql"
+	"github.com/cube2222/octosql/execution"
+	"github.com/pkg/errors"
+)
+
+type Unpivot struct {
+	Source  Node
+	Columns []octosql.VariableName
+
+	KeyName   octosql.VariableName
+	ValueName octosql.VariableName
+}
+
+func NewUnpivot(source Node, columns []octosql.VariableName, keyName, valueName octosql.VariableName) *Unpivot {
+	return &Unpivot{Source: source, Columns: columns, KeyName: keyName, ValueName: valueName}
+}
+
+func (node *Unpivot) Transform(ctx context.Context, transformers *Transformers) Node {
+	var transformed Node = &Unpivot{
+		Source:    node.Source.Transform(ctx, transformers),
+		Columns:   node.Columns,
+		KeyName:   node.KeyName,
+		ValueName: node.ValueName,
+	}
+
+	if transformers.NodeT != nil {
+		transformed = transformers.NodeT(transformed)
+	}
+
+	return transformed
+}
+
+func (node *Unpivot) Materialize(ctx context.Context) (execution.Node, error) {
+	source, err := node.Source.Materialize(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't materialize source node in unpivot")
+	}
+
+	return execution.NewUnpivot(source, node.Columns, node.KeyName, node.ValueName), nil
+}
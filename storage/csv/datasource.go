@@ -5,6 +5,7 @@ package csv
 
 import (
 	"bufio"
+	"context"
 	"encoding/csv"
 	"fmt"
 	"io"
@@ -33,19 +34,36 @@ func newDataSource(path, alias string) *DataSource {
 type DataSource struct {
 	path  string
 	alias string
+	// columns are the columns the records contain, all of them if it's nil.
+	columns []octosql.VariableName
 }
 
-func NewDataSourceBuilderFactory(path string) physical.DataSourceBuilderFactory {
-	return physical.NewDataSourceBuilderFactory(
-		func(filter physical.Formula, alias string) (execution.Node, error) {
-			return &DataSource{
-				path:  path,
-				alias: alias,
-			}, nil
-		},
-		nil,
-		availableFilters,
-	)
+// NewDataSourceBuilderFactory creates a new datasource builder factory for a csv file.
+// sortedBy lists the columns, by which the file is declared to be sorted in ascending order.
+// If inMemoryIndex is set, the file is loaded into an in-memory index for filtered accesses, instead of being scanned for each of them.
+func NewDataSourceBuilderFactory(path string, sortedBy []octosql.VariableName, inMemoryIndex bool) physical.DataSourceBuilderFactory {
+	source := func(alias string, columns []octosql.VariableName) (execution.Node, error) {
+		return &DataSource{
+			path:    path,
+			alias:   alias,
+			columns: columns,
+		}, nil
+	}
+	executor := func(options *physical.ExecutorOptions) (execution.Node, error) {
+		return source(options.Alias, options.Columns)
+	}
+	filters := availableFilters
+
+	if inMemoryIndex {
+		executor = physical.NewIndexedExecutor(source)
+		filters = physical.IndexedFilters
+	}
+
+	return physical.NewDataSourceBuilderFactory(physical.DataSourceBuilder{
+		Executor:         executor,
+		AvailableFilters: filters,
+		SortedBy:         sortedBy,
+	})
 }
 
 // NewDataSourceBuilderFactoryFromConfig creates a data source builder factory using the configuration.
@@ -55,10 +73,24 @@ func NewDataSourceBuilderFactoryFromConfig(dbConfig map[string]interface{}) (phy
 		return nil, errors.Wrap(err, "couldn't get path")
 	}
 
-	return NewDataSourceBuilderFactory(path), nil
+	sortedByStrings, err := config.GetStringList(dbConfig, "sortedBy", config.WithDefault([]string{}))
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get sortedBy")
+	}
+	var sortedBy []octosql.VariableName
+	for _, str := range sortedByStrings {
+		sortedBy = append(sortedBy, octosql.NewVariableName(str))
+	}
+
+	inMemoryIndex, err := config.GetBool(dbConfig, "inMemoryIndex", config.WithDefault(false))
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get inMemoryIndex")
+	}
+
+	return NewDataSourceBuilderFactory(path, sortedBy, inMemoryIndex), nil
 }
 
-func (ds *DataSource) Get(variables octosql.Variables) (execution.RecordStream, error) {
+func (ds *DataSource) Get(ctx context.Context, variables octosql.Variables) (execution.RecordStream, error) {
 	file, err := os.Open(ds.path)
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't open file")
@@ -71,18 +103,29 @@ func (ds *DataSource) Get(variables octosql.Variables) (execution.RecordStream,
 		return nil, errors.Wrap(err, "couldn't read column names")
 	}
 
-	aliasedFields := make([]octosql.VariableName, 0)
+	r.FieldsPerRecord = len(columns)
+
+	set := make(map[string]struct{})
 	for _, c := range columns {
-		aliasedFields = append(aliasedFields, octosql.VariableName(fmt.Sprintf("%s.%s", ds.alias, c)))
+		if _, present := set[c]; present {
+			return nil, errors.New("column names not unique") // cannot use Wrap() :(
+		}
+		set[c] = struct{}{}
 	}
-	r.FieldsPerRecord = len(aliasedFields)
 
-	set := make(map[octosql.VariableName]struct{})
-	for _, f := range aliasedFields {
-		if _, present := set[f]; present {
-			return nil, errors.New("column names not unique") // cannot use Wrap() :(
+	wanted := make(map[string]struct{})
+	for _, c := range ds.columns {
+		wanted[c.String()] = struct{}{}
+	}
+
+	aliasedFields := make([]octosql.VariableName, 0)
+	indices := make([]int, 0)
+	for i, c := range columns {
+		if _, ok := wanted[c]; ds.columns != nil && !ok {
+			continue
 		}
-		set[f] = struct{}{}
+		aliasedFields = append(aliasedFields, octosql.VariableName(fmt.Sprintf("%s.%s", ds.alias, c)))
+		indices = append(indices, i)
 	}
 
 	return &RecordStream{
@@ -91,6 +134,8 @@ func (ds *DataSource) Get(variables octosql.Variables) (execution.RecordStream,
 		isDone:        false,
 		alias:         ds.alias,
 		aliasedFields: aliasedFields,
+		indices:       indices,
+		schema:        execution.NewSchema(aliasedFields),
 	}, nil
 }
 
@@ -100,9 +145,17 @@ type RecordStream struct {
 	isDone        bool
 	alias         string
 	aliasedFields []octosql.VariableName
+	// indices are the positions of the aliased fields in the lines, the other values aren't parsed.
+	indices []int
+	schema  *execution.Schema
 }
 
 func (rs *RecordStream) Close() error {
+	if rs.isDone {
+		// The file gets closed once it's read until the end.
+		return nil
+	}
+
 	err := rs.file.Close()
 	if err != nil {
 		return errors.Wrap(err, "Couldn't close underlying file")
@@ -111,11 +164,15 @@ func (rs *RecordStream) Close() error {
 	return nil
 }
 
-func (rs *RecordStream) Next() (*execution.Record, error) {
+func (rs *RecordStream) Next(ctx context.Context) (*execution.Record, error) {
 	if rs.isDone {
 		return nil, execution.ErrEndOfStream
 	}
 
+	if err := ctx.Err(); err != nil {
+		return nil, errors.Wrap(err, "couldn't read record")
+	}
+
 	line, err := rs.r.Read()
 	if err == io.EOF {
 		rs.isDone = true
@@ -127,10 +184,47 @@ func (rs *RecordStream) Next() (*execution.Record, error) {
 		return nil, errors.Wrap(err, "couldn't read record")
 	}
 
-	aliasedRecord := make(map[octosql.VariableName]octosql.Value)
-	for i, v := range line {
-		aliasedRecord[rs.aliasedFields[i]] = execution.ParseType(v)
+	data := make([]octosql.Value, len(rs.indices))
+	for i, index := range rs.indices {
+		data[i] = execution.ParseType(line[index])
+	}
+
+	return execution.NewRecordFromSchema(rs.schema, data), nil
+}
+
+// NextBatch reads up to maxSize lines straight into the columns of a batch.
+func (rs *RecordStream) NextBatch(ctx context.Context, maxSize int) (*execution.RecordBatch, error) {
+	if rs.isDone {
+		return nil, execution.ErrEndOfStream
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, errors.Wrap(err, "couldn't read record")
+	}
+
+	columns := make([][]octosql.Value, len(rs.aliasedFields))
+	length := 0
+	for length < maxSize {
+		line, err := rs.r.Read()
+		if err == io.EOF {
+			rs.isDone = true
+			rs.file.Close()
+			break
+		}
+
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't read record")
+		}
+
+		for i, index := range rs.indices {
+			columns[i] = append(columns[i], execution.ParseType(line[index]))
+		}
+		length++
+	}
+
+	if length == 0 {
+		return nil, execution.ErrEndOfStream
 	}
 
-	return execution.NewRecord(rs.aliasedFields, aliasedRecord), nil
+	return execution.NewRecordBatch(rs.schema, columns, length), nil
 }
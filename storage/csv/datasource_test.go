@@ -1,11 +1,13 @@
 package csv
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
 	"github.com/cube2222/octosql"
 	"github.com/cube2222/octosql/execution"
+	"github.com/pkg/errors"
 )
 
 type csvDsc struct {
@@ -35,6 +37,7 @@ var csvDbs = map[string]csvDsc{
 }
 
 func TestCSVDataSource_Get(t *testing.T) {
+	ctx := context.Background()
 	tests := []struct {
 		name    string
 		csvName string
@@ -56,7 +59,7 @@ func TestCSVDataSource_Get(t *testing.T) {
 		ds := newDataSource(csvDbs[tt.csvName].path, csvDbs[tt.csvName].alias)
 
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := ds.Get(octosql.NoVariables())
+			_, err := ds.Get(ctx, octosql.NoVariables())
 			if (err != nil) != tt.wantErr {
 				t.Errorf("DataSource.Get() error is %v, want %v", err, tt.wantErr)
 			}
@@ -65,6 +68,7 @@ func TestCSVDataSource_Get(t *testing.T) {
 }
 
 func TestCSVRecordStream_Next(t *testing.T) {
+	ctx := context.Background()
 	type wanted struct {
 		record *execution.Record
 		error  bool
@@ -160,14 +164,14 @@ func TestCSVRecordStream_Next(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ds := newDataSource(csvDbs[tt.csvName].path, csvDbs[tt.csvName].alias)
-			rs, err := ds.Get(octosql.NoVariables())
+			rs, err := ds.Get(ctx, octosql.NoVariables())
 			if err != nil {
 				t.Errorf("DataSource.Get() error: %v", err)
 				return
 			}
 
 			for _, expected := range tt.want {
-				got, err := rs.Next()
+				got, err := rs.Next(ctx)
 
 				if err != nil || (err != nil) != expected.error {
 					if (err != nil) != expected.error {
@@ -183,3 +187,113 @@ func TestCSVRecordStream_Next(t *testing.T) {
 		})
 	}
 }
+
+func TestCSVRecordStream_NextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	ds := newDataSource(csvDbs["people"].path, csvDbs["people"].alias)
+	rs, err := ds.Get(ctx, octosql.NoVariables())
+	if err != nil {
+		t.Fatalf("DataSource.Get() error: %v", err)
+	}
+	defer rs.Close()
+
+	if _, err := rs.Next(ctx); err != nil {
+		t.Fatalf("DataSource.Next() error: %v", err)
+	}
+
+	cancel()
+	if _, err := rs.Next(ctx); errors.Cause(err) != context.Canceled {
+		t.Errorf("DataSource.Next() error is %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestCSVRecordStream_CloseAfterEnd(t *testing.T) {
+	ctx := context.Background()
+
+	ds := newDataSource(csvDbs["people"].path, csvDbs["people"].alias)
+	rs, err := ds.Get(ctx, octosql.NoVariables())
+	if err != nil {
+		t.Fatalf("DataSource.Get() error: %v", err)
+	}
+
+	for {
+		_, err := rs.Next(ctx)
+		if err == execution.ErrEndOfStream {
+			break
+		}
+		if err != nil {
+			t.Fatalf("DataSource.Next() error: %v", err)
+		}
+	}
+
+	if err := rs.Close(); err != nil {
+		t.Errorf("DataSource.Close() error: %v", err)
+	}
+}
+
+func TestCSVRecordStream_NextBatch(t *testing.T) {
+	ctx := context.Background()
+
+	ds := newDataSource(csvDbs["people"].path, csvDbs["people"].alias)
+	want, err := ds.Get(ctx, octosql.NoVariables())
+	if err != nil {
+		t.Fatalf("DataSource.Get() error: %v", err)
+	}
+	rs, err := ds.Get(ctx, octosql.NoVariables())
+	if err != nil {
+		t.Fatalf("DataSource.Get() error: %v", err)
+	}
+
+	var lengths []int
+	var got []*execution.Record
+	for {
+		batch, err := rs.(execution.BatchRecordStream).NextBatch(ctx, 3)
+		if err == execution.ErrEndOfStream {
+			break
+		}
+		if err != nil {
+			t.Fatalf("DataSource.NextBatch() error: %v", err)
+		}
+		lengths = append(lengths, batch.Len())
+		for i := 0; i < batch.Len(); i++ {
+			got = append(got, batch.Record(i))
+		}
+	}
+
+	if !reflect.DeepEqual(lengths, []int{3, 1}) {
+		t.Errorf("DataSource.NextBatch() batch lengths are %v, want %v", lengths, []int{3, 1})
+	}
+
+	equal, err := execution.AreStreamsEqual(ctx, want, execution.NewInMemoryStream(got))
+	if err != nil {
+		t.Errorf("Error in AreStreamsEqual(): %v", err)
+	}
+	if !equal {
+		t.Errorf("Batched records don't match records")
+	}
+}
+
+func TestCSVDataSource_Columns(t *testing.T) {
+	ctx := context.Background()
+
+	ds := &DataSource{
+		path:    csvDbs["people"].path,
+		alias:   csvDbs["people"].alias,
+		columns: []octosql.VariableName{"age", "name", "missing"},
+	}
+	rs, err := ds.Get(ctx, octosql.NoVariables())
+	if err != nil {
+		t.Fatalf("DataSource.Get() error: %v", err)
+	}
+	defer rs.Close()
+
+	got, err := rs.Next(ctx)
+	if err != nil {
+		t.Fatalf("DataSource.Next() error: %v", err)
+	}
+	want := execution.NewRecordFromSliceWithNormalize([]octosql.VariableName{"p.name", "p.age"}, []interface{}{"jan", 3})
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("DataSource.Next() is %v, want %v", got, want)
+	}
+}
@@ -1,6 +1,7 @@
 package json
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -22,21 +23,35 @@ type DataSource struct {
 	path        string
 	alias       string
 	arrayFormat bool
+	// columns are the columns the records contain, all of them if it's nil.
+	columns []octosql.VariableName
 }
 
-func NewDataSourceBuilderFactory(path string, arrayFormat bool) physical.DataSourceBuilderFactory {
-	return physical.NewDataSourceBuilderFactory(
-		func(filter physical.Formula, alias string) (execution.Node, error) {
-
-			return &DataSource{
-				path:        path,
-				arrayFormat: arrayFormat,
-				alias:       alias,
-			}, nil
-		},
-		nil,
-		availableFilters,
-	)
+// NewDataSourceBuilderFactory creates a new datasource builder factory for a json file.
+// If inMemoryIndex is set, the file is loaded into an in-memory index for filtered accesses, instead of being scanned for each of them.
+func NewDataSourceBuilderFactory(path string, arrayFormat bool, inMemoryIndex bool) physical.DataSourceBuilderFactory {
+	source := func(alias string, columns []octosql.VariableName) (execution.Node, error) {
+		return &DataSource{
+			path:        path,
+			arrayFormat: arrayFormat,
+			alias:       alias,
+			columns:     columns,
+		}, nil
+	}
+	executor := func(options *physical.ExecutorOptions) (execution.Node, error) {
+		return source(options.Alias, options.Columns)
+	}
+	filters := availableFilters
+
+	if inMemoryIndex {
+		executor = physical.NewIndexedExecutor(source)
+		filters = physical.IndexedFilters
+	}
+
+	return physical.NewDataSourceBuilderFactory(physical.DataSourceBuilder{
+		Executor:         executor,
+		AvailableFilters: filters,
+	})
 }
 
 // NewDataSourceBuilderFactoryFromConfig creates a data source builder factory using the configuration.
@@ -50,15 +65,28 @@ func NewDataSourceBuilderFactoryFromConfig(dbConfig map[string]interface{}) (phy
 		return nil, errors.Wrap(err, "couldn't get if json in array form")
 	}
 
-	return NewDataSourceBuilderFactory(path, arrayFormat), nil
+	inMemoryIndex, err := config.GetBool(dbConfig, "inMemoryIndex", config.WithDefault(false))
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get inMemoryIndex")
+	}
+
+	return NewDataSourceBuilderFactory(path, arrayFormat, inMemoryIndex), nil
 }
 
-func (ds *DataSource) Get(variables octosql.Variables) (execution.RecordStream, error) {
+func (ds *DataSource) Get(ctx context.Context, variables octosql.Variables) (execution.RecordStream, error) {
 	file, err := os.Open(ds.path)
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't open file")
 	}
 
+	var columns map[octosql.VariableName]struct{}
+	if ds.columns != nil {
+		columns = make(map[octosql.VariableName]struct{}, len(ds.columns))
+		for _, column := range ds.columns {
+			columns[column] = struct{}{}
+		}
+	}
+
 	return &RecordStream{
 		arrayFormat:                   ds.arrayFormat,
 		arrayFormatOpeningBracketRead: false,
@@ -66,6 +94,7 @@ func (ds *DataSource) Get(variables octosql.Variables) (execution.RecordStream,
 		decoder:                       json.NewDecoder(file),
 		isDone:                        false,
 		alias:                         ds.alias,
+		columns:                       columns,
 	}, nil
 }
 
@@ -76,9 +105,21 @@ type RecordStream struct {
 	decoder                       *json.Decoder
 	isDone                        bool
 	alias                         string
+	// columns are the fields which get decoded, all of them if it's nil.
+	columns map[octosql.VariableName]struct{}
+
+	// schema is the schema of the last record, reused while the records have the same fields.
+	schema *execution.Schema
+	// pending is a record decoded in the previous batch, which had a different schema.
+	pending *execution.Record
 }
 
 func (rs *RecordStream) Close() error {
+	if rs.isDone {
+		// The file gets closed once it's read until the end.
+		return nil
+	}
+
 	err := rs.file.Close()
 	if err != nil {
 		return errors.Wrap(err, "Couldn't close underlying file")
@@ -87,11 +128,74 @@ func (rs *RecordStream) Close() error {
 	return nil
 }
 
-func (rs *RecordStream) Next() (*execution.Record, error) {
+func (rs *RecordStream) Next(ctx context.Context) (*execution.Record, error) {
+	return rs.decodeRecord(ctx)
+}
+
+// NextBatch decodes up to maxSize records straight into the columns of a batch.
+// The batch ends early at a record with different fields, which starts the next batch.
+func (rs *RecordStream) NextBatch(ctx context.Context, maxSize int) (*execution.RecordBatch, error) {
+	record := rs.pending
+	rs.pending = nil
+	if record == nil {
+		var err error
+		record, err = rs.decodeRecord(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	schema := record.Schema()
+	columns := make([][]octosql.Value, schema.Len())
+	length := 0
+	for {
+		for i, field := range schema.Fields() {
+			columns[i] = append(columns[i], record.Value(field))
+		}
+		length++
+		if length == maxSize {
+			break
+		}
+
+		var err error
+		record, err = rs.decodeRecord(ctx)
+		if err == execution.ErrEndOfStream {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if record.Schema() != schema {
+			rs.pending = record
+			break
+		}
+	}
+
+	return execution.NewRecordBatch(schema, columns, length), nil
+}
+
+func sameFields(x, y []octosql.VariableName) bool {
+	if len(x) != len(y) {
+		return false
+	}
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// decodeRecord decodes the next record, with its fields sorted.
+func (rs *RecordStream) decodeRecord(ctx context.Context) (*execution.Record, error) {
 	if rs.isDone {
 		return nil, execution.ErrEndOfStream
 	}
 
+	if err := ctx.Err(); err != nil {
+		return nil, errors.Wrap(err, "couldn't read record")
+	}
+
 	if rs.arrayFormat && !rs.arrayFormatOpeningBracketRead {
 		tok, err := rs.decoder.Token() // Read opening [
 		if tok != json.Delim('[') {
@@ -109,8 +213,7 @@ func (rs *RecordStream) Next() (*execution.Record, error) {
 		return nil, execution.ErrEndOfStream
 	}
 
-	var record map[octosql.VariableName]interface{}
-	err := rs.decoder.Decode(&record)
+	record, err := rs.decodeFields()
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't decode json record")
 	}
@@ -129,5 +232,43 @@ func (rs *RecordStream) Next() (*execution.Record, error) {
 		return fields[i] < fields[j]
 	})
 
-	return execution.NewRecord(fields, aliasedRecord), nil
+	if rs.schema == nil || !sameFields(rs.schema.Fields(), fields) {
+		rs.schema = execution.NewSchema(fields)
+	}
+
+	data := make([]octosql.Value, len(fields))
+	for i := range fields {
+		data[i] = aliasedRecord[fields[i]]
+	}
+
+	return execution.NewRecordFromSchema(rs.schema, data), nil
+}
+
+// decodeFields decodes the next json object, leaving out the values of fields which aren't wanted without decoding them.
+func (rs *RecordStream) decodeFields() (map[octosql.VariableName]interface{}, error) {
+	var record map[octosql.VariableName]interface{}
+	if rs.columns == nil {
+		if err := rs.decoder.Decode(&record); err != nil {
+			return nil, err
+		}
+		return record, nil
+	}
+
+	var raw map[octosql.VariableName]json.RawMessage
+	if err := rs.decoder.Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	record = make(map[octosql.VariableName]interface{}, len(rs.columns))
+	for k, v := range raw {
+		if _, ok := rs.columns[k]; !ok {
+			continue
+		}
+		var value interface{}
+		if err := json.Unmarshal(v, &value); err != nil {
+			return nil, errors.Wrapf(err, "couldn't decode field %s", k)
+		}
+		record[k] = value
+	}
+	return record, nil
 }
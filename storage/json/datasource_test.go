@@ -9,11 +9,13 @@ import (
 )
 
 func TestJSONRecordStream_Get(t *testing.T) {
+	ctx := context.Background()
 	tests := []struct {
 		name        string
 		path        string
 		arrayFormat bool
 		alias       string
+		columns     []octosql.VariableName
 		want        execution.RecordStream
 	}{
 		{
@@ -68,22 +70,51 @@ func TestJSONRecordStream_Get(t *testing.T) {
 				},
 			),
 		},
+		{
+			name:        "reading chosen columns of bikes.json",
+			path:        "fixtures/bikes.json",
+			arrayFormat: false,
+			alias:       "b",
+			columns:     []octosql.VariableName{"color", "year"},
+			want: execution.NewInMemoryStream(
+				[]*execution.Record{
+					execution.NewRecordFromSliceWithNormalize(
+						[]octosql.VariableName{"b.color", "b.year"},
+						[]interface{}{"green", 2014.0},
+					),
+					execution.NewRecordFromSliceWithNormalize(
+						[]octosql.VariableName{"b.color", "b.year"},
+						[]interface{}{"black", 1988.0},
+					),
+					execution.NewRecordFromSliceWithNormalize(
+						[]octosql.VariableName{"b.color", "b.year"},
+						[]interface{}{"purple", 2009.0},
+					),
+					execution.NewRecordFromSliceWithNormalize(
+						[]octosql.VariableName{"b.color", "b.year"},
+						[]interface{}{"orange", 1979.0},
+					),
+				},
+			),
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			ds, err := NewDataSourceBuilderFactory(tt.path, tt.arrayFormat)(tt.alias).Materialize(context.Background())
+			builder := NewDataSourceBuilderFactory(tt.path, tt.arrayFormat, false)(tt.alias)
+			builder.Columns = tt.columns
+			ds, err := builder.Materialize(context.Background())
 			if err != nil {
 				t.Errorf("Error creating data source: %v", err)
 			}
 
-			got, err := ds.Get(octosql.NoVariables())
+			got, err := ds.Get(ctx, octosql.NoVariables())
 			if err != nil {
 				t.Errorf("DataSource.Get() error: %v", err)
 				return
 			}
 
-			if ok, err := execution.AreStreamsEqual(tt.want, got); !ok {
+			if ok, err := execution.AreStreamsEqual(ctx, tt.want, got); !ok {
 				t.Errorf("Streams aren't equal: %v", err)
 				return
 			}
@@ -1,6 +1,7 @@
 package mysql
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strings"
@@ -15,6 +16,7 @@ import (
 )
 
 func TestDataSource_Get(t *testing.T) {
+	ctx := context.Background()
 	host := "localhost"
 	port := 3306
 	user := "root"
@@ -286,22 +288,22 @@ func TestDataSource_Get(t *testing.T) {
 				return
 			}
 
-			dsFactory := NewDataSourceBuilderFactory(host, port, user, password, dbname, args.tablename, args.primaryKey)
+			dsFactory := NewDataSourceBuilderFactory(host, port, user, password, dbname, args.tablename, args.primaryKey, 1, 0, 0)
 			dsBuilder := dsFactory(args.alias)
 
-			execNode, err := dsBuilder.Executor(args.formula, args.alias)
+			execNode, err := dsBuilder.Executor(&physical.ExecutorOptions{Ctx: ctx, Filter: args.formula, Alias: args.alias})
 			if err != nil {
 				t.Errorf("Couldn't get ExecutionNode: %v", err)
 				return
 			}
 
-			stream, err := execNode.Get(args.variables)
+			stream, err := execNode.Get(ctx, args.variables)
 			if err != nil {
 				t.Errorf("Couldn't get stream: %v", err)
 				return
 			}
 
-			equal, err := execution.AreStreamsEqual(stream, tt.want)
+			equal, err := execution.AreStreamsEqual(ctx, stream, tt.want)
 			if err != nil {
 				t.Errorf("Error in AreStreamsEqual(): %v", err)
 				return
@@ -3,7 +3,9 @@ package mysql
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/cube2222/octosql"
 	"github.com/cube2222/octosql/execution"
 	"github.com/cube2222/octosql/physical"
 	"github.com/pkg/errors"
@@ -14,6 +16,7 @@ import (
 type aliases struct {
 	PlaceholderToExpression []physical.Expression
 	Alias                   string
+	JoinedAliases           []string
 }
 
 func newAliases(alias string) *aliases {
@@ -46,10 +49,23 @@ func relationToSQL(rel physical.Relation) string {
 	}
 }
 
+//checks if the variable is a column of the table with the alias or of one of the joined tables
+func (aliases *aliases) isColumn(name octosql.VariableName) bool {
+	if name.Source() == aliases.Alias {
+		return true
+	}
+	for _, alias := range aliases.JoinedAliases {
+		if name.Source() == alias {
+			return true
+		}
+	}
+	return false
+}
+
 func expressionToSQL(expression physical.Expression, aliases *aliases) string {
 	switch expression := expression.(type) {
 	case *physical.Variable: //if it's a variable, then check if it's a column name for alias
-		if expression.Name.Source() == aliases.Alias {
+		if aliases.isColumn(expression.Name) {
 			return expression.Name.String()
 		}
 		//if not, or we are not a variable, then create a new placeholder and assign the expression to it
@@ -99,6 +115,120 @@ func formulaToSQL(formula physical.Formula, aliases *aliases) string {
 	}
 }
 
+//creates a condition which is true for the given percentage of the rows
+func samplingToSQL(sampling *physical.Sampling, aliases *aliases) string {
+	if sampling.Method != physical.Bernoulli {
+		panic("Invalid physical sample method")
+	}
+
+	random := "RAND()"
+	if sampling.Seed != nil {
+		random = fmt.Sprintf("RAND(%s)", expressionToSQL(sampling.Seed, aliases))
+	}
+
+	return fmt.Sprintf("%s < %s / 100", random, parenthesize(expressionToSQL(sampling.Amount, aliases)))
+}
+
+//creates the JOIN clauses for the given joined tables, whose aliases need to be set as joined aliases
+//the placeholders of their conditions come before the ones of the WHERE clause
+func joinsToSQL(joins []*physical.TableJoin, aliases *aliases) string {
+	out := ""
+	for _, join := range joins {
+		var joinType string
+		switch join.JoinType {
+		case physical.InnerJoinType:
+			joinType = "JOIN"
+		case physical.LeftJoinType:
+			joinType = "LEFT JOIN"
+		default:
+			panic("Invalid physical join type")
+		}
+
+		out += fmt.Sprintf(" %s %s %s ON %s", joinType, join.Table, join.Alias, formulaToSQL(join.Filter, aliases))
+	}
+
+	return out
+}
+
+//creates the ORDER BY clause for the given ordering of the table with the given alias, or an empty string if there is none
+//text columns are sorted bytewise by their utf8mb4 encoding, like OctoSQL compares strings, so the records can be merge joined
+func orderingToSQL(ordering *physical.Ordering, alias string, textColumns map[octosql.VariableName]bool) string {
+	if ordering == nil {
+		return ""
+	}
+
+	columns := make([]string, len(ordering.Columns))
+	for i := range ordering.Columns {
+		column := fmt.Sprintf("%s.%s", alias, ordering.Columns[i])
+		if textColumns[ordering.Columns[i]] {
+			column = fmt.Sprintf("CAST(CONVERT(%s.%s USING utf8mb4) AS BINARY)", alias, ordering.Columns[i])
+		}
+		direction := "ASC"
+		if ordering.Directions[i] == physical.Descending {
+			direction = "DESC"
+		}
+		columns[i] = fmt.Sprintf("%s %s", column, direction)
+	}
+
+	return fmt.Sprintf(" ORDER BY %s", strings.Join(columns, ", "))
+}
+
+//creates the select list and the GROUP BY clause for the given grouping of the table with the given alias
+//the selected fields are named field_0, field_1 and so on, without a key, groups are only created for non-empty tables
+func groupingToSQL(grouping *physical.Grouping, alias string) (string, string) {
+	fields := make([]string, len(grouping.Fields))
+	for i := range grouping.Fields {
+		column := fmt.Sprintf("%s.%s", alias, grouping.Fields[i])
+
+		var field string
+		switch grouping.Aggregates[i] {
+		case physical.Count:
+			field = "COUNT(*)"
+		case physical.CountDistinct:
+			//unlike in SQL, null is counted as one of the distinct values
+			field = fmt.Sprintf("COUNT(DISTINCT %s) + MAX(CASE WHEN %s IS NULL THEN 1 ELSE 0 END)", column, column)
+		case physical.First, physical.Last:
+			field = column
+		case physical.Avg:
+			field = fmt.Sprintf("AVG(%s)", column)
+		case physical.AvgDistinct:
+			field = fmt.Sprintf("AVG(DISTINCT %s)", column)
+		case physical.Max:
+			field = fmt.Sprintf("MAX(%s)", column)
+		case physical.Min:
+			field = fmt.Sprintf("MIN(%s)", column)
+		case physical.Sum:
+			field = fmt.Sprintf("SUM(%s)", column)
+		case physical.SumDistinct:
+			field = fmt.Sprintf("SUM(DISTINCT %s)", column)
+		default:
+			panic("Invalid physical aggregate")
+		}
+
+		fields[i] = fmt.Sprintf("%s AS field_%d", field, i)
+	}
+
+	if len(grouping.Key) == 0 {
+		return strings.Join(fields, ", "), " HAVING COUNT(*) > 0"
+	}
+
+	key := make([]string, len(grouping.Key))
+	for i := range grouping.Key {
+		key[i] = fmt.Sprintf("%s.%s", alias, grouping.Key[i])
+	}
+
+	return strings.Join(fields, ", "), fmt.Sprintf(" GROUP BY %s", strings.Join(key, ", "))
+}
+
+//creates the LIMIT clause for the given limit, or an empty string if there is none
+func limitToSQL(limit physical.Expression, aliases *aliases) string {
+	if limit == nil {
+		return ""
+	}
+
+	return fmt.Sprintf(" LIMIT %s", expressionToSQL(limit, aliases))
+}
+
 func parenthesize(str string) string {
 	return fmt.Sprintf("(%s)", str)
 }
@@ -4,6 +4,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/cube2222/octosql"
 	"github.com/cube2222/octosql/physical"
 )
 
@@ -151,3 +152,224 @@ func TestFormulaToSQL(t *testing.T) {
 		})
 	}
 }
+
+func TestSamplingToSQL(t *testing.T) {
+	type args struct {
+		sampling *physical.Sampling
+		aliases  *aliases
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        string
+		wantAliases *aliases
+	}{
+		{
+			name: "bernoulli sampling",
+			args: args{
+				sampling: physical.NewSampling(physical.Bernoulli, physical.NewVariable("const_0"), nil),
+				aliases:  newAliases("e"),
+			},
+			want: "RAND() < (?) / 100",
+			wantAliases: &aliases{
+				PlaceholderToExpression: []physical.Expression{
+					physical.NewVariable("const_0"),
+				},
+				Alias: "e",
+			},
+		},
+		{
+			name: "repeatable bernoulli sampling",
+			args: args{
+				sampling: physical.NewSampling(physical.Bernoulli, physical.NewVariable("const_0"), physical.NewVariable("const_1")),
+				aliases:  newAliases("e"),
+			},
+			want: "RAND(?) < (?) / 100",
+			wantAliases: &aliases{
+				PlaceholderToExpression: []physical.Expression{
+					physical.NewVariable("const_1"),
+					physical.NewVariable("const_0"),
+				},
+				Alias: "e",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := samplingToSQL(tt.args.sampling, tt.args.aliases); got != tt.want {
+				t.Errorf("samplingToSQL() = %v, want %v", got, tt.want)
+			}
+
+			if !reflect.DeepEqual(tt.args.aliases, tt.wantAliases) {
+				t.Errorf("samplingToSQL aliases = %v, want %v", tt.args.aliases, tt.wantAliases)
+			}
+		})
+	}
+}
+
+func TestLimitToSQL(t *testing.T) {
+	tests := []struct {
+		name        string
+		limit       physical.Expression
+		aliases     *aliases
+		want        string
+		wantAliases *aliases
+	}{
+		{
+			name:    "no limit",
+			limit:   nil,
+			aliases: newAliases("e"),
+			want:    "",
+			wantAliases: &aliases{
+				PlaceholderToExpression: []physical.Expression{},
+				Alias:                   "e",
+			},
+		},
+		{
+			name:  "limit after other placeholders",
+			limit: physical.NewVariable("const_1"),
+			aliases: &aliases{
+				PlaceholderToExpression: []physical.Expression{
+					physical.NewVariable("const_0"),
+				},
+				Alias: "e",
+			},
+			want: " LIMIT ?",
+			wantAliases: &aliases{
+				PlaceholderToExpression: []physical.Expression{
+					physical.NewVariable("const_0"),
+					physical.NewVariable("const_1"),
+				},
+				Alias: "e",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := limitToSQL(tt.limit, tt.aliases); got != tt.want {
+				t.Errorf("limitToSQL() = %v, want %v", got, tt.want)
+			}
+
+			if !reflect.DeepEqual(tt.aliases, tt.wantAliases) {
+				t.Errorf("limitToSQL aliases = %v, want %v", tt.aliases, tt.wantAliases)
+			}
+		})
+	}
+}
+
+func TestOrderingToSQL(t *testing.T) {
+	tests := []struct {
+		name        string
+		ordering    *physical.Ordering
+		textColumns map[octosql.VariableName]bool
+		want        string
+	}{
+		{
+			name:     "no ordering",
+			ordering: nil,
+			want:     "",
+		},
+		{
+			name: "ascending and descending columns",
+			ordering: physical.NewOrdering(
+				[]octosql.VariableName{"age", "name"},
+				[]physical.OrderDirection{physical.Descending, physical.Ascending},
+			),
+			want: " ORDER BY e.age DESC, e.name ASC",
+		},
+		{
+			name: "text column sorted bytewise, with upper case before lower case",
+			ordering: physical.NewOrdering(
+				[]octosql.VariableName{"name", "age"},
+				[]physical.OrderDirection{physical.Ascending, physical.Ascending},
+			),
+			textColumns: map[octosql.VariableName]bool{"name": true},
+			want:        " ORDER BY CAST(CONVERT(e.name USING utf8mb4) AS BINARY) ASC, e.age ASC",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := orderingToSQL(tt.ordering, "e", tt.textColumns); got != tt.want {
+				t.Errorf("orderingToSQL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGroupingToSQL(t *testing.T) {
+	tests := []struct {
+		name        string
+		grouping    *physical.Grouping
+		wantSelect  string
+		wantGroupBy string
+	}{
+		{
+			name: "grouping by key",
+			grouping: physical.NewGrouping(
+				[]octosql.VariableName{"city"},
+				[]octosql.VariableName{"city", "amount", "name"},
+				[]physical.Aggregate{physical.First, physical.Sum, physical.CountDistinct},
+				[]octosql.VariableName{"e.city", "total", "names"},
+			),
+			wantSelect:  "e.city AS field_0, SUM(e.amount) AS field_1, COUNT(DISTINCT e.name) + MAX(CASE WHEN e.name IS NULL THEN 1 ELSE 0 END) AS field_2",
+			wantGroupBy: " GROUP BY e.city",
+		},
+		{
+			name: "grouping without key",
+			grouping: physical.NewGrouping(
+				nil,
+				[]octosql.VariableName{"*star*", "age"},
+				[]physical.Aggregate{physical.Count, physical.Max},
+				[]octosql.VariableName{"count", "oldest"},
+			),
+			wantSelect:  "COUNT(*) AS field_0, MAX(e.age) AS field_1",
+			wantGroupBy: " HAVING COUNT(*) > 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSelect, gotGroupBy := groupingToSQL(tt.grouping, "e")
+			if gotSelect != tt.wantSelect {
+				t.Errorf("groupingToSQL() select = %v, want %v", gotSelect, tt.wantSelect)
+			}
+			if gotGroupBy != tt.wantGroupBy {
+				t.Errorf("groupingToSQL() group by = %v, want %v", gotGroupBy, tt.wantGroupBy)
+			}
+		})
+	}
+}
+
+func TestJoinsToSQL(t *testing.T) {
+	aliases := newAliases("e")
+	aliases.JoinedAliases = []string{"o", "i"}
+
+	joins := []*physical.TableJoin{
+		{
+			Table:    "orders",
+			Alias:    "o",
+			Filter:   physical.NewPredicate(physical.NewVariable("o.user_id"), physical.Equal, physical.NewVariable("e.id")),
+			JoinType: physical.InnerJoinType,
+		},
+		{
+			Table: "items",
+			Alias: "i",
+			Filter: physical.NewAnd(
+				physical.NewPredicate(physical.NewVariable("i.order_id"), physical.Equal, physical.NewVariable("o.id")),
+				physical.NewPredicate(physical.NewVariable("i.shop"), physical.Equal, physical.NewVariable("s.id")),
+			),
+			JoinType: physical.LeftJoinType,
+		},
+	}
+
+	want := " JOIN orders o ON (o.user_id) = (e.id) LEFT JOIN items i ON ((i.order_id) = (o.id)) AND ((i.shop) = (?))"
+	if got := joinsToSQL(joins, aliases); got != want {
+		t.Errorf("joinsToSQL() = %v, want %v", got, want)
+	}
+
+	wantPlaceholders := []physical.Expression{
+		physical.NewVariable("s.id"),
+	}
+	if !reflect.DeepEqual(aliases.PlaceholderToExpression, wantPlaceholders) {
+		t.Errorf("joinsToSQL placeholders = %v, want %v", aliases.PlaceholderToExpression, wantPlaceholders)
+	}
+}
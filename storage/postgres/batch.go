@@ -0,0 +1,193 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cube2222/octosql"
+	"github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/physical"
+	"github.com/pkg/errors"
+)
+
+// BatchDataSource is a data source filtered on lookup keys,
+// which can query the keys for a whole batch of lookups using a single IN query.
+type BatchDataSource struct {
+	*DataSource
+	batchSize int
+
+	query       string
+	restAliases map[string]execution.Expression
+	keyColumns  []octosql.VariableName
+	keyValues   []execution.Expression
+}
+
+func newBatchDataSource(ds *DataSource, tableName string, columns []*physical.Variable, values []physical.Expression, rest physical.Formula, batchSize int) (*BatchDataSource, error) {
+	aliases := newAliases(ds.alias)
+	query := fmt.Sprintf("SELECT %s FROM %s %s WHERE %s", selectList(ds.alias, ds.columns), tableName, ds.alias, parenthesize(formulaToSQL(rest, aliases)))
+
+	restAliases, err := aliases.materializeAliases()
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't materialize aliases")
+	}
+
+	keyColumns := make([]octosql.VariableName, len(columns))
+	keyValues := make([]execution.Expression, len(values))
+	for i := range columns {
+		keyColumns[i] = columns[i].Name
+		keyValues[i], err = values[i].Materialize(context.Background())
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't materialize lookup key value with index %v", i)
+		}
+	}
+
+	return &BatchDataSource{
+		DataSource:  ds,
+		batchSize:   batchSize,
+		query:       query,
+		restAliases: restAliases,
+		keyColumns:  keyColumns,
+		keyValues:   keyValues,
+	}, nil
+}
+
+func (ds *BatchDataSource) BatchSize() int {
+	return ds.batchSize
+}
+
+func (ds *BatchDataSource) GetBatch(ctx context.Context, batch []octosql.Variables) ([]execution.RecordStream, error) {
+	results := make([][]*execution.Record, len(batch))
+
+	lookups := execution.NewHashMap()
+	var keys []octosql.Tuple
+	for i := range batch {
+		key, err := ds.evaluateKey(ctx, batch[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't evaluate lookup key with index %v", i)
+		}
+		if key == nil {
+			continue
+		}
+
+		indices, ok, err := lookups.Get(key)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't get lookup out of hashmap")
+		}
+		if !ok {
+			indices = &[]int{}
+			keys = append(keys, key)
+		}
+		*indices.(*[]int) = append(*indices.(*[]int), i)
+		err = lookups.Set(key, indices)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't put lookup into hashmap")
+		}
+	}
+
+	if len(keys) > 0 {
+		rows, err := ds.queryKeys(ctx, batch[0], keys)
+		if err != nil {
+			return nil, err
+		}
+
+		stream, err := newRecordStream(rows, ds.alias, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		for {
+			record, err := stream.Next(ctx)
+			if err != nil {
+				if err == execution.ErrEndOfStream {
+					break
+				}
+				return nil, errors.Wrap(err, "couldn't get record")
+			}
+
+			key := make(octosql.Tuple, len(ds.keyColumns))
+			for i := range ds.keyColumns {
+				key[i] = record.Value(ds.keyColumns[i])
+			}
+
+			indices, ok, err := lookups.Get(key)
+			if err != nil {
+				return nil, errors.Wrap(err, "couldn't get lookup out of hashmap")
+			}
+			if !ok {
+				continue
+			}
+			for _, index := range *indices.(*[]int) {
+				results[index] = append(results[index], record)
+			}
+		}
+	}
+
+	streams := make([]execution.RecordStream, len(batch))
+	for i := range results {
+		streams[i] = execution.NewInMemoryStream(results[i])
+	}
+
+	return streams, nil
+}
+
+// evaluateKey returns nil if any of the key values is null, as it can't match anything.
+func (ds *BatchDataSource) evaluateKey(ctx context.Context, variables octosql.Variables) (octosql.Tuple, error) {
+	key := make(octosql.Tuple, len(ds.keyValues))
+	for i := range ds.keyValues {
+		value, err := ds.keyValues[i].ExpressionValue(ctx, variables)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't get actual value from variables")
+		}
+		if value == nil {
+			return nil, nil
+		}
+		key[i] = value
+	}
+
+	return key, nil
+}
+
+func (ds *BatchDataSource) queryKeys(ctx context.Context, variables octosql.Variables, keys []octosql.Tuple) (*sql.Rows, error) {
+	values := make([]interface{}, 0, len(ds.restAliases)+len(keys)*len(ds.keyColumns))
+
+	for i := 0; i < len(ds.restAliases); i++ {
+		placeholder := "$" + strconv.Itoa(i+1)
+		expression, ok := ds.restAliases[placeholder]
+		if !ok {
+			return nil, errors.Errorf("couldn't get variable name for placeholder %s", placeholder)
+		}
+
+		value, err := expression.ExpressionValue(ctx, variables)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't get actual value from variables")
+		}
+
+		values = append(values, value)
+	}
+
+	columns := make([]string, len(ds.keyColumns))
+	for i := range ds.keyColumns {
+		columns[i] = ds.keyColumns[i].String()
+	}
+
+	tuples := make([]string, len(keys))
+	for i := range keys {
+		placeholders := make([]string, len(keys[i]))
+		for j := range keys[i] {
+			values = append(values, keys[i][j])
+			placeholders[j] = "$" + strconv.Itoa(len(values))
+		}
+		tuples[i] = parenthesize(strings.Join(placeholders, ", "))
+	}
+
+	query := fmt.Sprintf("%s AND %s IN %s", ds.query, parenthesize(strings.Join(columns, ", ")), parenthesize(strings.Join(tuples, ", ")))
+
+	rows, err := ds.db.QueryContext(ctx, query, values...)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't query batch of lookup keys")
+	}
+
+	return rows, nil
+}
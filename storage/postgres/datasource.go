@@ -1,9 +1,12 @@
 package postgres
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/cube2222/octosql"
 	"github.com/cube2222/octosql/config"
@@ -34,54 +37,131 @@ var availableFilters = map[physical.FieldType]map[physical.Relation]struct{}{
 	},
 }
 
+var availableAggregates = map[physical.Aggregate]struct{}{
+	physical.Avg:           {},
+	physical.AvgDistinct:   {},
+	physical.Count:         {},
+	physical.CountDistinct: {},
+	physical.First:         {},
+	physical.Last:          {},
+	physical.Max:           {},
+	physical.Min:           {},
+	physical.Sum:           {},
+	physical.SumDistinct:   {},
+}
+
+var availableSampling = map[physical.SampleMethod]struct{}{
+	physical.Bernoulli: {},
+	physical.System:    {},
+}
+
+// DefaultBatchSize is the default maximum number of lookups in a single batched query.
+const DefaultBatchSize = 100
+
 type DataSource struct {
-	db      *sql.DB
-	stmt    *sql.Stmt
-	aliases map[string]execution.Expression
-	alias   string
+	db       *sql.DB
+	stmt     *sql.Stmt
+	aliases  map[string]execution.Expression
+	alias    string
+	columns  []octosql.VariableName
+	prefetch int
+	grouping *physical.Grouping
+	fields   []octosql.VariableName
 }
 
+// NewDataSourceBuilderFactory creates a new datasource builder factory for a postgres table.
+// Lookups are batched into single queries for up to batchSize lookups, a batchSize of 1 disables batching.
+// Unbatched lookups are run concurrently for prefetch source records, a prefetch of 0 uses the global setting.
+// Lookup joins cache the records of up to lookupCacheSize records of the most recently looked up keys, 0 disables the cache.
+// Tables of the same database, accessed by the same user, are joined in the database.
 func NewDataSourceBuilderFactory(host string, port int, user, password, databaseName, tableName string,
-	primaryKeys []octosql.VariableName) physical.DataSourceBuilderFactory {
+	primaryKeys []octosql.VariableName, batchSize, prefetch, lookupCacheSize int) physical.DataSourceBuilderFactory {
 
 	psqlInfo := fmt.Sprintf("host=%s port=%d user=%s "+
 		"password=%s dbname=%s sslmode=disable", host, port, user, password, databaseName)
 
-	return physical.NewDataSourceBuilderFactory(
-		func(filter physical.Formula, alias string) (execution.Node, error) {
-			db, err := sql.Open("postgres", psqlInfo)
+	columnTypes := newColumnTypeCache()
+	executor := func(options *physical.ExecutorOptions) (execution.Node, error) {
+		db, err := sql.Open("postgres", psqlInfo)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't open connection to postgres database")
+		}
+
+		aliases := newAliases(options.Alias)
+		for _, join := range options.Joins {
+			aliases.JoinedAliases = append(aliases.JoinedAliases, join.Alias)
+		}
+
+		//create a query with placeholders to prepare a statement from a physical formula
+		sample := samplingToSQL(options.Sampling, aliases)
+		joined := joinsToSQL(options.Joins, aliases)
+		query := formulaToSQL(options.Filter, aliases)
+		selected, groupBy := selectList(options.Alias, options.Columns), ""
+		var fields []octosql.VariableName
+		if options.Grouping != nil {
+			selected, groupBy = groupingToSQL(options.Grouping, options.Alias)
+		} else if len(options.Joins) > 0 {
+			selected, fields, err = joinedSelectList(options.Ctx, columnTypes, db, tableName, options.Alias, options.Columns, options.Joins)
+			if err != nil {
+				return nil, errors.Wrap(err, "couldn't get columns of joined tables")
+			}
+		}
+		var textColumns map[octosql.VariableName]bool
+		if options.Ordering != nil {
+			textColumns, err = tableTextColumns(options.Ctx, columnTypes, db, tableName)
 			if err != nil {
-				return nil, errors.Wrap(err, "couldn't open connection to postgres database")
+				return nil, errors.Wrap(err, "couldn't get text columns of table")
 			}
+		}
+		query = fmt.Sprintf("SELECT %s FROM %s %s%s%s WHERE %s%s%s%s", selected, tableName, options.Alias, sample, joined, query, groupBy, orderingToSQL(options.Ordering, options.Alias, textColumns), limitToSQL(options.Limit, aliases))
 
-			aliases := newAliases(alias)
+		stmt, err := db.Prepare(query)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't prepare db for query")
+		}
 
-			//create a query with placeholders to prepare a statement from a physical formula
-			query := formulaToSQL(filter, aliases)
-			query = fmt.Sprintf("SELECT * FROM %s %s WHERE %s", tableName, alias, query)
+		//materialize the created aliases
+		execAliases, err := aliases.materializeAliases()
 
-			stmt, err := db.Prepare(query)
-			if err != nil {
-				return nil, errors.Wrap(err, "couldn't prepare db for query")
-			}
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't materialize aliases")
+		}
 
-			//materialize the created aliases
-			execAliases, err := aliases.materializeAliases()
+		ds := &DataSource{
+			stmt:     stmt,
+			aliases:  execAliases,
+			alias:    options.Alias,
+			columns:  options.Columns,
+			db:       db,
+			prefetch: prefetch,
+			grouping: options.Grouping,
+			fields:   fields,
+		}
 
-			if err != nil {
-				return nil, errors.Wrap(err, "couldn't materialize aliases")
+		if batchSize > 1 && options.Sampling == nil && options.Limit == nil && options.Ordering == nil && options.Grouping == nil && len(options.Joins) == 0 {
+			if columns, values, rest, ok := physical.ExtractLookupKeys(options.Filter, options.Alias); ok {
+				batchDataSource, err := newBatchDataSource(ds, tableName, columns, values, rest, batchSize)
+				if err != nil {
+					return nil, errors.Wrap(err, "couldn't create batch data source")
+				}
+				return batchDataSource, nil
 			}
+		}
+
+		return ds, nil
+	}
 
-			return &DataSource{
-				stmt:    stmt,
-				aliases: execAliases,
-				alias:   alias,
-				db:      db,
-			}, nil
-		},
-		primaryKeys,
-		availableFilters,
-	)
+	return physical.NewDataSourceBuilderFactory(physical.DataSourceBuilder{
+		Executor:            physical.WithLookupCache(executor, lookupCacheSize),
+		PrimaryKeys:         primaryKeys,
+		AvailableFilters:    availableFilters,
+		AvailableSampling:   availableSampling,
+		AvailableLimit:      true,
+		AvailableOrdering:   true,
+		AvailableAggregates: availableAggregates,
+		Connection:          fmt.Sprintf("postgres://%s@%s:%d/%s", user, host, port, databaseName),
+		Table:               tableName,
+	})
 }
 
 // NewDataSourceBuilderFactoryFromConfig creates a data source builder factory using the configuration.
@@ -117,10 +197,42 @@ func NewDataSourceBuilderFactoryFromConfig(dbConfig map[string]interface{}) (phy
 		return nil, errors.Wrap(err, "couldn't get password")
 	}
 
-	return NewDataSourceBuilderFactory(host, port, user, password, databaseName, tableName, primaryKeys), nil
+	batchSize, err := config.GetInt(dbConfig, "batchSize", config.WithDefault(DefaultBatchSize))
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get batchSize")
+	}
+	prefetch, err := config.GetInt(dbConfig, "prefetch", config.WithDefault(0))
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get prefetch")
+	}
+
+	lookupCacheSize, err := config.GetInt(dbConfig, "lookupCacheSize", config.WithDefault(0))
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get lookupCacheSize")
+	}
+
+	return NewDataSourceBuilderFactory(host, port, user, password, databaseName, tableName, primaryKeys, batchSize, prefetch, lookupCacheSize), nil
 }
 
-func (ds *DataSource) Get(variables octosql.Variables) (execution.RecordStream, error) {
+func (ds *DataSource) Prefetch() int {
+	return ds.prefetch
+}
+
+// LookupKey returns the values of the query's placeholders.
+func (ds *DataSource) LookupKey(ctx context.Context, variables octosql.Variables) (octosql.Value, error) {
+	values, err := ds.placeholderValues(ctx, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	key := make(octosql.Tuple, len(values))
+	for i := range values {
+		key[i], _ = values[i].(octosql.Value)
+	}
+	return key, nil
+}
+
+func (ds *DataSource) placeholderValues(ctx context.Context, variables octosql.Variables) ([]interface{}, error) {
 	values := make([]interface{}, 0)
 
 	for i := 0; i < len(ds.aliases); i++ {
@@ -131,7 +243,7 @@ func (ds *DataSource) Get(variables octosql.Variables) (execution.RecordStream,
 		}
 
 		//since we have an execution expression, then we can evaluate it given the variables
-		value, err := expression.ExpressionValue(variables)
+		value, err := expression.ExpressionValue(ctx, variables)
 		if err != nil {
 			return nil, errors.Wrap(err, "couldn't get actual value from variables")
 		}
@@ -139,30 +251,160 @@ func (ds *DataSource) Get(variables octosql.Variables) (execution.RecordStream,
 		values = append(values, value)
 	}
 
-	rows, err := ds.stmt.Query(values...)
+	return values, nil
+}
+
+func (ds *DataSource) Get(ctx context.Context, variables octosql.Variables) (execution.RecordStream, error) {
+	values, err := ds.placeholderValues(ctx, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := ds.stmt.QueryContext(ctx, values...)
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't query statement")
 	}
 
+	return newRecordStream(rows, ds.alias, ds.fields, ds.grouping)
+}
+
+// selectList returns the columns to select from the table with the given alias, all of them if there are none given.
+func selectList(alias string, columns []octosql.VariableName) string {
+	if len(columns) == 0 {
+		return "*"
+	}
+
+	list := make([]string, len(columns))
+	for i := range columns {
+		list[i] = fmt.Sprintf("%s.%s", alias, columns[i])
+	}
+	return strings.Join(list, ", ")
+}
+
+// joinedSelectList returns the columns to select from the table with the given alias and the tables joined to it,
+// together with the names of the fields they become. All the columns of a table are selected if there are none given for it,
+// they're then read from the database.
+func joinedSelectList(ctx context.Context, cache *columnTypeCache, db *sql.DB, tableName, alias string, columns []octosql.VariableName, joins []*physical.TableJoin) (string, []octosql.VariableName, error) {
+	tables := []*physical.TableJoin{{Table: tableName, Alias: alias, Columns: columns}}
+	tables = append(tables, joins...)
+
+	var list []string
+	var fields []octosql.VariableName
+	for _, table := range tables {
+		tableColumns := table.Columns
+		if len(tableColumns) == 0 {
+			columnTypes, err := cache.get(ctx, db, table.Table)
+			if err != nil {
+				return "", nil, err
+			}
+			tableColumns = make([]octosql.VariableName, len(columnTypes))
+			for i := range columnTypes {
+				tableColumns[i] = octosql.NewVariableName(columnTypes[i].Name())
+			}
+		}
+
+		for i := range tableColumns {
+			list = append(list, fmt.Sprintf("%s.%s", table.Alias, tableColumns[i]))
+			fields = append(fields, octosql.NewVariableName(fmt.Sprintf("%s.%s", table.Alias, tableColumns[i])))
+		}
+	}
+
+	return strings.Join(list, ", "), fields, nil
+}
+
+// columnTypeCache holds the column types of the tables of a database, so they're only read from the database once.
+type columnTypeCache struct {
+	mutex  sync.Mutex
+	tables map[string][]*sql.ColumnType
+}
+
+func newColumnTypeCache() *columnTypeCache {
+	return &columnTypeCache{
+		tables: make(map[string][]*sql.ColumnType),
+	}
+}
+
+// get returns the column types of the table, reading them from the database if they aren't cached yet.
+func (cache *columnTypeCache) get(ctx context.Context, db *sql.DB, tableName string) ([]*sql.ColumnType, error) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if columnTypes, ok := cache.tables[tableName]; ok {
+		return columnTypes, nil
+	}
+
+	rows, err := db.QueryContext(ctx, fmt.Sprintf("SELECT * FROM %s LIMIT 0", tableName))
+	if err != nil {
+		return nil, errors.Wrapf(err, "couldn't query table %s", tableName)
+	}
+	defer rows.Close()
+
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, errors.Wrapf(err, "couldn't get column types of table %s", tableName)
+	}
+
+	cache.tables[tableName] = columnTypes
+	return columnTypes, nil
+}
+
+// tableTextColumns returns the columns of the table, which hold text.
+func tableTextColumns(ctx context.Context, cache *columnTypeCache, db *sql.DB, tableName string) (map[octosql.VariableName]bool, error) {
+	columnTypes, err := cache.get(ctx, db, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[octosql.VariableName]bool)
+	for _, columnType := range columnTypes {
+		switch columnType.DatabaseTypeName() {
+		case "TEXT", "VARCHAR", "BPCHAR", "CHAR", "NAME":
+			out[octosql.NewVariableName(columnType.Name())] = true
+		}
+	}
+
+	return out, nil
+}
+
+// newRecordStream creates a record stream of the rows, which are grouped by the grouping, if it isn't nil.
+// The fields name the columns of the rows, nil meaning they're the columns of the table with the given alias.
+func newRecordStream(rows *sql.Rows, alias string, fields []octosql.VariableName, grouping *physical.Grouping) (*RecordStream, error) {
 	columns, err := rows.Columns()
 	if err != nil {
+		rows.Close()
 		return nil, errors.Wrap(err, "couldn't get columns from rows")
 	}
 
+	if fields == nil {
+		fields = make([]octosql.VariableName, len(columns))
+		for i, columnName := range columns {
+			fields[i] = octosql.VariableName(fmt.Sprintf("%s.%s", alias, columnName))
+		}
+	}
+
+	var aggregates []physical.Aggregate
+	if grouping != nil {
+		fields = grouping.As
+		aggregates = grouping.Aggregates
+	}
+
 	return &RecordStream{
-		rows:    rows,
-		columns: columns,
-		isDone:  false,
-		alias:   ds.alias,
+		rows:       rows,
+		columns:    columns,
+		schema:     execution.NewSchema(fields),
+		aggregates: aggregates,
+		isDone:     false,
+		alias:      alias,
 	}, nil
-
 }
 
 type RecordStream struct {
-	rows    *sql.Rows
-	columns []string
-	isDone  bool
-	alias   string
+	rows       *sql.Rows
+	columns    []string
+	schema     *execution.Schema
+	aggregates []physical.Aggregate
+	isDone     bool
+	alias      string
 }
 
 func (rs *RecordStream) Close() error {
@@ -174,12 +416,15 @@ func (rs *RecordStream) Close() error {
 	return nil
 }
 
-func (rs *RecordStream) Next() (*execution.Record, error) {
+func (rs *RecordStream) Next(ctx context.Context) (*execution.Record, error) {
 	if rs.isDone {
 		return nil, execution.ErrEndOfStream
 	}
 
 	if !rs.rows.Next() {
+		if err := rs.rows.Err(); err != nil {
+			return nil, errors.Wrap(err, "couldn't get next row")
+		}
 		rs.isDone = true
 		return nil, execution.ErrEndOfStream
 	}
@@ -194,14 +439,86 @@ func (rs *RecordStream) Next() (*execution.Record, error) {
 		return nil, errors.Wrap(err, "couldn't scan row")
 	}
 
-	resultMap := make(map[octosql.VariableName]octosql.Value)
+	data := make([]octosql.Value, len(cols))
+	for i := range cols {
+		data[i] = rs.normalizeColumn(i, cols[i])
+	}
+
+	return execution.NewRecordFromSchema(rs.schema, data), nil
+}
 
-	fields := make([]octosql.VariableName, len(rs.columns))
-	for i, columnName := range rs.columns {
-		newName := octosql.VariableName(fmt.Sprintf("%s.%s", rs.alias, columnName))
-		fields[i] = newName
-		resultMap[newName] = octosql.NormalizeType(cols[i])
+// NextBatch scans up to maxSize rows straight into the columns of a batch.
+func (rs *RecordStream) NextBatch(ctx context.Context, maxSize int) (*execution.RecordBatch, error) {
+	if rs.isDone {
+		return nil, execution.ErrEndOfStream
+	}
+
+	cols := make([]interface{}, len(rs.columns))
+	colPointers := make([]interface{}, len(cols))
+	for i := range cols {
+		colPointers[i] = &cols[i]
+	}
+
+	columns := make([][]octosql.Value, len(rs.columns))
+	length := 0
+	for length < maxSize {
+		if !rs.rows.Next() {
+			if err := rs.rows.Err(); err != nil {
+				return nil, errors.Wrap(err, "couldn't get next row")
+			}
+			rs.isDone = true
+			break
+		}
+
+		if err := rs.rows.Scan(colPointers...); err != nil {
+			return nil, errors.Wrap(err, "couldn't scan row")
+		}
+
+		for i := range cols {
+			columns[i] = append(columns[i], rs.normalizeColumn(i, cols[i]))
+		}
+		length++
+	}
+
+	if length == 0 {
+		return nil, execution.ErrEndOfStream
+	}
+
+	return execution.NewRecordBatch(rs.schema, columns, length), nil
+}
+
+func (rs *RecordStream) normalizeColumn(i int, value interface{}) octosql.Value {
+	out := octosql.NormalizeType(value)
+	if rs.aggregates == nil {
+		return out
+	}
+	return normalizeAggregate(rs.aggregates[i], out)
+}
+
+// normalizeAggregate turns the decimals, which the database returns for sums and averages of integers, into numbers.
+// Averages are always floats, like the ones computed in memory.
+func normalizeAggregate(aggregate physical.Aggregate, value octosql.Value) octosql.Value {
+	switch aggregate {
+	case physical.Sum, physical.SumDistinct:
+		if str, ok := value.(octosql.String); ok {
+			if integer, err := strconv.Atoi(str.AsString()); err == nil {
+				return octosql.MakeInt(integer)
+			}
+			if float, err := strconv.ParseFloat(str.AsString(), 64); err == nil {
+				return octosql.MakeFloat(float)
+			}
+		}
+
+	case physical.Avg, physical.AvgDistinct:
+		switch value := value.(type) {
+		case octosql.String:
+			if float, err := strconv.ParseFloat(value.AsString(), 64); err == nil {
+				return octosql.MakeFloat(float)
+			}
+		case octosql.Int:
+			return octosql.MakeFloat(float64(value.AsInt()))
+		}
 	}
 
-	return execution.NewRecord(fields, resultMap), nil
+	return value
 }
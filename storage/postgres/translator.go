@@ -3,7 +3,9 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/cube2222/octosql"
 	"github.com/cube2222/octosql/execution"
 	"github.com/cube2222/octosql/physical"
 	"github.com/pkg/errors"
@@ -15,6 +17,7 @@ type aliases struct {
 	PlaceholderToExpression map[string]physical.Expression
 	Counter                 int
 	Alias                   string
+	JoinedAliases           []string
 }
 
 func newAliases(alias string) *aliases {
@@ -48,6 +51,19 @@ func relationToSQL(rel physical.Relation) string {
 	}
 }
 
+//checks if the variable is a column of the table with the alias or of one of the joined tables
+func (aliases *aliases) isColumn(name octosql.VariableName) bool {
+	if name.Source() == aliases.Alias {
+		return true
+	}
+	for _, alias := range aliases.JoinedAliases {
+		if name.Source() == alias {
+			return true
+		}
+	}
+	return false
+}
+
 func (aliases *aliases) newPlaceholder() string {
 	str := fmt.Sprintf("$%d", aliases.Counter)
 	aliases.Counter++
@@ -57,7 +73,7 @@ func (aliases *aliases) newPlaceholder() string {
 func expressionToSQL(expression physical.Expression, aliases *aliases) string {
 	switch expression := expression.(type) {
 	case *physical.Variable: //if it's a variable, then check if it's a column name for alias
-		if expression.Name.Source() == aliases.Alias {
+		if aliases.isColumn(expression.Name) {
 			return expression.Name.String()
 		}
 		//if not, or we are not a variable, then create a new placeholder and assign the expression to it
@@ -113,6 +129,129 @@ func formulaToSQL(formula physical.Formula, aliases *aliases) string {
 	}
 }
 
+//creates the TABLESAMPLE clause for the given sampling, or an empty string if there is none
+func samplingToSQL(sampling *physical.Sampling, aliases *aliases) string {
+	if sampling == nil {
+		return ""
+	}
+
+	var method string
+	switch sampling.Method {
+	case physical.Bernoulli:
+		method = "BERNOULLI"
+	case physical.System:
+		method = "SYSTEM"
+	default:
+		panic("Invalid physical sample method")
+	}
+
+	out := fmt.Sprintf(" TABLESAMPLE %s %s", method, parenthesize(expressionToSQL(sampling.Amount, aliases)))
+	if sampling.Seed != nil {
+		out += fmt.Sprintf(" REPEATABLE %s", parenthesize(expressionToSQL(sampling.Seed, aliases)))
+	}
+
+	return out
+}
+
+//creates the JOIN clauses for the given joined tables, whose aliases need to be set as joined aliases
+func joinsToSQL(joins []*physical.TableJoin, aliases *aliases) string {
+	out := ""
+	for _, join := range joins {
+		var joinType string
+		switch join.JoinType {
+		case physical.InnerJoinType:
+			joinType = "JOIN"
+		case physical.LeftJoinType:
+			joinType = "LEFT JOIN"
+		default:
+			panic("Invalid physical join type")
+		}
+
+		out += fmt.Sprintf(" %s %s %s ON %s", joinType, join.Table, join.Alias, formulaToSQL(join.Filter, aliases))
+	}
+
+	return out
+}
+
+//creates the ORDER BY clause for the given ordering of the table with the given alias, or an empty string if there is none
+//text columns are sorted bytewise with the C collation, like OctoSQL compares strings, so the records can be merge joined
+func orderingToSQL(ordering *physical.Ordering, alias string, textColumns map[octosql.VariableName]bool) string {
+	if ordering == nil {
+		return ""
+	}
+
+	columns := make([]string, len(ordering.Columns))
+	for i := range ordering.Columns {
+		column := fmt.Sprintf("%s.%s", alias, ordering.Columns[i])
+		if textColumns[ordering.Columns[i]] {
+			column = fmt.Sprintf("%s.%s COLLATE \"C\"", alias, ordering.Columns[i])
+		}
+		direction := "ASC"
+		if ordering.Directions[i] == physical.Descending {
+			direction = "DESC"
+		}
+		columns[i] = fmt.Sprintf("%s %s", column, direction)
+	}
+
+	return fmt.Sprintf(" ORDER BY %s", strings.Join(columns, ", "))
+}
+
+//creates the select list and the GROUP BY clause for the given grouping of the table with the given alias
+//the selected fields are named field_0, field_1 and so on, without a key, groups are only created for non-empty tables
+func groupingToSQL(grouping *physical.Grouping, alias string) (string, string) {
+	fields := make([]string, len(grouping.Fields))
+	for i := range grouping.Fields {
+		column := fmt.Sprintf("%s.%s", alias, grouping.Fields[i])
+
+		var field string
+		switch grouping.Aggregates[i] {
+		case physical.Count:
+			field = "COUNT(*)"
+		case physical.CountDistinct:
+			//unlike in SQL, null is counted as one of the distinct values
+			field = fmt.Sprintf("COUNT(DISTINCT %s) + MAX(CASE WHEN %s IS NULL THEN 1 ELSE 0 END)", column, column)
+		case physical.First, physical.Last:
+			field = column
+		case physical.Avg:
+			field = fmt.Sprintf("AVG(%s)", column)
+		case physical.AvgDistinct:
+			field = fmt.Sprintf("AVG(DISTINCT %s)", column)
+		case physical.Max:
+			field = fmt.Sprintf("MAX(%s)", column)
+		case physical.Min:
+			field = fmt.Sprintf("MIN(%s)", column)
+		case physical.Sum:
+			field = fmt.Sprintf("SUM(%s)", column)
+		case physical.SumDistinct:
+			field = fmt.Sprintf("SUM(DISTINCT %s)", column)
+		default:
+			panic("Invalid physical aggregate")
+		}
+
+		fields[i] = fmt.Sprintf("%s AS field_%d", field, i)
+	}
+
+	if len(grouping.Key) == 0 {
+		return strings.Join(fields, ", "), " HAVING COUNT(*) > 0"
+	}
+
+	key := make([]string, len(grouping.Key))
+	for i := range grouping.Key {
+		key[i] = fmt.Sprintf("%s.%s", alias, grouping.Key[i])
+	}
+
+	return strings.Join(fields, ", "), fmt.Sprintf(" GROUP BY %s", strings.Join(key, ", "))
+}
+
+//creates the LIMIT clause for the given limit, or an empty string if there is none
+func limitToSQL(limit physical.Expression, aliases *aliases) string {
+	if limit == nil {
+		return ""
+	}
+
+	return fmt.Sprintf(" LIMIT %s", expressionToSQL(limit, aliases))
+}
+
 func parenthesize(str string) string {
 	return fmt.Sprintf("(%s)", str)
 }
@@ -0,0 +1,85 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cube2222/octosql"
+	"github.com/cube2222/octosql/execution"
+	"github.com/go-redis/redis"
+	"github.com/pkg/errors"
+)
+
+// BatchDataSource is a data source which can get the keys for a whole batch of lookups
+// using a single pipelined request.
+type BatchDataSource struct {
+	*DataSource
+	batchSize int
+}
+
+func (ds *BatchDataSource) BatchSize() int {
+	return ds.batchSize
+}
+
+func (ds *BatchDataSource) GetBatch(ctx context.Context, batch []octosql.Variables) ([]execution.RecordStream, error) {
+	streams := make([]execution.RecordStream, len(batch))
+	keys := make([][]string, len(batch))
+
+	pipeline := ds.client.WithContext(ctx).Pipeline()
+	defer pipeline.Close()
+
+	commands := make(map[string]*redis.StringStringMapCmd)
+	for i := range batch {
+		keysWanted, err := ds.keyFormula.getAllKeys(ctx, batch[i])
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't get all keys from filter")
+		}
+
+		// Lookups without specific keys scan the entire database, so they can't be batched.
+		if len(keysWanted.keys) == 0 {
+			streams[i], err = ds.DataSource.Get(ctx, batch[i])
+			if err != nil {
+				return nil, errors.Wrap(err, "couldn't get record stream for lookup")
+			}
+			continue
+		}
+
+		for key := range keysWanted.keys {
+			keys[i] = append(keys[i], key)
+			if _, ok := commands[key]; !ok {
+				commands[key] = pipeline.HGetAll(key)
+			}
+		}
+	}
+
+	if len(commands) > 0 {
+		_, err := pipeline.Exec()
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't execute pipelined hashes request")
+		}
+	}
+
+	for i := range batch {
+		if streams[i] != nil {
+			continue
+		}
+
+		records := make([]*execution.Record, 0, len(keys[i]))
+		for _, key := range keys[i] {
+			recordValues, err := commands[key].Result()
+			if err != nil {
+				return nil, errors.Wrap(err, fmt.Sprintf("could't get hash for key %s", key))
+			}
+
+			// We skip this record
+			if len(recordValues) == 0 {
+				continue
+			}
+
+			records = append(records, newRecord(ds.dbKey, key, ds.alias, recordValues))
+		}
+		streams[i] = execution.NewInMemoryStream(records)
+	}
+
+	return streams, nil
+}
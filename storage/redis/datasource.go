@@ -1,6 +1,7 @@
 package redis
 
 import (
+	"context"
 	"fmt"
 	"sort"
 
@@ -23,40 +24,71 @@ var availableFilters = map[physical.FieldType]map[physical.Relation]struct{}{
 type DataSource struct {
 	client     *redis.Client
 	keyFormula KeyFormula
-	alias      string
-	dbKey      string
+	// limit is the maximum number of records to return, no limit if it's nil.
+	limit    execution.Expression
+	alias    string
+	dbKey    string
+	prefetch int
 }
 
+// DefaultBatchSize is the default maximum number of lookups in a single pipelined request.
+const DefaultBatchSize = 100
+
 // NewDataSourceBuilderFactory creates a new datasource builder factory for a redis database.
 // dbKey is the name for hard-coded key alias used in future formulas for redis queries
-func NewDataSourceBuilderFactory(hostname string, port int, password string, dbIndex int, dbKey string) physical.DataSourceBuilderFactory {
-	return physical.NewDataSourceBuilderFactory(
-		func(filter physical.Formula, alias string) (execution.Node, error) {
-			client := redis.NewClient(
-				&redis.Options{
-					Addr:     fmt.Sprintf("%s:%d", hostname, port),
-					Password: password,
-					DB:       dbIndex,
-				},
-			)
-
-			keyFormula, err := NewKeyFormula(filter, dbKey, alias)
+// Lookups are batched into single pipelined requests for up to batchSize lookups, a batchSize of 1 disables batching.
+// Unbatched lookups are run concurrently for prefetch source records, a prefetch of 0 uses the global setting.
+// Lookup joins cache the records of up to lookupCacheSize records of the most recently looked up keys, 0 disables the cache.
+func NewDataSourceBuilderFactory(hostname string, port int, password string, dbIndex int, dbKey string, batchSize, prefetch, lookupCacheSize int) physical.DataSourceBuilderFactory {
+	executor := func(options *physical.ExecutorOptions) (execution.Node, error) {
+		client := redis.NewClient(
+			&redis.Options{
+				Addr:     fmt.Sprintf("%s:%d", hostname, port),
+				Password: password,
+				DB:       dbIndex,
+			},
+		)
+
+		keyFormula, err := NewKeyFormula(options.Filter, dbKey, options.Alias)
+		if err != nil {
+			return nil, errors.Errorf("couldn't create KeyFormula")
+		}
+
+		var execLimit execution.Expression
+		if options.Limit != nil {
+			execLimit, err = options.Limit.Materialize(context.Background())
 			if err != nil {
-				return nil, errors.Errorf("couldn't create KeyFormula")
+				return nil, errors.Wrap(err, "couldn't materialize limit")
 			}
+		}
+
+		ds := &DataSource{
+			client:     client,
+			keyFormula: keyFormula,
+			limit:      execLimit,
+			alias:      options.Alias,
+			dbKey:      dbKey,
+			prefetch:   prefetch,
+		}
 
-			return &DataSource{
-				client:     client,
-				keyFormula: keyFormula,
-				alias:      alias,
-				dbKey:      dbKey,
+		if batchSize > 1 && options.Limit == nil {
+			return &BatchDataSource{
+				DataSource: ds,
+				batchSize:  batchSize,
 			}, nil
-		},
-		[]octosql.VariableName{
+		}
+
+		return ds, nil
+	}
+
+	return physical.NewDataSourceBuilderFactory(physical.DataSourceBuilder{
+		Executor: physical.WithLookupCache(executor, lookupCacheSize),
+		PrimaryKeys: []octosql.VariableName{
 			octosql.NewVariableName(dbKey),
 		},
-		availableFilters,
-	)
+		AvailableFilters: availableFilters,
+		AvailableLimit:   true,
+	})
 }
 
 // NewDataSourceBuilderFactoryFromConfig creates a data source builder factory using the configuration.
@@ -77,22 +109,70 @@ func NewDataSourceBuilderFactoryFromConfig(dbConfig map[string]interface{}) (phy
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't get password")
 	}
+	batchSize, err := config.GetInt(dbConfig, "batchSize", config.WithDefault(DefaultBatchSize))
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get batchSize")
+	}
+	prefetch, err := config.GetInt(dbConfig, "prefetch", config.WithDefault(0))
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get prefetch")
+	}
+
+	lookupCacheSize, err := config.GetInt(dbConfig, "lookupCacheSize", config.WithDefault(0))
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get lookupCacheSize")
+	}
+
+	return NewDataSourceBuilderFactory(host, port, password, dbIndex, dbKey, batchSize, prefetch, lookupCacheSize), nil
+}
 
-	return NewDataSourceBuilderFactory(host, port, password, dbIndex, dbKey), nil
+func (ds *DataSource) Prefetch() int {
+	return ds.prefetch
 }
 
-func (ds *DataSource) Get(variables octosql.Variables) (execution.RecordStream, error) {
-	keysWanted, err := ds.keyFormula.getAllKeys(variables)
+// LookupKey returns the sorted keys wanted by the filter, no keys mean the entire database.
+func (ds *DataSource) LookupKey(ctx context.Context, variables octosql.Variables) (octosql.Value, error) {
+	keysWanted, err := ds.keyFormula.getAllKeys(ctx, variables)
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't get all keys from filter")
 	}
 
+	sliceKeys := make([]string, 0, len(keysWanted.keys))
+	for k := range keysWanted.keys {
+		sliceKeys = append(sliceKeys, k)
+	}
+	sort.Strings(sliceKeys)
+
+	key := make(octosql.Tuple, len(sliceKeys))
+	for i := range sliceKeys {
+		key[i] = octosql.MakeString(sliceKeys[i])
+	}
+	return key, nil
+}
+
+func (ds *DataSource) Get(ctx context.Context, variables octosql.Variables) (execution.RecordStream, error) {
+	keysWanted, err := ds.keyFormula.getAllKeys(ctx, variables)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get all keys from filter")
+	}
+
+	limit := -1
+	if ds.limit != nil {
+		limit, err = ds.evaluateLimit(ctx, variables)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	client := ds.client.WithContext(ctx)
+
 	if len(keysWanted.keys) == 0 {
-		allKeys := ds.client.Scan(0, "*", 0)
+		allKeys := client.Scan(0, "*", 0)
 
 		return &EntireDatabaseStream{
-			client:     ds.client,
+			client:     client,
 			dbIterator: allKeys.Iterator(),
+			remaining:  limit,
 			isDone:     false,
 			alias:      ds.alias,
 			keyName:    ds.dbKey,
@@ -103,9 +183,13 @@ func (ds *DataSource) Get(variables octosql.Variables) (execution.RecordStream,
 	for k := range keysWanted.keys {
 		sliceKeys = append(sliceKeys, k)
 	}
+	if limit >= 0 && limit < len(sliceKeys) {
+		sort.Strings(sliceKeys)
+		sliceKeys = sliceKeys[:limit]
+	}
 
 	return &KeySpecificStream{
-		client:  ds.client,
+		client:  client,
 		keys:    sliceKeys,
 		counter: 0,
 		isDone:  false,
@@ -123,12 +207,32 @@ type KeySpecificStream struct {
 	keyName string
 }
 
+// evaluateLimit returns the maximum number of records to return for the given variables.
+func (ds *DataSource) evaluateLimit(ctx context.Context, variables octosql.Variables) (int, error) {
+	value, err := ds.limit.ExpressionValue(ctx, variables)
+	if err != nil {
+		return 0, errors.Wrap(err, "couldn't extract value from limit subexpression")
+	}
+
+	limit, ok := value.(octosql.Int)
+	if !ok {
+		return 0, errors.New("limit value not int")
+	}
+	if limit < 0 {
+		return 0, errors.New("negative limit value")
+	}
+
+	return limit.AsInt(), nil
+}
+
 type EntireDatabaseStream struct {
 	client     *redis.Client
 	dbIterator *redis.ScanIterator
-	isDone     bool
-	alias      string
-	keyName    string
+	// remaining is the number of records left to return, no limit if it's negative.
+	remaining int
+	isDone    bool
+	alias     string
+	keyName   string
 }
 
 func (rs *KeySpecificStream) Close() error {
@@ -140,11 +244,15 @@ func (rs *KeySpecificStream) Close() error {
 	return nil
 }
 
-func (rs *KeySpecificStream) Next() (*execution.Record, error) {
+func (rs *KeySpecificStream) Next(ctx context.Context) (*execution.Record, error) {
 	if rs.isDone {
 		return nil, execution.ErrEndOfStream
 	}
 
+	if err := ctx.Err(); err != nil {
+		return nil, errors.Wrap(err, "couldn't get next record")
+	}
+
 	if rs.counter == len(rs.keys) {
 		rs.isDone = true
 		return nil, execution.ErrEndOfStream
@@ -165,12 +273,21 @@ func (rs *EntireDatabaseStream) Close() error {
 	return nil
 }
 
-func (rs *EntireDatabaseStream) Next() (*execution.Record, error) {
+func (rs *EntireDatabaseStream) Next(ctx context.Context) (*execution.Record, error) {
 	for {
 		if rs.isDone {
 			return nil, execution.ErrEndOfStream
 		}
 
+		if err := ctx.Err(); err != nil {
+			return nil, errors.Wrap(err, "couldn't get next record")
+		}
+
+		if rs.remaining == 0 {
+			rs.isDone = true
+			return nil, execution.ErrEndOfStream
+		}
+
 		if !rs.dbIterator.Next() {
 			if rs.dbIterator.Err() != nil {
 				return nil, rs.dbIterator.Err()
@@ -188,6 +305,9 @@ func (rs *EntireDatabaseStream) Next() (*execution.Record, error) {
 			return nil, err
 		}
 
+		if rs.remaining > 0 {
+			rs.remaining--
+		}
 		return record, nil
 	}
 }
@@ -203,6 +323,10 @@ func GetNewRecord(client *redis.Client, keyName, key, alias string) (*execution.
 		return nil, ErrNotFound
 	}
 
+	return newRecord(keyName, key, alias, recordValues), nil
+}
+
+func newRecord(keyName, key, alias string, recordValues map[string]string) *execution.Record {
 	keyVariableName := octosql.NewVariableName(fmt.Sprintf("%s.%s", alias, keyName))
 
 	aliasedRecord := make(map[octosql.VariableName]octosql.Value)
@@ -224,7 +348,7 @@ func GetNewRecord(client *redis.Client, keyName, key, alias string) (*execution.
 		return fieldNames[i+1] < fieldNames[j+1]
 	})
 
-	return execution.NewRecord(fieldNames, aliasedRecord), nil
+	return execution.NewRecord(fieldNames, aliasedRecord)
 }
 
 var ErrNotFound = errors.New("redis key not found")
@@ -1,6 +1,7 @@
 package redis
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -12,6 +13,7 @@ import (
 )
 
 func TestDataSource_Get(t *testing.T) {
+	ctx := context.Background()
 	hostname := "localhost"
 	password := ""
 	port := 6379
@@ -770,9 +772,9 @@ func TestDataSource_Get(t *testing.T) {
 				}
 			}
 
-			dsFactory := NewDataSourceBuilderFactory(fields.hostname, fields.port, fields.password, fields.dbIndex, fields.dbKey)
+			dsFactory := NewDataSourceBuilderFactory(fields.hostname, fields.port, fields.password, fields.dbIndex, fields.dbKey, 1, 0, 0)
 			dsBuilder := dsFactory(fields.alias)
-			execNode, err := dsBuilder.Executor(fields.filter, fields.alias)
+			execNode, err := dsBuilder.Executor(&physical.ExecutorOptions{Filter: fields.filter, Alias: fields.alias})
 			if err != nil && !tt.wantErr {
 				t.Errorf("%v : while executing datasource builder", err)
 				return
@@ -780,7 +782,7 @@ func TestDataSource_Get(t *testing.T) {
 				return
 			}
 
-			stream, err := execNode.Get(tt.args.variables)
+			stream, err := execNode.Get(ctx, tt.args.variables)
 			if err != nil && !tt.wantErr {
 				t.Errorf("Error in Get: %v", err)
 				return
@@ -788,7 +790,7 @@ func TestDataSource_Get(t *testing.T) {
 				return
 			}
 
-			equal, err := execution.AreStreamsEqualNoOrdering(stream, tt.want)
+			equal, err := execution.AreStreamsEqualNoOrdering(ctx, stream, tt.want)
 			if err != nil && !tt.wantErr {
 				t.Errorf("AreStreamsEqual() error: %s", err)
 				return
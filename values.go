@@ -242,6 +242,10 @@ func AreEqual(left, right Value) bool {
 		return true
 	}
 	switch left := left.(type) {
+	case Phantom:
+		_, ok := right.(Phantom)
+		return ok
+
 	case Int:
 		right, ok := right.(Int)
 		if !ok {
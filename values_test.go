@@ -68,6 +68,14 @@ func TestAreEqual(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "compare phantoms",
+			args: args{
+				left:  MakePhantom(),
+				right: MakePhantom(),
+			},
+			want: true,
+		},
 		{
 			name: "compare ints",
 			args: args{